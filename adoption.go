@@ -0,0 +1,84 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"slices"
+	"sort"
+)
+
+// Deployment is a named deployment of a product, such as a cluster, host, or tenant, and the
+// version it runs.
+type Deployment struct {
+	Name    string
+	Version Version
+}
+
+// DeploymentLag reports how far a deployment is behind the latest release.
+//
+// Latest is the highest release in the channel, and Distance the distance from the deployment's
+// version to it, as returned by Distance. ReleasesBehind counts the releases in the channel
+// with higher precedence than the deployment's version. A deployment running a version ahead
+// of Latest, such as a pre-release outside the channel, has a positive Distance.Sign and is zero
+// releases behind.
+type DeploymentLag struct {
+	Deployment
+	Latest         Version
+	Distance       VersionDistance
+	ReleasesBehind int
+}
+
+// IsCurrent reports whether the deployment runs the latest release, or a later version.
+func (l DeploymentLag) IsCurrent() bool {
+	return l.ReleasesBehind == 0
+}
+
+// AdoptionLag reports, for each deployment, how far it is behind the latest of the releases
+// allowed by the channel, in the order of deployments. Releases with equal precedence, such as
+// those differing only in build metadata, count once.
+//
+// If no release is allowed by the channel, every deployment is reported as current, with its
+// own version as Latest. Nil entries in releases are ignored.
+//
+// Example:
+//
+//	var releases []*semver.Version
+//	for _, s := range []string{"1.0.0", "1.1.0", "1.1.1", "2.0.0", "2.1.0-rc.1"} {
+//	    v := semver.MustParse(s)
+//	    releases = append(releases, &v)
+//	}
+//	fleet := []semver.Deployment{{Name: "eu-1", Version: semver.MustParse("1.1.0")}}
+//	for _, lag := range semver.AdoptionLag(fleet, releases, semver.ChannelStable) {
+//	    fmt.Println(lag.Name, lag.Latest, lag.ReleasesBehind, lag.Distance.Majors)
+//	}
+//	// Output: eu-1 2.0.0 2 1
+func AdoptionLag(deployments []Deployment, releases []*Version, channel Channel) []DeploymentLag {
+	stream := make([]*Version, 0, len(releases))
+	for _, v := range releases {
+		if v != nil && channel.Allows(*v) {
+			stream = append(stream, v)
+		}
+	}
+	Sort(stream)
+	stream = slices.CompactFunc(stream, func(a, b *Version) bool { return a.Equal(*b) })
+
+	lags := make([]DeploymentLag, len(deployments))
+	for i, d := range deployments {
+		latest := d.Version
+		if len(stream) > 0 {
+			latest = *stream[len(stream)-1]
+		}
+
+		newer := sort.Search(len(stream), func(j int) bool { return stream[j].GreaterThan(d.Version) })
+		lags[i] = DeploymentLag{
+			Deployment:     d,
+			Latest:         latest,
+			Distance:       Distance(d.Version, latest),
+			ReleasesBehind: len(stream) - newer,
+		}
+	}
+	return lags
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdoptionLag(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var releases []*Version
+	for _, s := range []string{"2.0.0", "1.0.0", "1.1.0", "1.1.1", "1.1.1+rebuild", "2.1.0-rc.1"} {
+		v := MustParse(s)
+		releases = append(releases, &v)
+	}
+	releases = append(releases, nil)
+
+	fleet := []Deployment{
+		{Name: "eu-1", Version: MustParse("1.1.0")},
+		{Name: "us-1", Version: MustParse("2.0.0")},
+		{Name: "ap-1", Version: MustParse("0.9.0")},
+		{Name: "canary", Version: MustParse("2.1.0-rc.1")},
+	}
+
+	lags := AdoptionLag(fleet, releases, ChannelStable)
+	is.Len(lags, len(fleet))
+
+	is.Equal("eu-1", lags[0].Name)
+	is.Equal("2.0.0", lags[0].Latest.String())
+	is.Equal(2, lags[0].ReleasesBehind)
+	is.Equal(VersionDistance{Majors: 1, Sign: -1}, lags[0].Distance)
+	is.False(lags[0].IsCurrent())
+
+	is.True(lags[1].IsCurrent())
+	is.True(lags[1].Distance.IsZero())
+
+	is.Equal(4, lags[2].ReleasesBehind)
+	is.Equal(VersionDistance{Majors: 2, Sign: -1}, lags[2].Distance)
+
+	is.True(lags[3].IsCurrent())
+	is.Equal(1, lags[3].Distance.Sign)
+
+	lags = AdoptionLag(fleet, releases, ChannelPrerelease)
+	is.Equal("2.1.0-rc.1", lags[1].Latest.String())
+	is.Equal(1, lags[1].ReleasesBehind)
+	is.True(lags[3].IsCurrent())
+}
+
+func TestAdoptionLagNoReleases(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	lags := AdoptionLag([]Deployment{{Name: "a", Version: MustParse("1.0.0")}}, nil, ChannelStable)
+	is.Len(lags, 1)
+	is.True(lags[0].IsCurrent())
+	is.Equal("1.0.0", lags[0].Latest.String())
+	is.True(lags[0].Distance.IsZero())
+
+	is.Empty(AdoptionLag(nil, nil, ChannelStable))
+}
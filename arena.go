@@ -0,0 +1,90 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// defaultArenaChunk is the number of identifiers in each buffer of a new Arena.
+const defaultArenaChunk = 4096
+
+// Arena is a Parser for bulk workloads that allocates the pre-release identifiers and build
+// metadata of parsed versions from reusable buffers instead of individual heap allocations.
+//
+// Versions parsed by an Arena remain valid until Reset is called, which releases every buffer
+// in bulk for reuse. Using such a version after Reset, without first calling Clone, results in
+// its identifiers being overwritten by later parses. An Arena is not safe for concurrent use;
+// use one Arena per goroutine.
+//
+// Example:
+//
+//	arena, err := semver.NewArena()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, batch := range batches {
+//	    for _, s := range batch {
+//	        v, err := arena.Parse(s)
+//	        if err != nil {
+//	            continue
+//	        }
+//	        process(v)
+//	    }
+//	    arena.Reset()
+//	}
+type Arena struct {
+	parser *parser
+	pre    []PrereleaseVersion
+	meta   []string
+}
+
+// Ensure Arena implements Parser.
+var _ Parser = (*Arena)(nil)
+
+// NewArena creates an Arena that parses versions with the given options, as NewParser does.
+func NewArena(options ...Option) (*Arena, error) {
+	p, err := NewParser(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Arena{
+		parser: p.(*parser),
+		pre:    make([]PrereleaseVersion, 0, defaultArenaChunk),
+		meta:   make([]string, 0, defaultArenaChunk),
+	}, nil
+}
+
+// Parse parses a version string into a Version whose slices are allocated from the arena.
+func (a *Arena) Parse(version string) (Version, error) {
+	v, err := a.parser.parse(version, a.pre[len(a.pre):], a.meta[len(a.meta):])
+	if err != nil {
+		return Version{}, err
+	}
+
+	a.pre = claim(a.pre, v.PreRelease)
+	a.meta = claim(a.meta, v.BuildMetadata)
+	return v, nil
+}
+
+// Reset releases every buffer in bulk so that it can be reused by later calls to Parse.
+// Versions previously returned by Parse must no longer be used.
+func (a *Arena) Reset() {
+	clear(a.pre)
+	clear(a.meta)
+	a.pre = a.pre[:0]
+	a.meta = a.meta[:0]
+}
+
+// claim marks the elements of used as taken from buf, which is the case if they were appended
+// within its spare capacity. If they were not, buf ran out of room and is replaced with a larger
+// buffer; the old one is left to the versions that still reference it.
+func claim[T any](buf, used []T) []T {
+	if len(used) == 0 {
+		return buf
+	}
+	if len(buf)+len(used) <= cap(buf) {
+		return buf[:len(buf)+len(used)]
+	}
+	return make([]T, 0, max(2*cap(buf), defaultArenaChunk))
+}
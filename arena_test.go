@@ -0,0 +1,108 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArenaParse(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	arena, err := NewArena()
+	is.NoError(err)
+
+	inputs := []string{"1.2.3", "1.0.0-alpha.1+build.5", "2.0.0-rc.1", "3.0.0+meta"}
+	var parsed []Version
+	for _, s := range inputs {
+		v, err := arena.Parse(s)
+		is.NoError(err)
+		parsed = append(parsed, v)
+	}
+
+	for i, v := range parsed {
+		is.Equal(inputs[i], v.String())
+		is.True(v.StrictEqual(MustParse(inputs[i])))
+		is.Equal(len(v.PreRelease), cap(v.PreRelease))
+		is.Equal(len(v.BuildMetadata), cap(v.BuildMetadata))
+	}
+
+	// Appending to one version must not overwrite the identifiers of the next.
+	grown := append(parsed[1].PreRelease, PrereleaseVersion{partString: "x"})
+	is.Len(grown, 3)
+	is.Equal("2.0.0-rc.1", parsed[2].String())
+
+	_, err = arena.Parse("1.2.3-01")
+	is.ErrorIs(err, ErrInvalidPrereleaseIdentifier)
+	v, err := arena.Parse("4.0.0-beta")
+	is.NoError(err)
+	is.Equal("4.0.0-beta", v.String())
+}
+
+func TestArenaReset(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	arena, err := NewArena()
+	is.NoError(err)
+
+	v, err := arena.Parse("1.0.0-alpha+build")
+	is.NoError(err)
+	kept := v.Clone()
+
+	arena.Reset()
+	_, err = arena.Parse("2.0.0-beta+other")
+	is.NoError(err)
+
+	is.Equal("1.0.0-beta+other", v.String(), "Versions are overwritten after Reset")
+	is.Equal("1.0.0-alpha+build", kept.String(), "Cloned versions survive Reset")
+}
+
+func TestArenaGrowth(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	arena, err := NewArena()
+	is.NoError(err)
+
+	var parsed []Version
+	for i := 0; i < 3*defaultArenaChunk; i++ {
+		v, err := arena.Parse("1.0.0-a.b.c+x.y")
+		is.NoError(err)
+		parsed = append(parsed, v)
+	}
+	for _, v := range parsed {
+		is.Equal("1.0.0-a.b.c+x.y", v.String())
+	}
+}
+
+func TestArenaAllocations(t *testing.T) {
+	arena, err := NewArena()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	allocs := testing.AllocsPerRun(1000, func() {
+		if _, err := arena.Parse("1.2.3-beta.1+build.5"); err != nil {
+			t.Fatal(err)
+		}
+		arena.Reset()
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestArenaStrictOption(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	arena, err := NewArena(WithStrictAdherence(false))
+	is.NoError(err)
+	_, err = arena.Parse("1.2.3")
+	is.NoError(err)
+}
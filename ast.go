@@ -0,0 +1,254 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Node is a node of the abstract syntax tree of a range expression: a *ComparatorNode, an
+// *AndNode, or an *OrNode.
+//
+// Trees are returned by ParseAST, which keeps the grouping of an expression as written, and by
+// VersionRange.AST, which mirrors the OR-of-ANDs form of a range. Tools can inspect or rewrite a
+// tree with Walk and turn it back into a range with NewRangeFromAST, or into another
+// ecosystem's syntax, without re-parsing strings.
+type Node interface {
+	// String returns the node in the syntax accepted by ParseExpression.
+	String() string
+
+	// requirements returns the node's requirements in disjunctive normal form.
+	requirements() ([][]Requirement, error)
+}
+
+// ComparatorNode is a leaf comparing versions against a possibly partial version.
+//
+// Parts is the number of numeric components given explicitly, from 0 for "*" to 3 for a
+// complete version, and the omitted components of Version are zero. For example, ">=1.2" has
+// Op OpGte, Version 1.2.0, and Parts 2.
+type ComparatorNode struct {
+	Op      Operator
+	Version Version
+	Parts   int
+}
+
+// AndNode matches the versions matched by every one of its Children.
+type AndNode struct {
+	Children []Node
+}
+
+// OrNode matches the versions matched by any of its Children.
+type OrNode struct {
+	Children []Node
+}
+
+// String returns the comparison, such as ">=1.2" or "^1.2.3".
+func (n *ComparatorNode) String() string {
+	if n.Parts == 0 {
+		return string(n.Op) + "*"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(string(n.Op))
+	for i, c := range []uint64{n.Version.Major, n.Version.Minor, n.Version.Patch}[:min(n.Parts, 3)] {
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(strconv.FormatUint(c, 10))
+	}
+	if s := n.Version.String(); len(n.Version.PreRelease) > 0 || len(n.Version.BuildMetadata) > 0 {
+		if i := strings.IndexAny(s, "-+"); i >= 0 {
+			sb.WriteString(s[i:])
+		}
+	}
+	return sb.String()
+}
+
+// String returns the children separated by spaces, with OR children in parentheses.
+func (n *AndNode) String() string {
+	parts := make([]string, len(n.Children))
+	for i, child := range n.Children {
+		parts[i] = child.String()
+		if _, ok := child.(*OrNode); ok {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// String returns the children separated by " || ".
+func (n *OrNode) String() string {
+	parts := make([]string, len(n.Children))
+	for i, child := range n.Children {
+		parts[i] = child.String()
+	}
+	return strings.Join(parts, " || ")
+}
+
+// requirements expands the comparison as ParseExpression does.
+func (n *ComparatorNode) requirements() ([][]Requirement, error) {
+	p := partialVersion{Version: n.Version, parts: n.Parts}
+	switch n.Op {
+	case OpCaret:
+		if p.parts == 3 {
+			return [][]Requirement{{{Op: OpCaret, Ver: p.Version}}}, nil
+		}
+		return expandCaret(p), nil
+	case OpTilde:
+		if p.parts == 3 {
+			return [][]Requirement{{{Op: OpTilde, Ver: p.Version}}}, nil
+		}
+		return expandTilde(p), nil
+	case OpEq, OpNeq, OpGt, OpGte, OpLt, OpLte:
+		return expandComparison(n.Op, p), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownOperator, n.Op)
+	}
+}
+
+// requirements distributes the children's requirements over each other. Like an OR branch
+// without requirements, an AndNode without children matches every version.
+func (n *AndNode) requirements() ([][]Requirement, error) {
+	reqs := [][]Requirement{{}}
+	for _, child := range n.Children {
+		next, err := child.requirements()
+		if err != nil {
+			return nil, err
+		}
+		if len(reqs)*len(next) > maxExpressionBranches {
+			return nil, fmt.Errorf("%w: more than %d branches", ErrInvalidExpression, maxExpressionBranches)
+		}
+		reqs = andRequirements(reqs, next)
+	}
+	return reqs, nil
+}
+
+// requirements concatenates the children's requirements. Like a range without OR branches,
+// an OrNode without children matches no version.
+func (n *OrNode) requirements() ([][]Requirement, error) {
+	var reqs [][]Requirement
+	for _, child := range n.Children {
+		next, err := child.requirements()
+		if err != nil {
+			return nil, err
+		}
+		if len(reqs)+len(next) > maxExpressionBranches {
+			return nil, fmt.Errorf("%w: more than %d branches", ErrInvalidExpression, maxExpressionBranches)
+		}
+		reqs = append(reqs, next...)
+	}
+	return reqs, nil
+}
+
+// Walk traverses the tree rooted at n in depth-first order, calling fn for each node before its
+// children. If fn returns false, the children of that node are skipped.
+//
+// Example:
+//
+//	tree, _ := semver.ParseAST("(>=1.2 <2.0) || ^3.1.0")
+//	semver.Walk(tree, func(n semver.Node) bool {
+//	    if c, ok := n.(*semver.ComparatorNode); ok {
+//	        fmt.Println(c)
+//	    }
+//	    return true
+//	})
+//	// Output:
+//	// >=1.2
+//	// <2.0
+//	// ^3.1.0
+func Walk(n Node, fn func(Node) bool) {
+	if n == nil || !fn(n) {
+		return
+	}
+
+	var children []Node
+	switch n := n.(type) {
+	case *AndNode:
+		children = n.Children
+	case *OrNode:
+		children = n.Children
+	}
+	for _, child := range children {
+		Walk(child, fn)
+	}
+}
+
+// ParseAST parses a range expression, in the syntax accepted by ParseExpression, into an
+// abstract syntax tree that keeps its grouping and partial versions as written. Operators are
+// normalized, so "==1.0.0" and "1.0.0" are both OpEq comparisons, and "!1.0.0" is OpNeq.
+//
+// A single comparison parses to a *ComparatorNode, and AND and OR groups of two or more
+// operands to an *AndNode or *OrNode.
+//
+// Returns an error wrapping ErrInvalidExpression, with the byte offset of the failure, if the
+// expression is malformed.
+//
+// Example:
+//
+//	tree, err := semver.ParseAST(">=1.2 && (<2.0 || >=3.0)")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%T %s\n", tree, tree) // Output: *semver.AndNode >=1.2 (<2.0 || >=3.0)
+func ParseAST(s string) (Node, error) {
+	p := exprParser{input: s}
+	n, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipSpace(); p.pos < len(p.input) {
+		return nil, p.errorf("unexpected %q", p.input[p.pos])
+	}
+	return n, nil
+}
+
+// NewRangeFromAST builds the range matched by the tree rooted at n, distributing nested groups
+// into the OR-of-ANDs form of VersionRange as ParseExpression does.
+//
+// Returns an error wrapping ErrInvalidExpression if n is nil or the tree expands to more than
+// 1024 OR branches, or ErrUnknownOperator if a comparator's operator is not defined.
+//
+// Example:
+//
+//	r, err := semver.NewRangeFromAST(&semver.OrNode{Children: []semver.Node{
+//	    &semver.ComparatorNode{Op: semver.OpCaret, Version: semver.MustParse("1.2.0"), Parts: 3},
+//	    &semver.ComparatorNode{Op: semver.OpGte, Version: semver.MustParse("3.0.0"), Parts: 1},
+//	}})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r) // Output: ^1.2.0 || >=3.0.0
+func NewRangeFromAST(n Node) (*VersionRange, error) {
+	if n == nil {
+		return nil, fmt.Errorf("%w: nil node", ErrInvalidExpression)
+	}
+	reqs, err := n.requirements()
+	if err != nil {
+		return nil, err
+	}
+	return &VersionRange{Requirements: reqs}, nil
+}
+
+// AST returns the range as an abstract syntax tree: an *OrNode holding an *AndNode of
+// complete-version comparators for each OR branch. The tree shares no memory with the range.
+//
+// Example:
+//
+//	tree := semver.MustParseRange(">=1.0.0 <2.0.0 || >=3.0.0").AST()
+//	fmt.Println(len(tree.(*semver.OrNode).Children)) // Output: 2
+func (vr *VersionRange) AST() Node {
+	or := &OrNode{Children: make([]Node, len(vr.Requirements))}
+	for i, andReqs := range vr.Requirements {
+		and := &AndNode{Children: make([]Node, len(andReqs))}
+		for j, req := range andReqs {
+			and.Children[j] = &ComparatorNode{Op: req.Op, Version: req.Ver.Clone(), Parts: 3}
+		}
+		or.Children[i] = and
+	}
+	return or
+}
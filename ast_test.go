@@ -0,0 +1,165 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAST(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		expr     string
+		expected string
+		typ      Node
+	}{
+		{">=1.2", ">=1.2", &ComparatorNode{}},
+		{"==1.0.0", "=1.0.0", &ComparatorNode{}},
+		{"!1.0.0-rc.1", "!=1.0.0-rc.1", &ComparatorNode{}},
+		{"*", "=*", &ComparatorNode{}},
+		{"(((^1.2.3)))", "^1.2.3", &ComparatorNode{}},
+		{">=1.2 && (<2.0 || >=3.0)", ">=1.2 (<2.0 || >=3.0)", &AndNode{}},
+		{"(>=1.2 <2.0) || (>=3.0 && !=3.1.4)", ">=1.2 <2.0 || >=3.0 !=3.1.4", &OrNode{}},
+		{"1.x || >= 2", "=1 || >=2", &OrNode{}},
+	}
+
+	for _, tt := range tests {
+		n, err := ParseAST(tt.expr)
+		if !is.NoError(err, tt.expr) {
+			continue
+		}
+		is.IsType(tt.typ, n, tt.expr)
+		is.Equal(tt.expected, n.String(), tt.expr)
+
+		// The printed tree parses back to an equivalent range.
+		a, err := NewRangeFromAST(n)
+		is.NoError(err)
+		b, err := ParseExpression(n.String())
+		is.NoError(err)
+		is.Equal(a.String(), b.String(), tt.expr)
+	}
+
+	_, err := ParseAST("(>=1.0.0")
+	is.ErrorIs(err, ErrInvalidExpression)
+}
+
+func TestComparatorNodeParts(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	n, err := ParseAST(">=1.2")
+	is.NoError(err)
+	c := n.(*ComparatorNode)
+	is.Equal(OpGte, c.Op)
+	is.Equal(2, c.Parts)
+	is.Equal("1.2.0", c.Version.String())
+}
+
+func TestWalk(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	n, err := ParseAST("(>=1.2 <2.0) || ^3.1.0")
+	is.NoError(err)
+
+	var visited []string
+	Walk(n, func(n Node) bool {
+		switch n.(type) {
+		case *OrNode:
+			visited = append(visited, "or")
+		case *AndNode:
+			visited = append(visited, "and")
+		case *ComparatorNode:
+			visited = append(visited, n.String())
+		}
+		return true
+	})
+	is.Equal([]string{"or", "and", ">=1.2", "<2.0", "^3.1.0"}, visited)
+
+	visited = nil
+	Walk(n, func(n Node) bool {
+		visited = append(visited, n.String())
+		_, isAnd := n.(*AndNode)
+		return !isAnd
+	})
+	is.Equal([]string{">=1.2 <2.0 || ^3.1.0", ">=1.2 <2.0", "^3.1.0"}, visited)
+
+	Walk(nil, func(Node) bool {
+		is.Fail("fn called for nil node")
+		return true
+	})
+}
+
+func TestWalkRewrite(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Loosen every exclusive upper bound to the next major version.
+	n, err := ParseAST(">=1.2.0 <1.5.0 || >=3.0.0 <3.1.0")
+	is.NoError(err)
+	Walk(n, func(n Node) bool {
+		if c, ok := n.(*ComparatorNode); ok && c.Op == OpLt {
+			c.Version = Version{Major: c.Version.Major + 1}
+			c.Parts = 1
+		}
+		return true
+	})
+
+	r, err := NewRangeFromAST(n)
+	is.NoError(err)
+	is.Equal(">=1.2.0 <2.0.0 || >=3.0.0 <4.0.0", r.String())
+}
+
+func TestNewRangeFromAST(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r, err := NewRangeFromAST(&OrNode{Children: []Node{
+		&ComparatorNode{Op: OpCaret, Version: MustParse("1.2.0"), Parts: 3},
+		&ComparatorNode{Op: OpGte, Version: MustParse("3.0.0"), Parts: 1},
+	}})
+	is.NoError(err)
+	is.Equal("^1.2.0 || >=3.0.0", r.String())
+
+	r, err = NewRangeFromAST(&AndNode{})
+	is.NoError(err)
+	is.True(r.Contains(MustParse("9.9.9")))
+
+	r, err = NewRangeFromAST(&OrNode{})
+	is.NoError(err)
+	is.False(r.Contains(MustParse("1.0.0")))
+
+	_, err = NewRangeFromAST(nil)
+	is.ErrorIs(err, ErrInvalidExpression)
+
+	_, err = NewRangeFromAST(&ComparatorNode{Op: "=>", Version: MustParse("1.0.0"), Parts: 3})
+	is.ErrorIs(err, ErrUnknownOperator)
+}
+
+func TestVersionRangeAST(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	vr := MustParseRange(">=1.0.0 <2.0.0 || >=3.0.0-rc.1")
+	n := vr.AST()
+	or, ok := n.(*OrNode)
+	is.True(ok)
+	is.Len(or.Children, 2)
+	is.Equal(">=1.0.0 <2.0.0 || >=3.0.0-rc.1", n.String())
+
+	r, err := NewRangeFromAST(n)
+	is.NoError(err)
+	is.Equal(vr.String(), r.String())
+
+	// The tree does not share identifiers with the range.
+	c := or.Children[1].(*AndNode).Children[0].(*ComparatorNode)
+	c.Version.PreRelease[0] = PrereleaseVersion{partString: "beta"}
+	is.Equal(">=1.0.0 <2.0.0 || >=3.0.0-rc.1", vr.String())
+}
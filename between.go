@@ -0,0 +1,47 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// NewRangeBetween returns the range of versions between min and max, each bound included or
+// excluded as given, without going through the range grammar.
+//
+// The range matches nothing if min is above max, or if they are equal and either bound is
+// excluded; if they are equal and both bounds are included, it matches exactly that version.
+// Build metadata is dropped from both bounds, since it does not affect precedence. Pre-releases
+// are matched by plain precedence (PrereleaseInclusive); set Prerelease on the result to
+// change that.
+//
+// Example:
+//
+//	r := semver.NewRangeBetween(semver.MustParse("1.2.0"), semver.MustParse("2.0.0"), true, false)
+//	fmt.Println(r)                                     // Output: >=1.2.0 <2.0.0
+//	fmt.Println(r.Contains(semver.MustParse("1.9.0"))) // Output: true
+func NewRangeBetween(min, max Version, minInclusive, maxInclusive bool) *VersionRange {
+	min.BuildMetadata = nil
+	max.BuildMetadata = nil
+
+	iv := Interval{
+		Lower: Bound{Version: min, Inclusive: minInclusive},
+		Upper: Bound{Version: max, Inclusive: maxInclusive},
+	}
+	if iv.IsEmpty() {
+		return &VersionRange{Requirements: matchNone()}
+	}
+	return &VersionRange{Requirements: [][]Requirement{iv.requirements()}}
+}
+
+// NewRangeExact returns the range matching exactly v. Build metadata is dropped from v, since
+// it does not affect precedence, so versions differing only in build metadata match as well.
+//
+// Example:
+//
+//	r := semver.NewRangeExact(semver.MustParse("1.2.3-rc.1"))
+//	fmt.Println(r)                                          // Output: =1.2.3-rc.1
+//	fmt.Println(r.Contains(semver.MustParse("1.2.3-rc.1"))) // Output: true
+func NewRangeExact(v Version) *VersionRange {
+	v.BuildMetadata = nil
+	return &VersionRange{Requirements: [][]Requirement{{{Op: OpEq, Ver: v}}}}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRangeBetween(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	lo, hi := MustParse("1.2.0"), MustParse("2.0.0")
+	tests := []struct {
+		minInclusive, maxInclusive bool
+		want                       string
+	}{
+		{true, true, ">=1.2.0 <=2.0.0"},
+		{true, false, ">=1.2.0 <2.0.0"},
+		{false, true, ">1.2.0 <=2.0.0"},
+		{false, false, ">1.2.0 <2.0.0"},
+	}
+	for _, tt := range tests {
+		r := NewRangeBetween(lo, hi, tt.minInclusive, tt.maxInclusive)
+		is.Equal(tt.want, r.String())
+		is.Equal(MustParseRange(tt.want), r)
+		is.Equal(tt.minInclusive, r.Contains(lo), tt.want)
+		is.Equal(tt.maxInclusive, r.Contains(hi), tt.want)
+		is.True(r.Contains(MustParse("1.5.0")), tt.want)
+		is.NoError(r.Validate())
+	}
+
+	// Equal bounds match the version only when both are included.
+	v := MustParse("1.2.3")
+	is.Equal("=1.2.3", NewRangeBetween(v, v, true, true).String())
+	for _, incl := range [][2]bool{{true, false}, {false, true}, {false, false}} {
+		r := NewRangeBetween(v, v, incl[0], incl[1])
+		is.False(r.Contains(v))
+		is.Equal(matchNone(), r.Requirements)
+	}
+
+	// Reversed bounds match nothing.
+	r := NewRangeBetween(hi, lo, true, true)
+	is.False(r.Contains(MustParse("1.5.0")))
+	is.False(r.Contains(MustParse("0.0.0-0")))
+	is.False(r.Contains(Max))
+
+	// Build metadata is dropped and pre-releases use plain precedence.
+	r = NewRangeBetween(MustParse("1.0.0+a"), MustParse("2.0.0+b"), true, false)
+	is.Equal(">=1.0.0 <2.0.0", r.String())
+	is.True(r.Contains(MustParse("1.5.0-rc.1")))
+	is.True(r.Contains(MustParse("2.0.0-rc.1")))
+}
+
+func TestNewRangeExact(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NewRangeExact(MustParse("1.2.3-rc.1+build"))
+	is.Equal("=1.2.3-rc.1", r.String())
+	is.True(r.Contains(MustParse("1.2.3-rc.1")))
+	is.True(r.Contains(MustParse("1.2.3-rc.1+other")))
+	is.False(r.Contains(MustParse("1.2.3")))
+	is.False(r.Contains(MustParse("1.2.3-rc.2")))
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// Bounds returns the lowest and highest bounds of the range's Intervals. Either bound is
+// unbounded if the range has no limit in that direction, as with "*" or ">=1.2.0".
+//
+// Returns false if the range matches no version.
+//
+// Example:
+//
+//	lower, upper, _ := semver.MustParseRange(">=1.2.0").Bounds()
+//	fmt.Println(lower.Version, upper.Unbounded) // Output: 1.2.0 true
+func (vr *VersionRange) Bounds() (lower, upper Bound, ok bool) {
+	ivs := vr.Intervals()
+	if len(ivs) == 0 {
+		return Bound{}, Bound{}, false
+	}
+	return ivs[0].Lower, ivs[len(ivs)-1].Upper, true
+}
+
+// MinVersion returns the lowest version that satisfies the range, as npm's minVersion does.
+//
+// An exclusive lower bound is raised to the next patch release, or for a pre-release, to the
+// next pre-release, so ">1.2.3" yields 1.2.4 and ">1.2.3-rc" yields 1.2.3-rc.0. A range with
+// no lower limit yields 0.0.0, or 0.0.0-0 if the range admits only pre-releases of it.
+//
+// Returns false if no such version can be derived, as when the range is empty.
+//
+// Example:
+//
+//	v, _ := semver.MustParseRange(">1.2.3 <2.0.0").MinVersion()
+//	fmt.Println(v) // Output: 1.2.4
+func (vr *VersionRange) MinVersion() (Version, bool) {
+	for _, iv := range vr.Intervals() {
+		for _, v := range iv.Lower.candidates() {
+			if vr.Contains(v) {
+				return v, true
+			}
+		}
+	}
+	return Version{}, false
+}
+
+// MaxVersion returns the highest version that satisfies the range.
+//
+// Only a range whose highest bound is inclusive has a highest version: infinitely many
+// pre-releases precede an exclusive upper bound such as "<2.0.0", and nothing limits an
+// unbounded one. In those cases, and if the range is empty, MaxVersion returns false; use
+// Bounds to inspect the limit instead.
+//
+// Example:
+//
+//	v, _ := semver.MustParseRange(">=1.0.0 <=1.4.2").MaxVersion()
+//	fmt.Println(v) // Output: 1.4.2
+func (vr *VersionRange) MaxVersion() (Version, bool) {
+	_, upper, ok := vr.Bounds()
+	if !ok || upper.Unbounded || !upper.Inclusive {
+		return Version{}, false
+	}
+	v := upper.Version
+	v.BuildMetadata = nil
+	if !vr.Contains(v) {
+		return Version{}, false
+	}
+	return v, true
+}
+
+// candidates returns the lowest versions that a lower bound admits, in ascending order.
+func (b Bound) candidates() []Version {
+	if b.Unbounded {
+		return []Version{Zero, minimalPrerelease(0, 0, 0)}
+	}
+
+	v := b.Version
+	v.BuildMetadata = nil
+	if !b.Inclusive {
+		if len(v.PreRelease) > 0 {
+			v.PreRelease = append(v.PreRelease[:len(v.PreRelease):len(v.PreRelease)], PrereleaseVersion{isNumeric: true})
+		} else {
+			v.Patch++
+			v.Revision = 0
+		}
+	}
+	return []Version{v}
+}
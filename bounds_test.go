@@ -0,0 +1,111 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionRangeOpenIntervals(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ivs := MustParseRange(">=1.2.0").Intervals()
+	is.Len(ivs, 1)
+	is.False(ivs[0].Lower.Unbounded)
+	is.True(ivs[0].Upper.Unbounded)
+	is.Equal(">=1.2.0", ivs[0].String())
+	is.Equal("1.2.0", ivs[0].Lower.String())
+	is.Equal("*", ivs[0].Upper.String())
+
+	ivs = MustParseRange(">=0.0.0-0").Intervals()
+	is.Len(ivs, 1)
+	is.True(ivs[0].Lower.Unbounded)
+	is.True(ivs[0].Upper.Unbounded)
+	is.True(ivs[0].Contains(Version{Epoch: 3}))
+
+	ivs = MustParseRange("<1.0.0 || >=2.0.0").Intervals()
+	is.Len(ivs, 2)
+	is.Equal("<1.0.0", ivs[0].String())
+	is.Equal(">=2.0.0", ivs[1].String())
+	is.True(ivs[0].Contains(MustParse("0.9.0")))
+	is.False(ivs[0].Contains(MustParse("1.0.0")))
+	is.False(ivs[0].IsEmpty())
+
+	r, err := HelmDialect.ParseRange("*")
+	is.NoError(err)
+	ivs = r.Intervals()
+	is.Len(ivs, 1)
+	is.True(ivs[0].Upper.Unbounded)
+}
+
+func TestVersionRangeBounds(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	lower, upper, ok := MustParseRange("~1.2.3 || ^3.0.0").Bounds()
+	is.True(ok)
+	is.Equal("1.2.3", lower.Version.String())
+	is.True(lower.Inclusive)
+	is.Equal("4.0.0-0", upper.Version.String())
+	is.False(upper.Inclusive)
+
+	lower, upper, ok = MustParseRange("<2.0.0").Bounds()
+	is.True(ok)
+	is.True(lower.Unbounded)
+	is.False(upper.Unbounded)
+
+	_, _, ok = MustParseRange(">2.0.0 <1.0.0").Bounds()
+	is.False(ok)
+}
+
+func TestVersionRangeMinVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		r    string
+		want string
+		ok   bool
+	}{
+		{">=1.2.3", "1.2.3", true},
+		{">1.2.3 <2.0.0", "1.2.4", true},
+		{">1.2.3-rc", "1.2.3-rc.0", true},
+		{"<2.0.0", "0.0.0", true},
+		{"<0.0.0", "0.0.0-0", true},
+		{"^1.2.3 || ~0.5.0", "0.5.0", true},
+		{">2.0.0 <1.0.0", "", false},
+		{"!=0.0.0 <0.0.0", "0.0.0-0", true},
+	}
+
+	for _, tc := range tests {
+		v, ok := MustParseRange(tc.r).MinVersion()
+		is.Equal(tc.ok, ok, tc.r)
+		if tc.ok {
+			is.Equal(tc.want, v.String(), tc.r)
+		}
+	}
+}
+
+func TestVersionRangeMaxVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v, ok := MustParseRange(">=1.0.0 <=1.4.2").MaxVersion()
+	is.True(ok)
+	is.Equal("1.4.2", v.String())
+
+	_, ok = MustParseRange(">=1.0.0 <2.0.0").MaxVersion()
+	is.False(ok)
+
+	_, ok = MustParseRange(">=1.0.0").MaxVersion()
+	is.False(ok)
+
+	_, ok = MustParseRange("<=1.4.2 !=1.4.2").MaxVersion()
+	is.False(ok)
+}
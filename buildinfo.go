@@ -0,0 +1,90 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"runtime/debug"
+	"strings"
+)
+
+// develVersion is the version the Go toolchain reports for a main module built without one.
+const develVersion = "(devel)"
+
+// readBuildInfo is the source of build information; it is replaced in tests.
+var readBuildInfo = debug.ReadBuildInfo
+
+// FromBuildInfo returns the version of the main module of the running binary, as recorded
+// by the Go toolchain.
+//
+// Pseudo-versions (e.g., "v0.0.0-20240101120000-abcdef123456") and "+incompatible" or
+// "+dirty" suffixes are valid semantic versions and are returned as parsed.
+//
+// Returns ErrNoBuildInfo if the binary has no build information, and ErrDevelVersion if the
+// main module was built without a version, as with "go run" or "go build" in a work tree
+// before Go 1.24.
+//
+// Example:
+//
+//	v, err := semver.FromBuildInfo()
+//	if err != nil {
+//	    log.Printf("version unknown: %v", err)
+//	}
+//	fmt.Println(v)
+func FromBuildInfo() (Version, error) {
+	info, ok := readBuildInfo()
+	if !ok || info == nil {
+		return Version{}, ErrNoBuildInfo
+	}
+	return ParseModuleVersion(info.Main.Version)
+}
+
+// ParseModuleVersion parses a Go module version such as "v1.2.3" into a Version.
+//
+// Example:
+//
+//	v, err := semver.ParseModuleVersion("v1.2.4-0.20240101120000-abcdef123456")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(semver.IsPseudoVersion(v)) // Output: true
+func ParseModuleVersion(version string) (Version, error) {
+	switch version {
+	case "":
+		return Version{}, ErrEmptyVersionString
+	case develVersion:
+		return Version{}, ErrDevelVersion
+	}
+	if !strings.HasPrefix(version, "v") {
+		return Version{}, ErrUnexpectedCharacter
+	}
+	return Parse(version[1:])
+}
+
+// IsPseudoVersion reports whether the version is a Go module pseudo-version, whose last
+// pre-release identifier is a 14-digit UTC timestamp and a 12-character commit hash prefix.
+//
+// Example:
+//
+//	v := semver.MustParse("0.0.0-20240101120000-abcdef123456")
+//	fmt.Println(semver.IsPseudoVersion(v)) // Output: true
+func IsPseudoVersion(v Version) bool {
+	if len(v.PreRelease) == 0 {
+		return false
+	}
+
+	last := v.PreRelease[len(v.PreRelease)-1].String()
+	timestamp, revision, ok := strings.Cut(last, "-")
+	if !ok || len(timestamp) != 14 || !isNumeric(timestamp) || len(revision) != 12 {
+		return false
+	}
+	for i := 0; i < len(revision); i++ {
+		ch := revision[i]
+		if !(ch >= '0' && ch <= '9') && !(ch >= 'a' && ch <= 'f') {
+			return false
+		}
+	}
+	return true
+}
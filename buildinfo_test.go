@@ -0,0 +1,90 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseModuleVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+		err      error
+	}{
+		{"v1.2.3", "1.2.3", nil},
+		{"v2.0.0+incompatible", "2.0.0+incompatible", nil},
+		{"v1.5.0+dirty", "1.5.0+dirty", nil},
+		{"v0.0.0-20240101120000-abcdef123456", "0.0.0-20240101120000-abcdef123456", nil},
+		{"(devel)", "", ErrDevelVersion},
+		{"", "", ErrEmptyVersionString},
+		{"1.2.3", "", ErrUnexpectedCharacter},
+	}
+
+	for _, test := range tests {
+		v, err := ParseModuleVersion(test.input)
+		if test.err != nil {
+			is.ErrorIs(err, test.err, "Input %q", test.input)
+			continue
+		}
+		is.NoError(err, "Input %q", test.input)
+		is.Equal(test.expected, v.String())
+	}
+}
+
+func TestIsPseudoVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		version  string
+		expected bool
+	}{
+		{"0.0.0-20240101120000-abcdef123456", true},
+		{"1.2.4-0.20240101120000-abcdef123456", true},
+		{"1.2.4-pre.0.20240101120000-abcdef123456+dirty", true},
+		{"1.2.3", false},
+		{"1.2.3-rc.1", false},
+		{"0.0.0-20240101120000-ABCDEF123456", false},
+		{"0.0.0-2024010112000-abcdef123456", false},
+	}
+
+	for _, test := range tests {
+		is.Equal(test.expected, IsPseudoVersion(MustParse(test.version)), "Version %s", test.version)
+	}
+}
+
+func TestFromBuildInfo(t *testing.T) {
+	is := assert.New(t)
+
+	original := readBuildInfo
+	defer func() { readBuildInfo = original }()
+
+	readBuildInfo = func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Main: debug.Module{Path: "example.com/app", Version: "v1.4.0"}}, true
+	}
+	v, err := FromBuildInfo()
+	is.NoError(err)
+	is.Equal("1.4.0", v.String())
+
+	readBuildInfo = func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Main: debug.Module{Path: "example.com/app", Version: "(devel)"}}, true
+	}
+	_, err = FromBuildInfo()
+	is.ErrorIs(err, ErrDevelVersion)
+
+	readBuildInfo = func() (*debug.BuildInfo, bool) {
+		return nil, false
+	}
+	_, err = FromBuildInfo()
+	is.ErrorIs(err, ErrNoBuildInfo)
+}
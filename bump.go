@@ -0,0 +1,75 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// BumpLevel identifies the version component to increment.
+//
+// Supported Levels:
+//   - BumpPatch: Increment the patch version.
+//   - BumpMinor: Increment the minor version and reset the patch version.
+//   - BumpMajor: Increment the major version and reset the minor and patch versions.
+type BumpLevel int
+
+const (
+	BumpPatch BumpLevel = iota
+	BumpMinor
+	BumpMajor
+)
+
+// String returns the string representation of the BumpLevel.
+//
+// Example:
+//
+//	fmt.Println(semver.BumpMinor.String()) // Output: minor
+func (l BumpLevel) String() string {
+	switch l {
+	case BumpPatch:
+		return "patch"
+	case BumpMinor:
+		return "minor"
+	case BumpMajor:
+		return "major"
+	default:
+		return "unknown"
+	}
+}
+
+// Bump returns the next release version at the given level. Pre-release identifiers and
+// build metadata are dropped, the Epoch is kept, and the Revision is reset along with the
+// other components below the bumped one.
+//
+// As with any release, bumping a pre-release of the same level releases it rather than
+// skipping past it: "1.3.0-rc.1" bumped by BumpMinor is "1.3.0", while "1.2.5" is "1.3.0".
+// A pre-release with a Revision is a pre-release of that revision, so "1.2.3.4-rc.1" bumped
+// by BumpPatch is "1.2.4".
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3-beta")
+//	fmt.Println(v.Bump(semver.BumpPatch)) // Output: 1.2.3
+//	fmt.Println(v.Bump(semver.BumpMinor)) // Output: 1.3.0
+func (v Version) Bump(level BumpLevel) Version {
+	// A pre-release with a revision lies above the release of its patch version.
+	isPrerelease := len(v.PreRelease) > 0 && v.Revision == 0
+
+	switch level {
+	case BumpMajor:
+		if isPrerelease && v.Minor == 0 && v.Patch == 0 {
+			return Version{Epoch: v.Epoch, Major: v.Major}
+		}
+		return Version{Epoch: v.Epoch, Major: v.Major + 1}
+	case BumpMinor:
+		if isPrerelease && v.Patch == 0 {
+			return Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor}
+		}
+		return Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor + 1}
+	default:
+		if isPrerelease {
+			return Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+		}
+		return Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+	}
+}
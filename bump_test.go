@@ -0,0 +1,97 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionBump(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		version  string
+		level    BumpLevel
+		expected string
+	}{
+		{"1.2.3", BumpPatch, "1.2.4"},
+		{"1.2.3", BumpMinor, "1.3.0"},
+		{"1.2.3", BumpMajor, "2.0.0"},
+		{"1.2.3+build.1", BumpPatch, "1.2.4"},
+		{"1.2.3-beta", BumpPatch, "1.2.3"},
+		{"1.2.3-beta", BumpMinor, "1.3.0"},
+		{"1.3.0-rc.1", BumpMinor, "1.3.0"},
+		{"1.3.0-rc.1", BumpMajor, "2.0.0"},
+		{"2.0.0-rc.1", BumpMajor, "2.0.0"},
+		{"2.0.0-rc.1", BumpMinor, "2.0.0"},
+	}
+
+	for _, test := range tests {
+		is.Equal(test.expected, MustParse(test.version).Bump(test.level).String(), "Bumping %s by %s", test.version, test.level)
+	}
+	is.Equal("major", BumpMajor.String())
+	is.Equal("unknown", BumpLevel(9).String())
+}
+
+func TestVersionBumpEpoch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithEpoch(true))
+	is.NoError(err)
+
+	tests := []struct {
+		version  string
+		level    BumpLevel
+		expected string
+	}{
+		{"2!1.0.0-rc.1", BumpMajor, "2!1.0.0"},
+		{"2!1.2.3", BumpMajor, "2!2.0.0"},
+		{"2!1.2.3", BumpMinor, "2!1.3.0"},
+		{"2!1.2.3-beta", BumpPatch, "2!1.2.3"},
+	}
+
+	for _, test := range tests {
+		v, err := p.Parse(test.version)
+		is.NoError(err, "Input %s", test.version)
+
+		bumped := v.Bump(test.level)
+		is.Equal(test.expected, bumped.String(), "Bumping %s by %s", test.version, test.level)
+		is.True(bumped.GreaterThan(v), "Bumping %s by %s", test.version, test.level)
+	}
+}
+
+func TestVersionBumpRevision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithRevision(true))
+	is.NoError(err)
+
+	tests := []struct {
+		version  string
+		level    BumpLevel
+		expected string
+	}{
+		{"1.2.3.4", BumpPatch, "1.2.4"},
+		{"1.2.3.4-rc.1", BumpPatch, "1.2.4"},
+		{"1.3.0.4-rc.1", BumpMinor, "1.4.0"},
+		{"2.0.0.1-beta", BumpMajor, "3.0.0"},
+		{"1.2.3.4", BumpMajor, "2.0.0"},
+	}
+
+	for _, test := range tests {
+		v, err := p.Parse(test.version)
+		is.NoError(err, "Input %s", test.version)
+
+		bumped := v.Bump(test.level)
+		is.Equal(test.expected, bumped.String(), "Bumping %s by %s", test.version, test.level)
+		is.True(bumped.GreaterThan(v), "Bumping %s by %s", test.version, test.level)
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidCanonicalRange indicates that a string is not a valid canonical range, or that a
+// range cannot be written in canonical form.
+var ErrInvalidCanonicalRange = errors.New("invalid canonical range")
+
+// CanonicalRangeVersion is the version of the canonical range syntax written by
+// VersionRange.Canonical. ParseCanonicalRange reads this and every earlier version.
+const CanonicalRangeVersion = 1
+
+// canonicalEmptyBranch stands for an OR branch without requirements, which matches every version.
+const canonicalEmptyBranch = "*"
+
+// Canonical returns the range in the canonical range syntax, a stable serialization for
+// persisting policies: ParseCanonicalRange returns a range with the same requirements, in the
+// same order, and the same PrereleasePolicy, and the output for a given range does not change
+// across releases of this package. Unlike String, the canonical form records the
+// PrereleasePolicy, and unlike Fingerprint, it keeps the requirements as written rather than
+// simplified.
+//
+// The syntax, version 1, is:
+//
+//	<canonical range> ::= "v1;" <policy> ";" [ <branch> *( " || " <branch> ) ]
+//	<policy>          ::= "inclusive" | "excluded" | "opt-in"
+//	<branch>          ::= "*" | <requirement> *( " " <requirement> )
+//	<requirement>     ::= <operator> <version>
+//	<operator>        ::= "=" | "!=" | ">" | ">=" | "<" | "<=" | "^" | "~"
+//	<version>         ::= [ <epoch> "!" ] <valid semver core> [ "." <revision> ] [ "-" <pre-release> ] [ "+" <build> ]
+//
+// where "*" is a branch without requirements, and the epoch and revision are present only when
+// non-zero, as written by Version.String.
+//
+// Returns an error wrapping ErrInvalidCanonicalRange if a requirement has an undefined
+// operator, if the range has an undefined PrereleasePolicy, or if a version fails Check.
+//
+// Example:
+//
+//	r := semver.MustParseRange(">=1.0.0 <2.0.0 || ^3.1.0")
+//	r.Prerelease = semver.PrereleaseExcluded
+//	s, _ := r.Canonical()
+//	fmt.Println(s) // Output: v1;excluded;>=1.0.0 <2.0.0 || ^3.1.0
+func (vr *VersionRange) Canonical() (string, error) {
+	var sb strings.Builder
+	sb.WriteString("v" + strconv.Itoa(CanonicalRangeVersion) + ";")
+
+	switch vr.Prerelease {
+	case PrereleaseInclusive, PrereleaseExcluded, PrereleaseOptIn:
+		sb.WriteString(vr.Prerelease.String() + ";")
+	default:
+		return "", fmt.Errorf("%w: undefined pre-release policy %d", ErrInvalidCanonicalRange, vr.Prerelease)
+	}
+
+	for i, andReqs := range vr.Requirements {
+		if i > 0 {
+			sb.WriteString(" || ")
+		}
+		if len(andReqs) == 0 {
+			sb.WriteString(canonicalEmptyBranch)
+			continue
+		}
+		for j, req := range andReqs {
+			if !req.Op.IsValid() {
+				return "", fmt.Errorf("%w: %w: %q", ErrInvalidCanonicalRange, ErrUnknownOperator, req.Op)
+			}
+			if err := req.Ver.Check(); err != nil {
+				return "", fmt.Errorf("%w: %s: %w", ErrInvalidCanonicalRange, req, err)
+			}
+			if j > 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(req.String())
+		}
+	}
+	return sb.String(), nil
+}
+
+// CanonicalDigest returns "sha256:" followed by the hex-encoded SHA-256 digest of the range's
+// canonical form, which identifies a stored policy exactly. Use Fingerprint instead to identify
+// ranges that are merely equivalent.
+//
+// Returns the error of Canonical if the range cannot be written in canonical form.
+//
+// Example:
+//
+//	d, _ := semver.MustParseRange("^1.2.3").CanonicalDigest()
+//	fmt.Println(len(d)) // Output: 71
+func (vr *VersionRange) CanonicalDigest() (string, error) {
+	s, err := vr.Canonical()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(s))
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// ParseCanonicalRange parses a range written by VersionRange.Canonical. Unlike ParseRange, it
+// accepts only the canonical syntax, with no optional whitespace or operators.
+//
+// Returns an error wrapping ErrInvalidCanonicalRange if the string is malformed or written in a
+// later version of the syntax.
+//
+// Example:
+//
+//	r, err := semver.ParseCanonicalRange("v1;opt-in;^1.2.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Prerelease, r) // Output: opt-in ^1.2.0
+func ParseCanonicalRange(s string) (*VersionRange, error) {
+	version, rest, ok := strings.Cut(s, ";")
+	if !ok {
+		return nil, fmt.Errorf("%w: missing version", ErrInvalidCanonicalRange)
+	}
+	if version != "v"+strconv.Itoa(CanonicalRangeVersion) {
+		return nil, fmt.Errorf("%w: unsupported version %q", ErrInvalidCanonicalRange, version)
+	}
+
+	policy, expr, ok := strings.Cut(rest, ";")
+	if !ok {
+		return nil, fmt.Errorf("%w: missing pre-release policy", ErrInvalidCanonicalRange)
+	}
+
+	vr := &VersionRange{}
+	switch policy {
+	case PrereleaseInclusive.String():
+		vr.Prerelease = PrereleaseInclusive
+	case PrereleaseExcluded.String():
+		vr.Prerelease = PrereleaseExcluded
+	case PrereleaseOptIn.String():
+		vr.Prerelease = PrereleaseOptIn
+	default:
+		return nil, fmt.Errorf("%w: unknown pre-release policy %q", ErrInvalidCanonicalRange, policy)
+	}
+	if expr == "" {
+		return vr, nil
+	}
+
+	for _, branch := range strings.Split(expr, " || ") {
+		if branch == canonicalEmptyBranch {
+			vr.Requirements = append(vr.Requirements, []Requirement{})
+			continue
+		}
+
+		var reqs []Requirement
+		for _, token := range strings.Split(branch, " ") {
+			req, err := parseCanonicalRequirement(token)
+			if err != nil {
+				return nil, err
+			}
+			reqs = append(reqs, req)
+		}
+		vr.Requirements = append(vr.Requirements, reqs)
+	}
+	return vr, nil
+}
+
+// ParseStoredRange parses a range persisted by any release of this package: the canonical form
+// written by VersionRange.Canonical, or the ParseRange syntax written by VersionRange.String
+// before the canonical form existed. Ranges in the older syntax use PrereleaseInclusive, since
+// String does not record the policy.
+//
+// Use MigrateStoredRange to rewrite stored ranges in the current canonical form.
+//
+// Example:
+//
+//	r, err := semver.ParseStoredRange(">=1.0.0 <2.0.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	s, _ := r.Canonical()
+//	fmt.Println(s) // Output: v1;inclusive;>=1.0.0 <2.0.0
+func ParseStoredRange(s string) (*VersionRange, error) {
+	if strings.Contains(s, ";") {
+		return ParseCanonicalRange(s)
+	}
+	return ParseRange(s)
+}
+
+// MigrateStoredRange rewrites a range persisted in any form accepted by ParseStoredRange in the
+// current canonical form. Strings already in the current canonical form are returned unchanged.
+//
+// Example:
+//
+//	s, err := semver.MigrateStoredRange("^1.2.3 || >=3.0.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(s) // Output: v1;inclusive;^1.2.3 || >=3.0.0
+func MigrateStoredRange(s string) (string, error) {
+	vr, err := ParseStoredRange(s)
+	if err != nil {
+		return "", err
+	}
+	return vr.Canonical()
+}
+
+// parseCanonicalRequirement parses a canonical requirement: an operator followed directly by a
+// version as written by Version.String.
+func parseCanonicalRequirement(token string) (Requirement, error) {
+	i := 0
+	for i < len(token) && strings.IndexByte("=!<>^~", token[i]) >= 0 {
+		i++
+	}
+	op := Operator(token[:i])
+	if !op.IsValid() {
+		return Requirement{}, fmt.Errorf("%w: %w: %q", ErrInvalidCanonicalRange, ErrUnknownOperator, token[:i])
+	}
+
+	v, err := parseCanonicalVersion(token[i:])
+	if err != nil {
+		return Requirement{}, fmt.Errorf("%w: %q: %w", ErrInvalidCanonicalRange, token, err)
+	}
+	return Requirement{Op: op, Ver: v}, nil
+}
+
+// parseCanonicalVersion parses a version as written by Version.String, including the non-zero
+// epoch and revision that Parse does not accept.
+func parseCanonicalVersion(s string) (Version, error) {
+	var epoch, revision uint64
+	if prefix, rest, ok := strings.Cut(s, "!"); ok {
+		n, err := parseCanonicalNumber(prefix)
+		if err != nil {
+			return Version{}, err
+		}
+		epoch, s = n, rest
+	}
+
+	core, suffix := s, ""
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		core, suffix = s[:i], s[i:]
+	}
+	if parts := strings.Split(core, "."); len(parts) == 4 {
+		n, err := parseCanonicalNumber(parts[3])
+		if err != nil {
+			return Version{}, err
+		}
+		revision, core = n, strings.Join(parts[:3], ".")
+	}
+
+	v, err := Parse(core + suffix)
+	if err != nil {
+		return Version{}, err
+	}
+	v.Epoch, v.Revision = epoch, revision
+	return v, nil
+}
+
+// parseCanonicalNumber parses a non-zero epoch or revision without leading zeros, as written by
+// Version.String, which omits zero epochs and revisions.
+func parseCanonicalNumber(s string) (uint64, error) {
+	if s == "" || s[0] == '0' || !isNumeric(s) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidNumericIdentifier, s)
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalRoundTrip(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ranges := []*VersionRange{
+		MustParseRange(">=1.0.0 <2.0.0 || ^3.1.0"),
+		MustParseRange("~1.2.3 !=1.2.5-rc.1+build.7"),
+		MustParseRange("<2.0.0 >=1.0.0 >=0.5.0"),
+		{Requirements: [][]Requirement{{}, {{Op: OpEq, Ver: Version{Epoch: 2, Major: 1, Revision: 4, BuildMetadata: []string{"x"}}}}}},
+		{Prerelease: PrereleaseOptIn},
+		{Requirements: [][]Requirement{{{Op: OpLte, Ver: MustParse("1.0.0-alpha.1")}}}, Prerelease: PrereleaseExcluded},
+	}
+
+	for _, vr := range ranges {
+		s, err := vr.Canonical()
+		if !is.NoError(err) {
+			continue
+		}
+
+		parsed, err := ParseCanonicalRange(s)
+		if !is.NoError(err, s) {
+			continue
+		}
+		is.Equal(vr.Prerelease, parsed.Prerelease, s)
+		is.Len(parsed.Requirements, len(vr.Requirements), s)
+		for i := range vr.Requirements {
+			is.Len(parsed.Requirements[i], len(vr.Requirements[i]), s)
+			for j, req := range vr.Requirements[i] {
+				got := parsed.Requirements[i][j]
+				is.Equal(req.Op, got.Op, s)
+				is.True(req.Ver.StrictEqual(got.Ver), s)
+			}
+		}
+
+		again, err := parsed.Canonical()
+		is.NoError(err)
+		is.Equal(s, again)
+	}
+}
+
+func TestCanonicalFormat(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	s, err := MustParseRange(">=1.0.0  <2.0.0||^3.1.0").Canonical()
+	is.NoError(err)
+	is.Equal("v1;inclusive;>=1.0.0 <2.0.0 || ^3.1.0", s)
+
+	s, err = (&VersionRange{Requirements: [][]Requirement{{}}}).Canonical()
+	is.NoError(err)
+	is.Equal("v1;inclusive;*", s)
+
+	_, err = (&VersionRange{Prerelease: 7}).Canonical()
+	is.ErrorIs(err, ErrInvalidCanonicalRange)
+
+	_, err = (&VersionRange{Requirements: [][]Requirement{{{Op: "=>", Ver: MustParse("1.0.0")}}}}).Canonical()
+	is.ErrorIs(err, ErrInvalidCanonicalRange)
+	is.ErrorIs(err, ErrUnknownOperator)
+
+	bad := Version{Major: 1, PreRelease: []PrereleaseVersion{{partString: ""}}}
+	_, err = (&VersionRange{Requirements: [][]Requirement{{{Op: OpEq, Ver: bad}}}}).Canonical()
+	is.ErrorIs(err, ErrInvalidCanonicalRange)
+}
+
+func TestCanonicalDigest(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	a, err := MustParseRange("^1.2.3").CanonicalDigest()
+	is.NoError(err)
+	is.True(strings.HasPrefix(a, "sha256:"))
+	is.Len(a, 71)
+
+	// The digest is pinned: a change breaks every stored digest.
+	is.Equal("sha256:277c4bc473f45ee029ae3f0ba05700df0b80fa25576b28c9538d26b7a49d3978", a)
+
+	b, err := MustParseRange("^1.2.3").CanonicalDigest()
+	is.NoError(err)
+	is.Equal(a, b)
+
+	excluded := MustParseRange("^1.2.3")
+	excluded.Prerelease = PrereleaseExcluded
+	c, err := excluded.CanonicalDigest()
+	is.NoError(err)
+	is.NotEqual(a, c)
+
+	_, err = (&VersionRange{Prerelease: 7}).CanonicalDigest()
+	is.ErrorIs(err, ErrInvalidCanonicalRange)
+}
+
+func TestParseCanonicalRangeErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, s := range []string{
+		"",
+		">=1.0.0",
+		"v2;inclusive;>=1.0.0",
+		"v1;>=1.0.0",
+		"v1;sometimes;>=1.0.0",
+		"v1;inclusive;>= 1.0.0",
+		"v1;inclusive;>=1.0.0  <2.0.0",
+		"v1;inclusive;1.0.0",
+		"v1;inclusive;=>1.0.0",
+		"v1;inclusive;>=1.0",
+		"v1;inclusive;>=1.0.0 ||",
+		"v1;inclusive;=0!1.0.0",
+		"v1;inclusive;=1.0.0.0",
+		"v1;inclusive;=1.0.0.01",
+	} {
+		_, err := ParseCanonicalRange(s)
+		is.ErrorIs(err, ErrInvalidCanonicalRange, s)
+	}
+}
+
+func TestParseStoredRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r, err := ParseStoredRange(">=1.0.0 <2.0.0")
+	is.NoError(err)
+	is.Equal(PrereleaseInclusive, r.Prerelease)
+	is.Equal(">=1.0.0 <2.0.0", r.String())
+
+	r, err = ParseStoredRange("v1;opt-in;^1.2.0")
+	is.NoError(err)
+	is.Equal(PrereleaseOptIn, r.Prerelease)
+
+	s, err := MigrateStoredRange("^1.2.3  ||  >=3.0.0")
+	is.NoError(err)
+	is.Equal("v1;inclusive;^1.2.3 || >=3.0.0", s)
+
+	s, err = MigrateStoredRange("v1;excluded;^1.2.3")
+	is.NoError(err)
+	is.Equal("v1;excluded;^1.2.3", s)
+
+	_, err = MigrateStoredRange("v9;inclusive;^1.2.3")
+	is.ErrorIs(err, ErrInvalidCanonicalRange)
+	_, err = MigrateStoredRange("not a range")
+	is.Error(err)
+}
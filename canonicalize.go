@@ -0,0 +1,242 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// canonicalOperators lists the operators accepted in manifest constraints, longest first so
+// that prefixes match greedily.
+var canonicalOperators = []string{">=", "<=", "!=", "==", ">", "<", "=", "!", "^", "~"}
+
+// CanonicalChange is an entry that Canonicalize rewrote.
+//
+// Line is the 1-based line number of the entry in a newline-separated manifest, or its 1-based
+// position in a JSON array.
+type CanonicalChange struct {
+	Line      int
+	Original  string
+	Canonical string
+}
+
+// CanonicalizeReport summarizes the changes made by CanonicalizeManifest or CanonicalizeFile.
+type CanonicalizeReport struct {
+	Path    string
+	Entries int
+	Changes []CanonicalChange
+}
+
+// Changed reports whether any entry was rewritten.
+func (r *CanonicalizeReport) Changed() bool {
+	return len(r.Changes) > 0
+}
+
+// String returns a diff summary listing every rewritten entry.
+//
+// Example:
+//
+//	_, report, _ := semver.CanonicalizeManifest([]byte("v1.2\n>=1.0.0\n"))
+//	fmt.Print(report)
+//
+// Output:
+// 1 of 2 entries changed
+// line 1: v1.2 -> 1.2.0
+func (r *CanonicalizeReport) String() string {
+	var sb strings.Builder
+	if r.Path != "" {
+		sb.WriteString(r.Path)
+		sb.WriteString(": ")
+	}
+	fmt.Fprintf(&sb, "%d of %d entries changed\n", len(r.Changes), r.Entries)
+	for _, c := range r.Changes {
+		fmt.Fprintf(&sb, "line %d: %s -> %s\n", c.Line, c.Original, c.Canonical)
+	}
+	return sb.String()
+}
+
+// CanonicalizeFile canonicalizes the manifest at path, as described by CanonicalizeManifest, and
+// writes it back if any entry changed. The file's permissions are preserved. If any entry is
+// invalid, the file is left untouched and the error identifies every invalid entry.
+//
+// This is intended for pre-commit hooks that keep large manifests normalized.
+//
+// Example:
+//
+//	report, err := semver.CanonicalizeFile("versions.txt")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if report.Changed() {
+//	    fmt.Print(report)
+//	}
+func CanonicalizeFile(path string) (*CanonicalizeReport, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	out, report, err := CanonicalizeManifest(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	report.Path = path
+
+	if report.Changed() {
+		if err := os.WriteFile(path, out, info.Mode().Perm()); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// CanonicalizeManifest canonicalizes every entry of a manifest of versions and constraints and
+// returns the rewritten manifest along with a report of the changes.
+//
+// A manifest whose first non-space character is "[" is a JSON array of strings and is rewritten
+// as an indented JSON array. Otherwise it holds one entry per line; blank lines and lines
+// starting with "#" are kept as they are, and other lines are trimmed.
+//
+// Versions are coerced with ParseLenient and written in their canonical form. Constraints have
+// each of their versions coerced the same way, "==" and "!" spelled as "=" and "!=", exact
+// versions written without an operator, and their requirements separated by single spaces, and
+// must then be valid for ParseRange.
+//
+// If any entry is invalid, CanonicalizeManifest returns an Errors holding one *PositionedError
+// per invalid entry, whose Line is the entry's line, or its 1-based position in a JSON array.
+//
+// Example:
+//
+//	out, report, err := semver.CanonicalizeManifest([]byte(`["v1.2", ">= 1.0 <02.0.0"]`))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(len(report.Changes)) // Output: 2
+//	fmt.Print(string(out))
+//	// [
+//	//   "1.2.0",
+//	//   ">=1.0.0 <2.0.0"
+//	// ]
+func CanonicalizeManifest(data []byte) ([]byte, *CanonicalizeReport, error) {
+	report := &CanonicalizeReport{}
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var entries []string
+		if err := json.Unmarshal(trimmed, &entries); err != nil {
+			return nil, nil, err
+		}
+		var errs Errors
+		for i, entry := range entries {
+			canonical, err := canonicalizeEntry(entry, i+1, report)
+			if err != nil {
+				errs = append(errs, &PositionedError{Index: i, Line: i + 1, Input: entry, Err: err})
+				continue
+			}
+			entries[i] = canonical
+		}
+		if len(errs) > 0 {
+			return nil, nil, errs
+		}
+
+		var out bytes.Buffer
+		enc := json.NewEncoder(&out)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(entries); err != nil {
+			return nil, nil, err
+		}
+		return out.Bytes(), report, nil
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+	var sb strings.Builder
+	sb.Grow(len(data))
+	var errs Errors
+	for i, line := range lines {
+		content, eol := strings.CutSuffix(line, "\n")
+		content = strings.TrimSuffix(content, "\r")
+		entry := strings.TrimSpace(content)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			sb.WriteString(line)
+			continue
+		}
+
+		canonical, err := canonicalizeEntry(entry, i+1, report)
+		if err != nil {
+			errs = append(errs, &PositionedError{Index: i, Line: i + 1, Input: entry, Err: err})
+			continue
+		}
+		sb.WriteString(canonical)
+		if eol {
+			sb.WriteString(line[len(content):])
+		}
+	}
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+	return []byte(sb.String()), report, nil
+}
+
+// canonicalizeEntry returns the canonical form of a version or constraint, recording a change
+// in report if it differs from the entry.
+func canonicalizeEntry(entry string, line int, report *CanonicalizeReport) (string, error) {
+	report.Entries++
+
+	canonical, err := canonicalEntry(entry)
+	if err != nil {
+		return "", err
+	}
+	if canonical != entry {
+		report.Changes = append(report.Changes, CanonicalChange{Line: line, Original: entry, Canonical: canonical})
+	}
+	return canonical, nil
+}
+
+// canonicalEntry returns the canonical form of a version or, failing that, a constraint.
+func canonicalEntry(entry string) (string, error) {
+	if v, _, err := ParseLenient(entry); err == nil {
+		return v.String(), nil
+	}
+
+	var branches []string
+	for _, part := range strings.Split(entry, "||") {
+		tokens := joinOperatorTokens(strings.Fields(part), canonicalOperators)
+		if len(tokens) == 0 {
+			return "", invalidRangeToken(entry)
+		}
+
+		reqs := make([]string, len(tokens))
+		for i, token := range tokens {
+			op, rest := splitOperator(token, canonicalOperators)
+			v, _, err := ParseLenient(rest)
+			if err != nil {
+				return "", invalidRangeToken(token)
+			}
+			switch op {
+			case "", "=", "==":
+				op = ""
+			case "!":
+				op = string(OpNeq)
+			}
+			reqs[i] = op + v.String()
+		}
+		branches = append(branches, strings.Join(reqs, " "))
+	}
+
+	canonical := strings.Join(branches, " || ")
+	if _, err := ParseRange(canonical); err != nil {
+		return "", err
+	}
+	return canonical, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalizeManifestLines(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := "# pinned versions\r\n  v1.2\r\n\r\n1.4.0-rc.01\n>= 1.0 <02.0.0 || == 3 !4.0.1\n^1.2.3\n=1.2.3\n2.0.0"
+	out, report, err := CanonicalizeManifest([]byte(input))
+	is.NoError(err)
+	is.Equal("# pinned versions\r\n1.2.0\r\n\r\n1.4.0-rc.1\n>=1.0.0 <2.0.0 || 3.0.0 !=4.0.1\n^1.2.3\n1.2.3\n2.0.0", string(out))
+
+	is.Equal(6, report.Entries)
+	is.True(report.Changed())
+	is.Equal([]CanonicalChange{
+		{Line: 2, Original: "v1.2", Canonical: "1.2.0"},
+		{Line: 4, Original: "1.4.0-rc.01", Canonical: "1.4.0-rc.1"},
+		{Line: 5, Original: ">= 1.0 <02.0.0 || == 3 !4.0.1", Canonical: ">=1.0.0 <2.0.0 || 3.0.0 !=4.0.1"},
+		{Line: 7, Original: "=1.2.3", Canonical: "1.2.3"},
+	}, report.Changes)
+	is.Equal("4 of 6 entries changed\n"+
+		"line 2: v1.2 -> 1.2.0\n"+
+		"line 4: 1.4.0-rc.01 -> 1.4.0-rc.1\n"+
+		"line 5: >= 1.0 <02.0.0 || == 3 !4.0.1 -> >=1.0.0 <2.0.0 || 3.0.0 !=4.0.1\n"+
+		"line 7: =1.2.3 -> 1.2.3\n", report.String())
+
+	out, report, err = CanonicalizeManifest(out)
+	is.NoError(err)
+	is.False(report.Changed(), "Canonical output must be stable")
+	is.Equal("# pinned versions\r\n1.2.0\r\n\r\n1.4.0-rc.1\n>=1.0.0 <2.0.0 || 3.0.0 !=4.0.1\n^1.2.3\n1.2.3\n2.0.0", string(out))
+}
+
+func TestCanonicalizeManifestJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	out, report, err := CanonicalizeManifest([]byte(` ["v1.2", ">= 1.0 <02.0.0", "1.0.0"]`))
+	is.NoError(err)
+	is.Equal("[\n  \"1.2.0\",\n  \">=1.0.0 <2.0.0\",\n  \"1.0.0\"\n]\n", string(out))
+	is.Equal(3, report.Entries)
+	is.Len(report.Changes, 2)
+	is.Equal(2, report.Changes[1].Line)
+
+	_, _, err = CanonicalizeManifest([]byte(`["1.0.0", 2]`))
+	is.Error(err)
+}
+
+func TestCanonicalizeManifestErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, input := range []string{"1.0.0\nnot-a-version\n", "1.0.0 ||", ">=1.0.0 <abc", `["1.x"]`} {
+		_, _, err := CanonicalizeManifest([]byte(input))
+		is.Error(err, "Input %q", input)
+	}
+
+	_, _, err := CanonicalizeManifest([]byte("1.0.0\n>=1.0.0 <abc\n"))
+	is.ErrorContains(err, "line 2")
+	is.ErrorIs(err, ErrInvalidRangeToken)
+
+	// Every invalid entry is reported, not only the first.
+	_, _, err = CanonicalizeManifest([]byte("bogus\n1.0.0\n# comment\n>=1.0.0 <abc\n"))
+	var errs Errors
+	if is.True(errors.As(err, &errs)) {
+		is.Len(errs, 2)
+		is.Equal(1, errs[0].Line)
+		is.Equal("bogus", errs[0].Input)
+		is.Equal(4, errs[1].Line)
+		is.Equal(3, errs[1].Index)
+	}
+
+	_, _, err = CanonicalizeManifest([]byte(`["1.0.0", "bogus", "1.x"]`))
+	if is.True(errors.As(err, &errs)) {
+		is.Len(errs, 2)
+		is.Equal(1, errs[0].Index)
+		is.Equal(2, errs[0].Line)
+	}
+}
+
+func TestCanonicalizeFile(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "versions.txt")
+	is.NoError(os.WriteFile(path, []byte("v1.2\n1.0.0\n"), 0o640))
+
+	report, err := CanonicalizeFile(path)
+	is.NoError(err)
+	is.Equal(path, report.Path)
+	is.Equal(path+": 1 of 2 entries changed\nline 1: v1.2 -> 1.2.0\n", report.String())
+
+	data, err := os.ReadFile(path)
+	is.NoError(err)
+	is.Equal("1.2.0\n1.0.0\n", string(data))
+
+	info, err := os.Stat(path)
+	is.NoError(err)
+	is.Equal(os.FileMode(0o640), info.Mode().Perm())
+
+	invalid := filepath.Join(dir, "invalid.txt")
+	is.NoError(os.WriteFile(invalid, []byte("v1.2\nbogus\n"), 0o600))
+	_, err = CanonicalizeFile(invalid)
+	is.ErrorContains(err, invalid+": line 2")
+	data, err = os.ReadFile(invalid)
+	is.NoError(err)
+	is.Equal("v1.2\nbogus\n", string(data), "Invalid manifests must not be modified")
+
+	_, err = CanonicalizeFile(filepath.Join(dir, "missing.txt"))
+	is.ErrorIs(err, os.ErrNotExist)
+}
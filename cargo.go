@@ -0,0 +1,134 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// CargoDialect parses version requirements the way Cargo does, for tooling that reads or
+// writes the dependencies of Rust crates.
+//
+// Supported syntax:
+//   - Bare versions are caret requirements: "1.2" is "^1.2"
+//   - Comparisons: "=", ">", ">=", "<", "<="
+//   - Caret and tilde: "^1.2.3", "~1.2"
+//   - Wildcards and partial versions: "1.*", "1.2.*", "1.2", "*"
+//   - AND with commas; there is no OR
+//
+// Partial versions expand as in Cargo, so "=1.2" is ">=1.2.0 <1.3.0-0" and ">1.2" is ">=1.3.0".
+// Ranges use PrereleaseOptIn: a pre-release version only matches a requirement whose own
+// version carries a pre-release.
+//
+// Example:
+//
+//	r, err := semver.CargoDialect.ParseRange("1.2, <1.5")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.4.9"))) // Output: true
+var CargoDialect Dialect = cargoDialect{}
+
+type cargoDialect struct{}
+
+// cargoOperators lists the Cargo operators, longest first so that prefixes match greedily.
+var cargoOperators = []string{">=", "<=", ">", "<", "=", "~", "^"}
+
+// Name returns the name of the dialect.
+func (cargoDialect) Name() string {
+	return "cargo"
+}
+
+// ParseVersion parses a Cargo version, which must be a complete Semantic Versioning version.
+func (cargoDialect) ParseVersion(v string) (Version, error) {
+	return Parse(strings.TrimSpace(v))
+}
+
+// FormatRange formats a range as a Cargo requirement, which can hold a single interval only.
+// Caret and tilde requirements are written in shorthand, a caret as a bare version, and an
+// exact version is written with "=".
+func (cargoDialect) FormatRange(r *VersionRange) (string, error) {
+	iv, err := singleInterval(r, "cargo")
+	if err != nil {
+		return "", err
+	}
+
+	if iv.Upper.Unbounded && !iv.Lower.Unbounded && iv.Lower.Inclusive && iv.Lower.Version.Compare(Version{}) == 0 {
+		return "*", nil
+	}
+	if caret, ok := shorthandRequirement(iv, OpCaret); ok {
+		return caret.Ver.String(), nil
+	}
+	if tilde, ok := shorthandRequirement(iv, OpTilde); ok {
+		return tilde.String(), nil
+	}
+
+	reqs := iv.requirements()
+	parts := make([]string, len(reqs))
+	for i, req := range reqs {
+		req.Ver.BuildMetadata = nil
+		parts[i] = req.String()
+	}
+	return strings.Join(parts, ", "), nil
+}
+
+// ParseRange parses a Cargo version requirement into a VersionRange.
+func (cargoDialect) ParseRange(r string) (*VersionRange, error) {
+	vr := &VersionRange{
+		Prerelease: PrereleaseOptIn,
+	}
+
+	var group [][]Requirement
+	for _, token := range strings.Split(r, ",") {
+		reqs, err := parseCargoToken(strings.TrimSpace(token))
+		if err != nil {
+			return nil, err
+		}
+		group = andRequirements(group, reqs)
+	}
+
+	vr.Requirements = group
+	return vr, nil
+}
+
+// parseCargoToken parses a single Cargo comparator.
+func parseCargoToken(token string) ([][]Requirement, error) {
+	op, rest := splitOperator(token, cargoOperators)
+
+	p, err := parsePartialVersion(rest)
+	if err != nil {
+		return nil, invalidRangeToken(token)
+	}
+
+	switch op {
+	case "":
+		if p.parts < 3 && strings.ContainsAny(rest, "*xX") {
+			return expandComparison(OpEq, p), nil
+		}
+		return expandCaret(p), nil
+	case "^":
+		return expandCaret(p), nil
+	case "~":
+		return expandTilde(p), nil
+	case "=":
+		return expandComparison(OpEq, p), nil
+	default:
+		return expandComparison(Operator(op), p), nil
+	}
+}
+
+// ParseCargoRange parses a Cargo version requirement into a VersionRange.
+//
+// Example:
+//
+//	r, err := semver.ParseCargoRange("~1.2")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.3.0"))) // Output: false
+func ParseCargoRange(r string) (*VersionRange, error) {
+	return CargoDialect.ParseRange(r)
+}
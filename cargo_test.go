@@ -0,0 +1,101 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCargoDialectContains(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rangeStr    string
+		version     string
+		shouldMatch bool
+	}{
+		{"1.2.3", "1.9.9", true},
+		{"1.2.3", "2.0.0", false},
+		{"1.0", "1.9.0", true},
+		{"1", "1.0.0", true},
+		{"1", "2.0.0", false},
+		{"0.8", "0.8.5", true},
+		{"0.8", "0.9.0", false},
+		{"0.0.3", "0.0.4", false},
+		{"^1.2", "1.5.0", true},
+		{"~1.2", "1.2.9", true},
+		{"~1.2", "1.3.0", false},
+		{"~1", "1.9.0", true},
+		{"=1.2", "1.2.7", true},
+		{"=1.2", "1.3.0", false},
+		{"=1.2.3", "1.2.4", false},
+		{">1.2", "1.2.9", false},
+		{">1.2", "1.3.0", true},
+		{"<=1.2", "1.2.9", true},
+		{"1.*", "1.9.0", true},
+		{"1.2.*", "1.3.0", false},
+		{"*", "3.4.5", true},
+		{">= 1.2, < 1.5", "1.4.9", true},
+		{">= 1.2, < 1.5", "1.5.0", false},
+
+		// Pre-releases are only matched by requirements that opt in.
+		{"1.2.3", "1.5.0-rc.1", false},
+		{">=1.2.3-0", "1.3.0-beta.1", true},
+	}
+
+	for _, test := range tests {
+		r, err := CargoDialect.ParseRange(test.rangeStr)
+		is.NoError(err, "Range %s should parse", test.rangeStr)
+		if err != nil {
+			continue
+		}
+		is.Equal(test.shouldMatch, r.Contains(MustParse(test.version)), "Version %s against range %s", test.version, test.rangeStr)
+	}
+}
+
+func TestCargoDialectInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, input := range []string{"", "1.0.0 || 2.0.0", ">=1.0 <2.0", "!=1.0.0", "~>1.2", "1.x.3", "1.0,"} {
+		_, err := ParseCargoRange(input)
+		is.ErrorIs(err, ErrInvalidRangeToken, "Expected error for input: %q", input)
+	}
+	is.Equal("cargo", CargoDialect.Name())
+
+	_, err := CargoDialect.(VersionDialect).ParseVersion("1.2")
+	is.Error(err)
+}
+
+func TestCargoDialectFormatRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rng      string
+		expected string
+	}{
+		{"^1.2.3", "1.2.3"},
+		{"^0.2.0", "0.2.0"},
+		{"~1.2.3", "~1.2.3"},
+		{"=1.2.3+build.1", "=1.2.3"},
+		{">=1.2.0 <1.5.0", ">=1.2.0, <1.5.0"},
+		{">1.0.0", ">1.0.0"},
+		{">=0.0.0", "*"},
+		{">=0.0.0-0", ">=0.0.0-0"},
+	}
+
+	for _, tt := range tests {
+		r := MustParseRange(tt.rng)
+		r.Prerelease = PrereleaseOptIn
+		s, err := Translate(r, CargoDialect)
+		is.NoError(err, tt.rng)
+		is.Equal(tt.expected, s, tt.rng)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+)
+
+// Check verifies the invariants of a Version that may have been assembled by hand rather than
+// parsed, so that values accepted across API boundaries can be rejected before they cause
+// surprising comparisons or a String form that does not parse back.
+//
+// It checks that:
+//   - No pre-release or build metadata identifier is empty.
+//   - Every alphanumeric identifier contains only ASCII letters, digits, and hyphens.
+//   - An alphanumeric pre-release identifier is not made of digits only, since it would parse
+//     back as a numeric identifier with different precedence.
+//   - A numeric pre-release identifier carries no alphanumeric text.
+//
+// Returns an error wrapping the sentinel error for the first invariant that does not hold, such
+// as ErrEmptyPrereleaseIdentifier or ErrInvalidBuildMetadataIdentifier.
+//
+// Example:
+//
+//	v := semver.Version{Major: 1, BuildMetadata: []string{"sha", ""}}
+//	fmt.Println(v.Check()) // Output: build metadata identifier 1: build metadata is empty
+func (v Version) Check() error {
+	for i, pre := range v.PreRelease {
+		switch {
+		case pre.isNumeric && pre.partString != "":
+			return fmt.Errorf("pre-release identifier %d: numeric identifier has text %q: %w", i, pre.partString, ErrInvalidPrereleaseIdentifier)
+		case pre.isNumeric:
+		case pre.partString == "":
+			return fmt.Errorf("pre-release identifier %d: %w", i, ErrEmptyPrereleaseIdentifier)
+		case !isIdentifier(pre.partString):
+			return fmt.Errorf("pre-release identifier %d: %q: %w", i, pre.partString, ErrInvalidCharacterInIdentifier)
+		case isNumeric(pre.partString):
+			return fmt.Errorf("pre-release identifier %d: %q is marked alphanumeric: %w", i, pre.partString, ErrInvalidPrereleaseIdentifier)
+		}
+	}
+
+	for i, build := range v.BuildMetadata {
+		switch {
+		case build == "":
+			return fmt.Errorf("build metadata identifier %d: %w", i, ErrEmptyBuildMetadata)
+		case !isIdentifier(build):
+			return fmt.Errorf("build metadata identifier %d: %q: %w", i, build, ErrInvalidBuildMetadataIdentifier)
+		}
+	}
+
+	return nil
+}
+
+// isIdentifier reports whether s consists only of ASCII letters, digits, and hyphens.
+func isIdentifier(s string) bool {
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if !(ch >= '0' && ch <= '9') && !(ch >= 'A' && ch <= 'Z') && !(ch >= 'a' && ch <= 'z') && ch != '-' {
+			return false
+		}
+	}
+	return true
+}
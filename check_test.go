@@ -0,0 +1,39 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionCheck(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, s := range []string{"0.0.0", "1.2.3-rc.1+build.007", "1.0.0-x-y.0.a1+--"} {
+		is.NoError(MustParse(s).Check(), s)
+	}
+	is.NoError(Version{Major: 1}.Check())
+
+	tests := []struct {
+		v   Version
+		err error
+	}{
+		{Version{PreRelease: []PrereleaseVersion{{}}}, ErrEmptyPrereleaseIdentifier},
+		{Version{PreRelease: []PrereleaseVersion{{partString: "rc.1"}}}, ErrInvalidCharacterInIdentifier},
+		{Version{PreRelease: []PrereleaseVersion{{partString: "12"}}}, ErrInvalidPrereleaseIdentifier},
+		{Version{PreRelease: []PrereleaseVersion{{partString: "rc", partNumeric: 1, isNumeric: true}}}, ErrInvalidPrereleaseIdentifier},
+		{Version{BuildMetadata: []string{"sha", ""}}, ErrEmptyBuildMetadata},
+		{Version{BuildMetadata: []string{"sha+1"}}, ErrInvalidBuildMetadataIdentifier},
+	}
+	for _, tt := range tests {
+		is.ErrorIs(tt.v.Check(), tt.err, tt.v.String())
+	}
+
+	is.EqualError(Version{Major: 1, BuildMetadata: []string{"sha", ""}}.Check(), "build metadata identifier 1: build metadata is empty")
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+//go:build cgo
+
+package main
+
+/*
+#include <stddef.h>
+
+enum {
+	SEMVER_OK = 0,
+	SEMVER_ERR_INVALID_VERSION = -1,
+	SEMVER_ERR_INVALID_RANGE = -2,
+	SEMVER_ERR_NULL_ARGUMENT = -3,
+	SEMVER_ERR_BUFFER_TOO_SMALL = -4,
+};
+
+static const char *semver_status_text(int code) {
+	switch (code) {
+	case SEMVER_OK:
+		return "ok";
+	case SEMVER_ERR_INVALID_VERSION:
+		return "invalid version";
+	case SEMVER_ERR_INVALID_RANGE:
+		return "invalid range";
+	case SEMVER_ERR_NULL_ARGUMENT:
+		return "null argument";
+	case SEMVER_ERR_BUFFER_TOO_SMALL:
+		return "buffer too small";
+	default:
+		return "unknown error";
+	}
+}
+*/
+import "C"
+
+import (
+	"unsafe"
+)
+
+// semver_parse validates version and, if out is not NULL, writes its canonical form there as
+// a NUL-terminated string. Returns SEMVER_ERR_BUFFER_TOO_SMALL if out_len cannot hold it.
+//
+//export semver_parse
+func semver_parse(version *C.char, out *C.char, outLen C.size_t) C.int {
+	if version == nil {
+		return statusNullArgument
+	}
+	s, status := parseVersion(C.GoString(version))
+	if status != statusOK || out == nil {
+		return C.int(status)
+	}
+	if uint64(len(s)) >= uint64(outLen) {
+		return statusBufferTooSmall
+	}
+	buf := unsafe.Slice((*byte)(unsafe.Pointer(out)), int(outLen))
+	buf[copy(buf, s)] = 0
+	return statusOK
+}
+
+// semver_compare stores -1, 0, or +1 in result depending on whether a has lower, equal, or
+// higher precedence than b.
+//
+//export semver_compare
+func semver_compare(a, b *C.char, result *C.int) C.int {
+	if a == nil || b == nil || result == nil {
+		return statusNullArgument
+	}
+	c, status := compareVersions(C.GoString(a), C.GoString(b))
+	if status == statusOK {
+		*result = C.int(c)
+	}
+	return C.int(status)
+}
+
+// semver_satisfies stores 1 in result if version lies within rng, and 0 otherwise.
+//
+//export semver_satisfies
+func semver_satisfies(version, rng *C.char, result *C.int) C.int {
+	if version == nil || rng == nil || result == nil {
+		return statusNullArgument
+	}
+	ok, status := satisfiesRange(C.GoString(version), C.GoString(rng))
+	if status == statusOK {
+		*result = 0
+		if ok {
+			*result = 1
+		}
+	}
+	return C.int(status)
+}
+
+// semver_strerror returns a static description of a status code. The string must not be freed.
+//
+//export semver_strerror
+func semver_strerror(code C.int) *C.char {
+	return C.semver_status_text(code)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Command libsemver builds a C shared library exporting this module's parser, comparison, and
+// range matching, so that services written in other languages can link one canonical
+// implementation.
+//
+// Build it with cgo enabled:
+//
+//	go build -buildmode=c-shared -o libsemver.so ./cmd/libsemver
+//
+// This produces libsemver.so and a libsemver.h header declaring:
+//
+//	int semver_parse(char *version, char *out, size_t out_len);
+//	int semver_compare(char *a, char *b, int *result);
+//	int semver_satisfies(char *version, char *range, int *result);
+//	char *semver_strerror(int code);
+//
+// Every function returns SEMVER_OK (0) on success or a negative SEMVER_ERR_* code on failure,
+// and never retains the pointers it is given.
+//
+// Example (C):
+//
+//	int ok;
+//	if (semver_satisfies("1.4.0", ">=1.0.0 <2.0.0", &ok) != SEMVER_OK) {
+//	    /* handle error */
+//	}
+package main
+
+import (
+	"github.com/sixafter/semver"
+)
+
+// Status codes returned by the exported functions. They mirror the SEMVER_* constants in the
+// generated header.
+const (
+	statusOK             = 0
+	statusInvalidVersion = -1
+	statusInvalidRange   = -2
+	statusNullArgument   = -3
+	statusBufferTooSmall = -4
+)
+
+// parseVersion parses a version and returns its canonical string form.
+func parseVersion(s string) (string, int) {
+	v, err := semver.Parse(s)
+	if err != nil {
+		return "", statusInvalidVersion
+	}
+	return v.String(), statusOK
+}
+
+// compareVersions parses and compares two versions.
+func compareVersions(a, b string) (int, int) {
+	va, err := semver.Parse(a)
+	if err != nil {
+		return 0, statusInvalidVersion
+	}
+	vb, err := semver.Parse(b)
+	if err != nil {
+		return 0, statusInvalidVersion
+	}
+	return semver.Compare(va, vb), statusOK
+}
+
+// satisfiesRange reports whether a version lies within a range parsed with semver.ParseRange.
+func satisfiesRange(version, r string) (bool, int) {
+	v, err := semver.Parse(version)
+	if err != nil {
+		return false, statusInvalidVersion
+	}
+	vr, err := semver.ParseRange(r)
+	if err != nil {
+		return false, statusInvalidRange
+	}
+	return vr.Contains(v), statusOK
+}
+
+// A c-shared build requires a main function, which is never called.
+func main() {}
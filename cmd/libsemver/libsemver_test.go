@@ -0,0 +1,51 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	s, status := parseVersion("1.2.3-rc.1+build")
+	is.Equal(statusOK, status)
+	is.Equal("1.2.3-rc.1+build", s)
+
+	_, status = parseVersion("v1")
+	is.Equal(statusInvalidVersion, status)
+}
+
+func TestCompareVersions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	c, status := compareVersions("1.0.0-rc.1", "1.0.0")
+	is.Equal(statusOK, status)
+	is.Equal(-1, c)
+
+	_, status = compareVersions("1.0.0", "bogus")
+	is.Equal(statusInvalidVersion, status)
+}
+
+func TestSatisfiesRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ok, status := satisfiesRange("1.4.0", ">=1.0.0 <2.0.0")
+	is.Equal(statusOK, status)
+	is.True(ok)
+
+	_, status = satisfiesRange("bogus", ">=1.0.0")
+	is.Equal(statusInvalidVersion, status)
+
+	_, status = satisfiesRange("1.4.0", ">=bogus")
+	is.Equal(statusInvalidRange, status)
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrColumnLength indicates that the columns of a VersionColumns do not all have the same length.
+var ErrColumnLength = errors.New("version columns have different lengths")
+
+// VersionColumns holds a list of versions as parallel columns of primitive values, one element
+// per version, for columnar formats such as Apache Arrow and Parquet.
+//
+// Prereleases and Builds hold the dot-separated pre-release identifiers and build metadata,
+// empty for versions without them. Epochs and Revisions are nil unless some version has a
+// non-zero epoch or revision, since Semantic Versioning versions have neither.
+type VersionColumns struct {
+	Epochs      []uint64
+	Majors      []uint64
+	Minors      []uint64
+	Patches     []uint64
+	Revisions   []uint64
+	Prereleases []string
+	Builds      []string
+}
+
+// Len returns the number of versions held in the columns.
+func (c VersionColumns) Len() int {
+	return len(c.Majors)
+}
+
+// EncodeColumns splits versions into parallel columns.
+//
+// Example:
+//
+//	cols := semver.EncodeColumns([]semver.Version{semver.MustParse("1.2.3"), semver.MustParse("2.0.0-rc.1")})
+//	fmt.Println(cols.Majors, cols.Prereleases) // Output: [1 2] [ rc.1]
+func EncodeColumns(versions []Version) VersionColumns {
+	n := len(versions)
+	c := VersionColumns{
+		Majors:      make([]uint64, n),
+		Minors:      make([]uint64, n),
+		Patches:     make([]uint64, n),
+		Prereleases: make([]string, n),
+		Builds:      make([]string, n),
+	}
+
+	var pre []string
+	for i, v := range versions {
+		c.Majors[i], c.Minors[i], c.Patches[i] = v.Major, v.Minor, v.Patch
+
+		if v.Epoch != 0 && c.Epochs == nil {
+			c.Epochs = make([]uint64, n)
+		}
+		if c.Epochs != nil {
+			c.Epochs[i] = v.Epoch
+		}
+		if v.Revision != 0 && c.Revisions == nil {
+			c.Revisions = make([]uint64, n)
+		}
+		if c.Revisions != nil {
+			c.Revisions[i] = v.Revision
+		}
+
+		if len(v.PreRelease) > 0 {
+			pre = pre[:0]
+			for _, id := range v.PreRelease {
+				pre = append(pre, id.String())
+			}
+			c.Prereleases[i] = strings.Join(pre, ".")
+		}
+		if len(v.BuildMetadata) > 0 {
+			c.Builds[i] = strings.Join(v.BuildMetadata, ".")
+		}
+	}
+	return c
+}
+
+// DecodeColumns rebuilds the versions held in columns, as returned by EncodeColumns.
+//
+// The Epochs, Revisions, Prereleases, and Builds columns may be nil, in which case every version
+// has a zero epoch or revision, or no pre-release or build metadata. Repeated pre-release and
+// build metadata strings are parsed once and their identifiers shared between the versions that
+// have them, so use Clone before modifying the identifiers of a returned version in place.
+//
+// Returns an error wrapping ErrColumnLength if a non-nil column has a different length than
+// Majors, or the parse error, with the index of its version, if a pre-release or build metadata
+// string is invalid.
+//
+// Example:
+//
+//	versions, err := semver.DecodeColumns(semver.VersionColumns{
+//	    Majors:      []uint64{1, 2},
+//	    Minors:      []uint64{2, 0},
+//	    Patches:     []uint64{3, 0},
+//	    Prereleases: []string{"", "rc.1"},
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(versions) // Output: [1.2.3 2.0.0-rc.1]
+func DecodeColumns(c VersionColumns) ([]Version, error) {
+	n := c.Len()
+	for _, col := range []struct {
+		name     string
+		length   int
+		optional bool
+	}{
+		{"epochs", len(c.Epochs), true},
+		{"minors", len(c.Minors), false},
+		{"patches", len(c.Patches), false},
+		{"revisions", len(c.Revisions), true},
+		{"prereleases", len(c.Prereleases), true},
+		{"builds", len(c.Builds), true},
+	} {
+		if col.length != n && !(col.optional && col.length == 0) {
+			return nil, fmt.Errorf("%w: %d majors, %d %s", ErrColumnLength, n, col.length, col.name)
+		}
+	}
+
+	versions := make([]Version, n)
+	pres := make(map[string][]PrereleaseVersion)
+	builds := make(map[string][]string)
+	for i := range versions {
+		v := Version{Major: c.Majors[i], Minor: c.Minors[i], Patch: c.Patches[i]}
+		if c.Epochs != nil {
+			v.Epoch = c.Epochs[i]
+		}
+		if c.Revisions != nil {
+			v.Revision = c.Revisions[i]
+		}
+
+		if c.Prereleases != nil && c.Prereleases[i] != "" {
+			pre, ok := pres[c.Prereleases[i]]
+			if !ok {
+				parsed, err := Version{}.SetPrerelease(c.Prereleases[i])
+				if err != nil {
+					return nil, fmt.Errorf("version %d: %w", i, err)
+				}
+				pre = parsed.PreRelease
+				pres[c.Prereleases[i]] = pre
+			}
+			v.PreRelease = pre
+		}
+		if c.Builds != nil && c.Builds[i] != "" {
+			build, ok := builds[c.Builds[i]]
+			if !ok {
+				parsed, err := Version{}.SetBuildMetadata(c.Builds[i])
+				if err != nil {
+					return nil, fmt.Errorf("version %d: %w", i, err)
+				}
+				build = parsed.BuildMetadata
+				builds[c.Builds[i]] = build
+			}
+			v.BuildMetadata = build
+		}
+
+		versions[i] = v
+	}
+	return versions, nil
+}
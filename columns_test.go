@@ -0,0 +1,74 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodeColumns(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	versions := []Version{
+		MustParse("1.2.3"),
+		MustParse("2.0.0-rc.1+sha.5"),
+		MustParse("2.0.0-rc.1"),
+	}
+	c := EncodeColumns(versions)
+	is.Equal(3, c.Len())
+	is.Equal([]uint64{1, 2, 2}, c.Majors)
+	is.Equal([]uint64{2, 0, 0}, c.Minors)
+	is.Equal([]uint64{3, 0, 0}, c.Patches)
+	is.Equal([]string{"", "rc.1", "rc.1"}, c.Prereleases)
+	is.Equal([]string{"", "sha.5", ""}, c.Builds)
+	is.Nil(c.Epochs)
+	is.Nil(c.Revisions)
+
+	decoded, err := DecodeColumns(c)
+	is.NoError(err)
+	is.Len(decoded, len(versions))
+	for i := range versions {
+		is.True(versions[i].StrictEqual(decoded[i]), versions[i].String())
+	}
+
+	withEpoch := []Version{MustParse("1.0.0"), {Epoch: 2, Major: 1, Revision: 3}}
+	c = EncodeColumns(withEpoch)
+	is.Equal([]uint64{0, 2}, c.Epochs)
+	is.Equal([]uint64{0, 3}, c.Revisions)
+	decoded, err = DecodeColumns(c)
+	is.NoError(err)
+	is.True(withEpoch[1].StrictEqual(decoded[1]))
+
+	c = EncodeColumns(nil)
+	is.Equal(0, c.Len())
+	decoded, err = DecodeColumns(c)
+	is.NoError(err)
+	is.Empty(decoded)
+}
+
+func TestDecodeColumnsErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	decoded, err := DecodeColumns(VersionColumns{Majors: []uint64{1, 2}, Minors: []uint64{0, 0}, Patches: []uint64{0, 0}})
+	is.NoError(err)
+	is.Equal("2.0.0", decoded[1].String())
+
+	_, err = DecodeColumns(VersionColumns{Majors: []uint64{1, 2}, Minors: []uint64{0}, Patches: []uint64{0, 0}})
+	is.ErrorIs(err, ErrColumnLength)
+
+	_, err = DecodeColumns(VersionColumns{Majors: []uint64{1}, Minors: []uint64{0}, Patches: []uint64{0}, Builds: []string{"", ""}})
+	is.ErrorIs(err, ErrColumnLength)
+
+	_, err = DecodeColumns(VersionColumns{Majors: []uint64{1}, Minors: []uint64{0}, Patches: []uint64{0}, Prereleases: []string{"rc..1"}})
+	is.ErrorIs(err, ErrEmptyPrereleaseIdentifier)
+
+	_, err = DecodeColumns(VersionColumns{Majors: []uint64{1}, Minors: []uint64{0}, Patches: []uint64{0}, Builds: []string{"a+b"}})
+	is.Error(err)
+}
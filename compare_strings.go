@@ -0,0 +1,205 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// maxScannedDigits is the largest number of digits in a numeric identifier that
+// CompareStrings compares without parsing. Every 19-digit number fits in a uint64.
+const maxScannedDigits = 19
+
+// scannedVersion holds the parts of a validated version string as substrings of it.
+type scannedVersion struct {
+	core       [3]string
+	prerelease string
+}
+
+// CompareStrings compares two version strings by precedence without constructing Version values,
+// returning -1, 0, or 1 like Version.Compare.
+//
+// Both strings are validated as Parse would validate them. If both are valid, the comparison
+// makes no allocations, which suits hot paths such as sorting registry listings. If either is
+// invalid, its parse error is returned.
+//
+// Example:
+//
+//	c, err := semver.CompareStrings("1.2.3-alpha.10", "1.2.3-alpha.9")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(c) // Output: 1
+func CompareStrings(a, b string) (int, error) {
+	sa, okA := scanVersion(a)
+	sb, okB := scanVersion(b)
+	if !okA || !okB {
+		// Invalid input, or numbers too large to compare as digit strings.
+		va, err := Parse(a)
+		if err != nil {
+			return 0, err
+		}
+		vb, err := Parse(b)
+		if err != nil {
+			return 0, err
+		}
+		return va.Compare(vb), nil
+	}
+
+	for i := range sa.core {
+		if c := compareDigits(sa.core[i], sb.core[i]); c != 0 {
+			return c, nil
+		}
+	}
+	return comparePrereleaseStrings(sa.prerelease, sb.prerelease), nil
+}
+
+// scanVersion splits a version string into its parts while validating it, without allocating.
+// The boolean is false if s is not a valid version or has a numeric identifier longer than
+// maxScannedDigits.
+func scanVersion(s string) (scannedVersion, bool) {
+	var sv scannedVersion
+
+	i := 0
+	for c := range sv.core {
+		start := i
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if !validNumber(s[start:i]) {
+			return sv, false
+		}
+		sv.core[c] = s[start:i]
+
+		if c < 2 {
+			if i >= len(s) || s[i] != '.' {
+				return sv, false
+			}
+			i++
+		}
+	}
+
+	rest := s[i:]
+	if rest == "" {
+		return sv, true
+	}
+
+	var build string
+	hasBuild := false
+	if j := strings.IndexByte(rest, '+'); j >= 0 {
+		rest, build, hasBuild = rest[:j], rest[j+1:], true
+	}
+
+	if rest != "" {
+		if rest[0] != '-' {
+			return sv, false
+		}
+		sv.prerelease = rest[1:]
+		if !scanIdentifiers(sv.prerelease, true) {
+			return sv, false
+		}
+	}
+	if hasBuild && !scanIdentifiers(build, false) {
+		return sv, false
+	}
+
+	return sv, true
+}
+
+// validNumber reports whether s is a non-empty numeric identifier without leading zeros and
+// with at most maxScannedDigits digits.
+func validNumber(s string) bool {
+	return s != "" && len(s) <= maxScannedDigits && (s[0] != '0' || len(s) == 1)
+}
+
+// scanIdentifiers validates a dot-separated list of identifiers. Numeric pre-release
+// identifiers must also be valid numbers.
+func scanIdentifiers(s string, prerelease bool) bool {
+	if s == "" {
+		return false
+	}
+	for {
+		id, rest, more := strings.Cut(s, ".")
+		if id == "" {
+			return false
+		}
+
+		numeric := true
+		for i := 0; i < len(id); i++ {
+			ch := id[i]
+			switch {
+			case ch >= '0' && ch <= '9':
+			case (ch >= 'A' && ch <= 'Z') || (ch >= 'a' && ch <= 'z') || ch == '-':
+				numeric = false
+			default:
+				return false
+			}
+		}
+		if prerelease && numeric && !validNumber(id) {
+			return false
+		}
+
+		if !more {
+			return true
+		}
+		s = rest
+	}
+}
+
+// compareDigits compares two numbers without leading zeros given as digit strings.
+func compareDigits(a, b string) int {
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// comparePrereleaseStrings compares two validated pre-release strings by precedence.
+// An empty pre-release has higher precedence than any non-empty one.
+func comparePrereleaseStrings(a, b string) int {
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	for {
+		idA, restA, moreA := strings.Cut(a, ".")
+		idB, restB, moreB := strings.Cut(b, ".")
+
+		numA, numB := isNumeric(idA), isNumeric(idB)
+		var c int
+		switch {
+		case numA && numB:
+			c = compareDigits(idA, idB)
+		case numA:
+			c = -1
+		case numB:
+			c = 1
+		default:
+			c = strings.Compare(idA, idB)
+		}
+		if c != 0 {
+			return c
+		}
+
+		switch {
+		case !moreA && !moreB:
+			return 0
+		case !moreA:
+			return -1
+		case !moreB:
+			return 1
+		}
+		a, b = restA, restB
+	}
+}
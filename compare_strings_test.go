@@ -0,0 +1,59 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareStrings(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	versions := []string{
+		"0.0.0", "0.0.1", "0.1.0", "1.0.0-0", "1.0.0-1", "1.0.0-2", "1.0.0-10",
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0-RC.1", "1.0.0", "1.0.0+build", "1.0.0+build.2",
+		"1.0.1", "1.2.3-a-b", "1.9.0", "1.10.0", "1.11.0", "2.0.0", "10.0.0",
+		"18446744073709551615.0.0", "1.0.0-18446744073709551615",
+	}
+
+	for _, a := range versions {
+		for _, b := range versions {
+			c, err := CompareStrings(a, b)
+			is.NoError(err)
+			is.Equal(MustParse(a).Compare(MustParse(b)), c, "CompareStrings(%q, %q)", a, b)
+		}
+	}
+}
+
+func TestCompareStringsInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	invalid := []string{
+		"", "1", "1.2", "01.2.3", "1.2.3-", "1.2.3+", "1.2.3-01", "1.2.3-a..b",
+		"1.2.3+a..b", "1.2.3-a_b", "1.2.3.4", "v1.2.3", "1.2.3 ",
+	}
+	for _, s := range invalid {
+		_, expected := Parse(s)
+		is.Error(expected, "Parse(%q)", s)
+
+		_, err := CompareStrings(s, "1.0.0")
+		is.Equal(expected, err, "CompareStrings(%q, 1.0.0)", s)
+		_, err = CompareStrings("1.0.0", s)
+		is.Equal(expected, err, "CompareStrings(1.0.0, %q)", s)
+	}
+}
+
+func TestCompareStringsAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = CompareStrings("1.2.3-alpha.10+build.5", "1.2.3-alpha.9")
+	})
+	assert.Zero(t, allocs)
+}
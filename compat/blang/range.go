@@ -0,0 +1,89 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package blang
+
+import (
+	"sort"
+
+	"github.com/sixafter/semver"
+)
+
+// Range reports whether a version lies within a range, like the Range type of blang/semver.
+type Range func(Version) bool
+
+// ParseRange parses a range in the syntax accepted by semver.ParseRange, such as
+// ">1.0.0 <2.0.0 || >=3.0.0", and returns it as a Range.
+//
+// Versions with invalid identifiers never lie within the range.
+//
+// Example:
+//
+//	inRange, err := blang.ParseRange(">1.0.0 <2.0.0 || >=3.0.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(inRange(blang.MustParse("2.5.0"))) // Output: false
+func ParseRange(s string) (Range, error) {
+	r, err := semver.ParseRange(s)
+	if err != nil {
+		return nil, err
+	}
+	return FromVersionRange(r), nil
+}
+
+// MustParseRange is like ParseRange but panics if the range cannot be parsed.
+func MustParseRange(s string) Range {
+	r, err := ParseRange(s)
+	if err != nil {
+		panic(`semver: ParseRange(` + s + `): ` + err.Error())
+	}
+	return r
+}
+
+// FromVersionRange adapts a semver.VersionRange into a Range.
+func FromVersionRange(r *semver.VersionRange) Range {
+	return func(v Version) bool {
+		sv, err := v.Semver()
+		return err == nil && r.Contains(sv)
+	}
+}
+
+// AND returns a Range matching versions matched by both rf and f.
+func (rf Range) AND(f Range) Range {
+	return func(v Version) bool {
+		return rf(v) && f(v)
+	}
+}
+
+// OR returns a Range matching versions matched by either rf or f.
+func (rf Range) OR(f Range) Range {
+	return func(v Version) bool {
+		return rf(v) || f(v)
+	}
+}
+
+// Versions is a sortable list of versions, like the Versions type of blang/semver.
+type Versions []Version
+
+// Len returns the number of versions.
+func (s Versions) Len() int {
+	return len(s)
+}
+
+// Less reports whether the version at index i has lower precedence than the one at index j.
+func (s Versions) Less(i, j int) bool {
+	return s[i].LT(s[j])
+}
+
+// Swap exchanges the versions at indices i and j.
+func (s Versions) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Sort sorts versions in ascending order of precedence.
+func Sort(versions []Version) {
+	sort.Sort(Versions(versions))
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package blang
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		r       string
+		version string
+		want    bool
+	}{
+		{">1.0.0 <2.0.0", "1.5.0", true},
+		{">1.0.0 <2.0.0", "2.0.0", false},
+		{">1.0.0 <2.0.0 || >=3.0.0", "3.1.0", true},
+		{">1.0.0 <2.0.0 || >=3.0.0", "2.5.0", false},
+		{"!=1.2.3", "1.2.3", false},
+		{"1.2.3", "1.2.3", true},
+	}
+
+	for _, tc := range tests {
+		inRange, err := ParseRange(tc.r)
+		is.NoError(err, tc.r)
+		is.Equal(tc.want, inRange(MustParse(tc.version)), "%s %s", tc.r, tc.version)
+	}
+
+	_, err := ParseRange(">=bogus")
+	is.Error(err)
+	is.Panics(func() { MustParseRange(">=bogus") })
+
+	inRange := MustParseRange(">=1.0.0")
+	is.False(inRange(Version{Major: 2, Pre: []PRVersion{{VersionStr: ""}}}))
+}
+
+func TestRangeCombinators(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	above := MustParseRange(">=1.0.0")
+	below := MustParseRange("<2.0.0")
+	legacy := MustParseRange("<0.5.0")
+
+	both := above.AND(below)
+	is.True(both(MustParse("1.5.0")))
+	is.False(both(MustParse("2.5.0")))
+
+	either := both.OR(legacy)
+	is.True(either(MustParse("0.4.0")))
+	is.False(either(MustParse("0.7.0")))
+}
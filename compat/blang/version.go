@@ -0,0 +1,383 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package blang mirrors the API of github.com/blang/semver/v4 on top of this module's parser
+// and range engine, so that projects switching from blang/semver can adopt it without rewriting
+// call sites.
+//
+// Version keeps the exported Major, Minor, Patch, Pre, and Build fields of its blang
+// counterpart, and Range is a plain func(Version) bool that can be combined with AND and OR.
+// Version.Semver and FromSemver convert to and from semver.Version for code that has already
+// migrated.
+//
+// Importing the package under the name semver keeps existing call sites such as semver.Make
+// unchanged:
+//
+//	import semver "github.com/sixafter/semver/compat/blang"
+//
+// Example:
+//
+//	v, err := blang.Make("1.4.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	inRange := blang.MustParseRange(">=1.0.0 <2.0.0")
+//	fmt.Println(inRange(v)) // Output: true
+package blang
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+// Version is a semantic version with the exported fields of blang/semver.
+type Version struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+	Pre   []PRVersion
+	Build []string
+}
+
+// PRVersion is a pre-release identifier. VersionNum is set for numeric identifiers and
+// VersionStr for alphanumeric ones; IsNum reports which.
+type PRVersion struct {
+	VersionStr string
+	VersionNum uint64
+	IsNum      bool
+}
+
+// Make parses a version string that must strictly follow the Semantic Versioning
+// specification. It is an alias of Parse.
+func Make(s string) (Version, error) {
+	return Parse(s)
+}
+
+// Parse parses a version string that must strictly follow the Semantic Versioning
+// specification.
+//
+// Example:
+//
+//	v, err := blang.Parse("1.2.3-rc.1")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v.Pre[1].VersionNum) // Output: 1
+func Parse(s string) (Version, error) {
+	v, err := semver.Parse(s)
+	if err != nil {
+		return Version{}, err
+	}
+	return FromSemver(v), nil
+}
+
+// ParseTolerant parses a version string, tolerating surrounding whitespace, a "v" prefix,
+// missing minor and patch components, and leading zeros.
+//
+// Example:
+//
+//	v, err := blang.ParseTolerant(" v1.2 ")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.0
+func ParseTolerant(s string) (Version, error) {
+	v, _, err := semver.ParseLenient(s)
+	if err != nil {
+		return Version{}, err
+	}
+	return FromSemver(v), nil
+}
+
+// MustParse is like Parse but panics if the version cannot be parsed.
+func MustParse(s string) Version {
+	v, err := Parse(s)
+	if err != nil {
+		panic(`semver: Parse(` + s + `): ` + err.Error())
+	}
+	return v
+}
+
+// New is like Parse but returns a pointer to the version.
+func New(s string) (*Version, error) {
+	v, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// NewPRVersion creates a pre-release identifier, rejecting empty identifiers and numeric
+// identifiers with leading zeros.
+func NewPRVersion(s string) (PRVersion, error) {
+	pr, err := semver.NewPrereleaseVersion(s)
+	if err != nil {
+		return PRVersion{}, err
+	}
+	return fromPrerelease(pr), nil
+}
+
+// NewBuildVersion creates a build metadata identifier, rejecting empty identifiers and
+// invalid characters.
+func NewBuildVersion(s string) (string, error) {
+	if s == "" {
+		return "", semver.ErrEmptyBuildMetadata
+	}
+	if _, err := semver.Parse("0.0.0+" + s); err != nil || strings.Contains(s, ".") {
+		return "", semver.ErrInvalidBuildMetadataIdentifier
+	}
+	return s, nil
+}
+
+// FromSemver converts a semver.Version. The epoch and revision, which blang/semver does not
+// model, are dropped.
+func FromSemver(v semver.Version) Version {
+	bv := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	if len(v.PreRelease) > 0 {
+		bv.Pre = make([]PRVersion, len(v.PreRelease))
+		for i, pr := range v.PreRelease {
+			bv.Pre[i] = fromPrerelease(pr)
+		}
+	}
+	if len(v.BuildMetadata) > 0 {
+		bv.Build = append([]string(nil), v.BuildMetadata...)
+	}
+	return bv
+}
+
+// fromPrerelease converts a semver.PrereleaseVersion.
+func fromPrerelease(pr semver.PrereleaseVersion) PRVersion {
+	if pr.IsNumeric() {
+		n, _ := strconv.ParseUint(pr.String(), 10, 64)
+		return PRVersion{VersionNum: n, IsNum: true}
+	}
+	return PRVersion{VersionStr: pr.String()}
+}
+
+// Semver converts the version into a semver.Version.
+//
+// Returns an error if any identifier is invalid; see Validate.
+func (v Version) Semver() (semver.Version, error) {
+	return semver.Parse(v.String())
+}
+
+// Validate checks that every pre-release and build identifier is valid under the Semantic
+// Versioning specification.
+//
+// Example:
+//
+//	v := blang.Version{Major: 1, Pre: []blang.PRVersion{{VersionStr: ""}}}
+//	fmt.Println(v.Validate() != nil) // Output: true
+func (v Version) Validate() error {
+	_, err := v.Semver()
+	return err
+}
+
+// String returns the string representation of the version.
+func (v Version) String() string {
+	b := make([]byte, 0, 16)
+	b = strconv.AppendUint(b, v.Major, 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, v.Minor, 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, v.Patch, 10)
+	for i, pr := range v.Pre {
+		if i == 0 {
+			b = append(b, '-')
+		} else {
+			b = append(b, '.')
+		}
+		b = append(b, pr.String()...)
+	}
+	for i, bm := range v.Build {
+		if i == 0 {
+			b = append(b, '+')
+		} else {
+			b = append(b, '.')
+		}
+		b = append(b, bm...)
+	}
+	return string(b)
+}
+
+// FinalizeVersion returns the version without pre-release or build identifiers.
+func (v Version) FinalizeVersion() string {
+	return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}.String()
+}
+
+// Compare returns -1, 0, or +1 depending on whether v has lower, equal, or higher precedence
+// than o. Build metadata is ignored.
+func (v Version) Compare(o Version) int {
+	switch {
+	case v.Major != o.Major:
+		return compareUint(v.Major, o.Major)
+	case v.Minor != o.Minor:
+		return compareUint(v.Minor, o.Minor)
+	case v.Patch != o.Patch:
+		return compareUint(v.Patch, o.Patch)
+	}
+
+	// A release has higher precedence than any of its pre-releases.
+	switch {
+	case len(v.Pre) == 0 && len(o.Pre) == 0:
+		return 0
+	case len(v.Pre) == 0:
+		return 1
+	case len(o.Pre) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.Pre) && i < len(o.Pre); i++ {
+		if c := v.Pre[i].Compare(o.Pre[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.Pre)), uint64(len(o.Pre)))
+}
+
+// Equals reports whether v and o have equal precedence.
+func (v Version) Equals(o Version) bool {
+	return v.Compare(o) == 0
+}
+
+// EQ reports whether v and o have equal precedence.
+func (v Version) EQ(o Version) bool {
+	return v.Compare(o) == 0
+}
+
+// NE reports whether v and o have different precedence.
+func (v Version) NE(o Version) bool {
+	return v.Compare(o) != 0
+}
+
+// GT reports whether v has higher precedence than o.
+func (v Version) GT(o Version) bool {
+	return v.Compare(o) > 0
+}
+
+// GTE reports whether v has higher or equal precedence than o.
+func (v Version) GTE(o Version) bool {
+	return v.Compare(o) >= 0
+}
+
+// LT reports whether v has lower precedence than o.
+func (v Version) LT(o Version) bool {
+	return v.Compare(o) < 0
+}
+
+// LTE reports whether v has lower or equal precedence than o.
+func (v Version) LTE(o Version) bool {
+	return v.Compare(o) <= 0
+}
+
+// IncrementPatch increments the patch version, leaving the pre-release and build
+// identifiers in place as blang/semver does.
+func (v *Version) IncrementPatch() error {
+	v.Patch++
+	return nil
+}
+
+// IncrementMinor increments the minor version and resets the patch version.
+func (v *Version) IncrementMinor() error {
+	v.Minor++
+	v.Patch = 0
+	return nil
+}
+
+// IncrementMajor increments the major version and resets the minor and patch versions.
+func (v *Version) IncrementMajor() error {
+	v.Major++
+	v.Minor = 0
+	v.Patch = 0
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// Scan implements database/sql.Scanner.
+func (v *Version) Scan(value interface{}) error {
+	var s string
+	switch t := value.(type) {
+	case string:
+		s = t
+	case []byte:
+		s = string(t)
+	default:
+		return semver.ErrUnsupportedType
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// Value implements database/sql/driver.Valuer.
+func (v Version) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// String returns the string representation of the identifier.
+func (v PRVersion) String() string {
+	if v.IsNum {
+		return strconv.FormatUint(v.VersionNum, 10)
+	}
+	return v.VersionStr
+}
+
+// IsNumeric reports whether the identifier is numeric.
+func (v PRVersion) IsNumeric() bool {
+	return v.IsNum
+}
+
+// Compare compares two identifiers. Numeric identifiers have lower precedence than
+// alphanumeric ones.
+func (v PRVersion) Compare(o PRVersion) int {
+	switch {
+	case v.IsNum && o.IsNum:
+		return compareUint(v.VersionNum, o.VersionNum)
+	case v.IsNum:
+		return -1
+	case o.IsNum:
+		return 1
+	default:
+		return strings.Compare(v.VersionStr, o.VersionStr)
+	}
+}
+
+// compareUint returns -1, 0, or +1 depending on whether a is less than, equal to, or greater
+// than b.
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
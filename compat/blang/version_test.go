@@ -0,0 +1,133 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package blang
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMake(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v, err := Make("1.2.3-rc.1+build.5")
+	is.NoError(err)
+	is.Equal(uint64(1), v.Major)
+	is.Equal(uint64(2), v.Minor)
+	is.Equal(uint64(3), v.Patch)
+	is.Equal([]PRVersion{{VersionStr: "rc"}, {VersionNum: 1, IsNum: true}}, v.Pre)
+	is.Equal([]string{"build", "5"}, v.Build)
+	is.Equal("1.2.3-rc.1+build.5", v.String())
+	is.Equal("1.2.3", v.FinalizeVersion())
+
+	_, err = Make("v1.2")
+	is.Error(err)
+
+	v, err = ParseTolerant(" v1.2 ")
+	is.NoError(err)
+	is.Equal("1.2.0", v.String())
+
+	p, err := New("2.0.0")
+	is.NoError(err)
+	is.Equal(uint64(2), p.Major)
+
+	is.Panics(func() { MustParse("bogus") })
+}
+
+func TestVersionValidate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NoError(MustParse("1.2.3-alpha.1+build").Validate())
+	is.Error(Version{Major: 1, Pre: []PRVersion{{VersionStr: ""}}}.Validate())
+	is.Error(Version{Major: 1, Pre: []PRVersion{{VersionStr: "a!"}}}.Validate())
+	is.Error(Version{Major: 1, Build: []string{""}}.Validate())
+
+	pr, err := NewPRVersion("beta")
+	is.NoError(err)
+	is.False(pr.IsNumeric())
+	_, err = NewPRVersion("01")
+	is.ErrorIs(err, semver.ErrLeadingZeroInNumericIdentifier)
+
+	_, err = NewBuildVersion("")
+	is.ErrorIs(err, semver.ErrEmptyBuildMetadata)
+	_, err = NewBuildVersion("a.b")
+	is.ErrorIs(err, semver.ErrInvalidBuildMetadataIdentifier)
+	b, err := NewBuildVersion("sha-1")
+	is.NoError(err)
+	is.Equal("sha-1", b)
+}
+
+func TestVersionCompare(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0", "1.10.0"}
+	for i := 1; i < len(ordered); i++ {
+		a, b := MustParse(ordered[i-1]), MustParse(ordered[i])
+		is.True(a.LT(b), "%s < %s", a, b)
+		is.True(a.LTE(b))
+		is.True(b.GT(a))
+		is.True(b.GTE(a))
+		is.True(a.NE(b))
+		is.Equal(a.Compare(b), -b.Compare(a))
+	}
+	is.True(MustParse("1.0.0+a").Equals(MustParse("1.0.0+b")))
+	is.True(MustParse("1.0.0").EQ(MustParse("1.0.0")))
+
+	versions := []Version{MustParse("2.0.0"), MustParse("1.0.0"), MustParse("1.0.0-rc.1")}
+	Sort(versions)
+	is.Equal("1.0.0-rc.1", versions[0].String())
+	is.Equal("2.0.0", versions[2].String())
+}
+
+func TestVersionIncrement(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := MustParse("1.2.3")
+	is.NoError(v.IncrementPatch())
+	is.Equal("1.2.4", v.String())
+	is.NoError(v.IncrementMinor())
+	is.Equal("1.3.0", v.String())
+	is.NoError(v.IncrementMajor())
+	is.Equal("2.0.0", v.String())
+}
+
+func TestVersionEncoding(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data, err := json.Marshal(MustParse("1.2.3-rc.1"))
+	is.NoError(err)
+	is.Equal(`"1.2.3-rc.1"`, string(data))
+
+	var v Version
+	is.NoError(json.Unmarshal(data, &v))
+	is.Equal("1.2.3-rc.1", v.String())
+	is.Error(json.Unmarshal([]byte(`"1.2"`), &v))
+
+	is.NoError(v.Scan("3.0.0"))
+	value, err := v.Value()
+	is.NoError(err)
+	is.Equal("3.0.0", value)
+	is.ErrorIs(v.Scan(42), semver.ErrUnsupportedType)
+}
+
+func TestVersionSemver(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sv := semver.MustParse("1.2.3-rc.1+build")
+	v := FromSemver(sv)
+	back, err := v.Semver()
+	is.NoError(err)
+	is.True(back.StrictEqual(sv))
+}
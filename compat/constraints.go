@@ -0,0 +1,82 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package compat
+
+import (
+	"fmt"
+
+	"github.com/sixafter/semver"
+)
+
+// Constraints is a parsed version constraint, like the Constraints type of Masterminds/semver.
+type Constraints struct {
+	r        *semver.VersionRange
+	original string
+}
+
+// NewConstraint parses a constraint in Masterminds/semver syntax, as described by
+// semver.HelmDialect.
+//
+// Example:
+//
+//	c, err := compat.NewConstraint("~1.2 || ^3")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(c.Check(compat.MustParse("1.2.9"))) // Output: true
+func NewConstraint(c string) (*Constraints, error) {
+	r, err := semver.HelmDialect.ParseRange(c)
+	if err != nil {
+		return nil, err
+	}
+	return &Constraints{r: r, original: c}, nil
+}
+
+// Range returns the underlying semver.VersionRange.
+func (cs *Constraints) Range() *semver.VersionRange {
+	return cs.r
+}
+
+// Check reports whether the version satisfies the constraint.
+func (cs *Constraints) Check(v *Version) bool {
+	return cs.r.Contains(v.v)
+}
+
+// Validate reports whether the version satisfies the constraint and, if it does not, returns
+// an error for each requirement that rejected it.
+//
+// Example:
+//
+//	c, _ := compat.NewConstraint(">= 2.0")
+//	ok, errs := c.Validate(compat.MustParse("1.4.0"))
+//	fmt.Println(ok, errs) // Output: false [1.4.0 does not satisfy >=2.0.0]
+func (cs *Constraints) Validate(v *Version) (bool, []error) {
+	e := cs.r.Explain(v.v)
+	if e.Matched {
+		return true, nil
+	}
+	if len(e.Rejections) == 0 {
+		return false, []error{fmt.Errorf("%s does not satisfy %s", v, cs.original)}
+	}
+
+	errs := make([]error, len(e.Rejections))
+	for i, rejection := range e.Rejections {
+		switch {
+		case rejection.Branch == 0:
+			errs[i] = fmt.Errorf("%s does not satisfy %s: %s", v, cs.original, rejection.Reason)
+		case rejection.Reason == semver.RejectedUnsatisfied:
+			errs[i] = fmt.Errorf("%s does not satisfy %s", v, rejection.Requirement)
+		default:
+			errs[i] = fmt.Errorf("%s does not satisfy %s: %s", v, rejection.Requirement, rejection.Reason)
+		}
+	}
+	return false, errs
+}
+
+// String returns the constraint as it was written.
+func (cs *Constraints) String() string {
+	return cs.original
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package compat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintCheck(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{">= 1.2, < 2", "1.4.0", true},
+		{">= 1.2, < 2", "2.0.0", false},
+		{"~1.2 || ^3", "1.2.9", true},
+		{"~1.2 || ^3", "3.4.0", true},
+		{"~1.2 || ^3", "2.0.0", false},
+		{"1.x", "1.9.9", true},
+		{"^1.2", "1.3.0-beta", false},
+		{"^1.2.0-0", "1.3.0-beta", true},
+	}
+
+	for _, tc := range tests {
+		c, err := NewConstraint(tc.constraint)
+		is.NoError(err, tc.constraint)
+		is.Equal(tc.want, c.Check(MustParse(tc.version)), "%s %s", tc.constraint, tc.version)
+		is.Equal(tc.constraint, c.String())
+	}
+
+	_, err := NewConstraint(">= bogus")
+	is.Error(err)
+}
+
+func TestConstraintValidate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	c, err := NewConstraint(">= 2.0")
+	is.NoError(err)
+
+	ok, errs := c.Validate(MustParse("1.4.0"))
+	is.False(ok)
+	is.Len(errs, 1)
+	is.Equal("1.4.0 does not satisfy >=2.0.0", errs[0].Error())
+
+	ok, errs = c.Validate(MustParse("2.1.0"))
+	is.True(ok)
+	is.Empty(errs)
+
+	ok, errs = c.Validate(MustParse("2.1.0-rc.1"))
+	is.False(ok)
+	is.NotEmpty(errs)
+	is.NotNil(c.Range())
+}
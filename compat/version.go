@@ -0,0 +1,310 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package compat mirrors the API of github.com/Masterminds/semver/v3 on top of this module's
+// parser and range engine, so that large codebases can migrate one import at a time.
+//
+// Version, Collection, and Constraints have the constructors and method names of their
+// Masterminds counterparts. Constraints are parsed with semver.HelmDialect, which follows the
+// Masterminds range syntax and pre-release rules. Version.Semver and FromSemver convert to and
+// from semver.Version for code that has already migrated.
+//
+// Example:
+//
+//	c, err := compat.NewConstraint(">= 1.2, < 2")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	v, err := compat.NewVersion("v1.4")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(c.Check(v)) // Output: true
+package compat
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+var (
+	// ErrInvalidSemVer indicates that a version string could not be parsed.
+	ErrInvalidSemVer = errors.New("invalid semantic version")
+
+	// ErrEmptyString indicates that an empty string was given as a version.
+	ErrEmptyString = errors.New("version string empty")
+)
+
+// Version is a parsed version that remembers the string it was parsed from, like the Version
+// type of Masterminds/semver.
+type Version struct {
+	v        semver.Version
+	original string
+}
+
+// NewVersion parses a version, tolerating a "v" prefix, missing minor and patch components,
+// and leading zeros, as Masterminds/semver does.
+//
+// Example:
+//
+//	v, err := compat.NewVersion("v1.2")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v, v.Original()) // Output: 1.2.0 v1.2
+func NewVersion(s string) (*Version, error) {
+	if s == "" {
+		return nil, ErrEmptyString
+	}
+	v, _, err := semver.ParseLenient(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSemVer, err)
+	}
+	return &Version{v: v, original: s}, nil
+}
+
+// StrictNewVersion parses a version that must strictly follow the Semantic Versioning
+// specification.
+func StrictNewVersion(s string) (*Version, error) {
+	if s == "" {
+		return nil, ErrEmptyString
+	}
+	v, err := semver.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSemVer, err)
+	}
+	return &Version{v: v, original: s}, nil
+}
+
+// MustParse is like NewVersion but panics if the version cannot be parsed.
+func MustParse(s string) *Version {
+	v, err := NewVersion(s)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// New creates a Version from its components. The pre-release and metadata strings may be
+// empty; invalid identifiers are dropped, as they would be unreachable through the parser.
+func New(major, minor, patch uint64, pre, metadata string) *Version {
+	v := semver.Version{Major: major, Minor: minor, Patch: patch}
+	if pre != "" {
+		if parsed, err := semver.Parse(fmt.Sprintf("0.0.0-%s", pre)); err == nil {
+			v.PreRelease = parsed.PreRelease
+		}
+	}
+	if metadata != "" {
+		v.BuildMetadata = strings.Split(metadata, ".")
+	}
+	return &Version{v: v, original: v.String()}
+}
+
+// FromSemver wraps a semver.Version.
+func FromSemver(v semver.Version) *Version {
+	return &Version{v: v, original: v.String()}
+}
+
+// Semver returns the underlying semver.Version.
+func (v *Version) Semver() semver.Version {
+	return v.v
+}
+
+// String returns the canonical string representation of the version.
+func (v *Version) String() string {
+	return v.v.String()
+}
+
+// Original returns the string the version was parsed from.
+func (v *Version) Original() string {
+	return v.original
+}
+
+// Major returns the major version number.
+func (v *Version) Major() uint64 {
+	return v.v.Major
+}
+
+// Minor returns the minor version number.
+func (v *Version) Minor() uint64 {
+	return v.v.Minor
+}
+
+// Patch returns the patch version number.
+func (v *Version) Patch() uint64 {
+	return v.v.Patch
+}
+
+// Prerelease returns the pre-release identifiers joined by dots, or an empty string.
+func (v *Version) Prerelease() string {
+	ids := make([]string, len(v.v.PreRelease))
+	for i, pr := range v.v.PreRelease {
+		ids[i] = pr.String()
+	}
+	return strings.Join(ids, ".")
+}
+
+// Metadata returns the build metadata identifiers joined by dots, or an empty string.
+func (v *Version) Metadata() string {
+	return strings.Join(v.v.BuildMetadata, ".")
+}
+
+// IncPatch returns the next patch version. A pre-release is released instead, without
+// incrementing the patch number. Metadata is dropped.
+func (v Version) IncPatch() Version {
+	next := semver.Version{Major: v.v.Major, Minor: v.v.Minor, Patch: v.v.Patch}
+	if len(v.v.PreRelease) == 0 {
+		next.Patch++
+	}
+	return v.next(next)
+}
+
+// IncMinor returns the next minor version, dropping the pre-release and metadata.
+func (v Version) IncMinor() Version {
+	return v.next(semver.Version{Major: v.v.Major, Minor: v.v.Minor + 1})
+}
+
+// IncMajor returns the next major version, dropping the pre-release and metadata.
+func (v Version) IncMajor() Version {
+	return v.next(semver.Version{Major: v.v.Major + 1})
+}
+
+// SetPrerelease returns a copy of the version with the given pre-release identifiers.
+func (v Version) SetPrerelease(pre string) (Version, error) {
+	next := v.v.Clone()
+	next.PreRelease = nil
+	if pre != "" {
+		parsed, err := semver.Parse("0.0.0-" + pre)
+		if err != nil {
+			return Version{}, fmt.Errorf("%w: %w", ErrInvalidSemVer, err)
+		}
+		next.PreRelease = parsed.PreRelease
+	}
+	return v.next(next), nil
+}
+
+// SetMetadata returns a copy of the version with the given build metadata identifiers.
+func (v Version) SetMetadata(metadata string) (Version, error) {
+	next := v.v.Clone()
+	next.BuildMetadata = nil
+	if metadata != "" {
+		parsed, err := semver.Parse("0.0.0+" + metadata)
+		if err != nil {
+			return Version{}, fmt.Errorf("%w: %w", ErrInvalidSemVer, err)
+		}
+		next.BuildMetadata = parsed.BuildMetadata
+	}
+	return v.next(next), nil
+}
+
+// next returns v replaced by next, keeping a "v" prefix of the original string.
+func (v Version) next(next semver.Version) Version {
+	prefix := ""
+	if strings.HasPrefix(v.original, "v") {
+		prefix = "v"
+	}
+	return Version{v: next, original: prefix + next.String()}
+}
+
+// Compare returns -1, 0, or +1 depending on whether v has lower, equal, or higher precedence
+// than o.
+func (v *Version) Compare(o *Version) int {
+	return v.v.Compare(o.v)
+}
+
+// LessThan reports whether v has lower precedence than o.
+func (v *Version) LessThan(o *Version) bool {
+	return v.Compare(o) < 0
+}
+
+// LessThanEqual reports whether v has lower or equal precedence than o.
+func (v *Version) LessThanEqual(o *Version) bool {
+	return v.Compare(o) <= 0
+}
+
+// GreaterThan reports whether v has higher precedence than o.
+func (v *Version) GreaterThan(o *Version) bool {
+	return v.Compare(o) > 0
+}
+
+// GreaterThanEqual reports whether v has higher or equal precedence than o.
+func (v *Version) GreaterThanEqual(o *Version) bool {
+	return v.Compare(o) >= 0
+}
+
+// Equal reports whether v and o have equal precedence. Metadata is ignored.
+func (v *Version) Equal(o *Version) bool {
+	return v.Compare(o) == 0
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.v.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(s))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Version) MarshalText() ([]byte, error) {
+	return v.v.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := NewVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = *parsed
+	return nil
+}
+
+// Scan implements database/sql.Scanner.
+func (v *Version) Scan(value interface{}) error {
+	switch t := value.(type) {
+	case string:
+		return v.UnmarshalText([]byte(t))
+	case []byte:
+		return v.UnmarshalText(t)
+	default:
+		return semver.ErrUnsupportedType
+	}
+}
+
+// Value implements database/sql/driver.Valuer.
+func (v Version) Value() (driver.Value, error) {
+	return v.v.String(), nil
+}
+
+// Collection is a sortable collection of versions, like the Collection type of
+// Masterminds/semver.
+type Collection []*Version
+
+// Len returns the number of versions in the collection.
+func (c Collection) Len() int {
+	return len(c)
+}
+
+// Less reports whether the version at index i has lower precedence than the one at index j.
+func (c Collection) Less(i, j int) bool {
+	return c[i].LessThan(c[j])
+}
+
+// Swap exchanges the versions at indices i and j.
+func (c Collection) Swap(i, j int) {
+	c[i], c[j] = c[j], c[i]
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package compat
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v, err := NewVersion("v1.2")
+	is.NoError(err)
+	is.Equal("1.2.0", v.String())
+	is.Equal("v1.2", v.Original())
+	is.Equal(uint64(1), v.Major())
+	is.Equal(uint64(2), v.Minor())
+	is.Equal(uint64(0), v.Patch())
+
+	v, err = NewVersion("1.2.3-rc.1+build.5")
+	is.NoError(err)
+	is.Equal("rc.1", v.Prerelease())
+	is.Equal("build.5", v.Metadata())
+
+	_, err = NewVersion("")
+	is.ErrorIs(err, ErrEmptyString)
+
+	_, err = NewVersion("not a version")
+	is.ErrorIs(err, ErrInvalidSemVer)
+
+	_, err = StrictNewVersion("v1.2")
+	is.ErrorIs(err, ErrInvalidSemVer)
+
+	v, err = StrictNewVersion("1.2.3")
+	is.NoError(err)
+	is.Equal("1.2.3", v.String())
+
+	is.Panics(func() { MustParse("bogus") })
+}
+
+func TestVersionIncrements(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := MustParse("v1.2.3-beta+build")
+	next := v.IncPatch()
+	is.Equal("1.2.3", next.String())
+	is.Equal("v1.2.3", next.Original())
+
+	next = MustParse("1.2.3").IncPatch()
+	is.Equal("1.2.4", next.String())
+
+	next = v.IncMinor()
+	is.Equal("1.3.0", next.String())
+
+	next = v.IncMajor()
+	is.Equal("2.0.0", next.String())
+
+	next, err := MustParse("1.2.3").SetPrerelease("alpha.1")
+	is.NoError(err)
+	is.Equal("1.2.3-alpha.1", next.String())
+
+	next, err = next.SetMetadata("sha.abc")
+	is.NoError(err)
+	is.Equal("1.2.3-alpha.1+sha.abc", next.String())
+
+	_, err = next.SetPrerelease("bad..id")
+	is.ErrorIs(err, ErrInvalidSemVer)
+}
+
+func TestVersionComparison(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	a, b := MustParse("1.2.3"), MustParse("1.10.0")
+	is.Equal(-1, a.Compare(b))
+	is.True(a.LessThan(b))
+	is.True(a.LessThanEqual(b))
+	is.False(a.GreaterThan(b))
+	is.True(b.GreaterThanEqual(a))
+	is.True(a.Equal(MustParse("v1.2.3+meta")))
+
+	c := Collection{MustParse("2.0.0"), MustParse("1.0.0-rc.1"), MustParse("1.0.0")}
+	sort.Sort(c)
+	is.Equal("1.0.0-rc.1", c[0].String())
+	is.Equal("1.0.0", c[1].String())
+	is.Equal("2.0.0", c[2].String())
+}
+
+func TestVersionEncoding(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data, err := json.Marshal(MustParse("v1.2.3"))
+	is.NoError(err)
+	is.Equal(`"1.2.3"`, string(data))
+
+	var v Version
+	is.NoError(json.Unmarshal([]byte(`"v2.1"`), &v))
+	is.Equal("2.1.0", v.String())
+
+	is.NoError(v.Scan([]byte("3.0.0")))
+	value, err := v.Value()
+	is.NoError(err)
+	is.Equal("3.0.0", value)
+	is.ErrorIs(v.Scan(42), semver.ErrUnsupportedType)
+}
+
+func TestVersionSemver(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	sv := semver.MustParse("1.2.3-rc.1")
+	v := FromSemver(sv)
+	is.True(v.Semver().StrictEqual(sv))
+	is.Equal("1.2.3-rc.1", v.Original())
+
+	v = New(1, 2, 3, "beta.2", "build")
+	is.Equal("1.2.3-beta.2+build", v.String())
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// Stability represents the release stability of a version, as used by Composer.
+//
+// Stabilities are ordered from least to most stable:
+//   - StabilityDev
+//   - StabilityAlpha
+//   - StabilityBeta
+//   - StabilityRC
+//   - StabilityStable
+type Stability int
+
+const (
+	StabilityDev Stability = iota
+	StabilityAlpha
+	StabilityBeta
+	StabilityRC
+	StabilityStable
+)
+
+// String returns the Composer name of the Stability.
+//
+// Example:
+//
+//	fmt.Println(semver.StabilityRC.String()) // Output: RC
+func (s Stability) String() string {
+	switch s {
+	case StabilityDev:
+		return "dev"
+	case StabilityAlpha:
+		return "alpha"
+	case StabilityBeta:
+		return "beta"
+	case StabilityRC:
+		return "RC"
+	default:
+		return "stable"
+	}
+}
+
+// StabilityOf classifies a version by the label of its first pre-release identifier.
+//
+// Versions without pre-release identifiers are stable. Labels starting with "dev", "alpha" or "a",
+// "beta" or "b", and "rc" are matched case-insensitively; "patch" and "pl" are stable, and any
+// other label is treated as dev.
+//
+// Example:
+//
+//	fmt.Println(semver.StabilityOf(semver.MustParse("1.0.0-beta2"))) // Output: beta
+func StabilityOf(v Version) Stability {
+	if len(v.PreRelease) == 0 {
+		return StabilityStable
+	}
+	return stabilityOfLabel(v.PreRelease[0].String())
+}
+
+// stabilityOfLabel classifies a pre-release label.
+func stabilityOfLabel(label string) Stability {
+	label = strings.ToLower(label)
+	switch {
+	case strings.HasPrefix(label, "dev"):
+		return StabilityDev
+	case strings.HasPrefix(label, "alpha"), strings.HasPrefix(label, "a") && isNumeric(label[1:]):
+		return StabilityAlpha
+	case strings.HasPrefix(label, "beta"), strings.HasPrefix(label, "b") && isNumeric(label[1:]):
+		return StabilityBeta
+	case strings.HasPrefix(label, "rc"):
+		return StabilityRC
+	case strings.HasPrefix(label, "patch"), strings.HasPrefix(label, "pl") && isNumeric(label[2:]):
+		return StabilityStable
+	default:
+		return StabilityDev
+	}
+}
+
+// parseStabilityFlag parses a Composer stability flag without its leading "@".
+func parseStabilityFlag(flag string) (Stability, bool) {
+	switch strings.ToLower(flag) {
+	case "dev":
+		return StabilityDev, true
+	case "alpha":
+		return StabilityAlpha, true
+	case "beta":
+		return StabilityBeta, true
+	case "rc":
+		return StabilityRC, true
+	case "stable":
+		return StabilityStable, true
+	default:
+		return 0, false
+	}
+}
+
+// ComposerConstraint is a Composer version constraint: a VersionRange together with the
+// minimum stability a version must have to satisfy it.
+//
+// MinStability defaults to StabilityStable and is lowered by stability flags such as "@beta",
+// or by a pre-release in one of the constraint's versions (e.g., ">=1.0.0-RC1" implies "@RC").
+type ComposerConstraint struct {
+	Range        *VersionRange
+	MinStability Stability
+}
+
+// Contains checks if a version satisfies the constraint and is at least as stable as MinStability.
+//
+// Example:
+//
+//	c, _ := semver.ParseComposerConstraint("^1.0@beta")
+//	fmt.Println(c.Contains(semver.MustParse("1.2.0-beta1")))  // Output: true
+//	fmt.Println(c.Contains(semver.MustParse("1.2.0-alpha1"))) // Output: false
+func (c *ComposerConstraint) Contains(v Version) bool {
+	if StabilityOf(v) < c.MinStability {
+		return false
+	}
+	return c.Range.Contains(v)
+}
+
+// ComposerDialect parses Composer (Packagist) version constraints.
+//
+// Supported syntax:
+//   - Comparisons: "=", "==", "!=", "<>", ">", ">=", "<", "<="
+//   - Caret: "^1.2.3" is ">=1.2.3 <2.0.0"
+//   - Tilde: "~1.2" is ">=1.2.0 <2.0.0" and "~1.2.3" is ">=1.2.3 <1.3.0"
+//   - Wildcards: "1.0.*"
+//   - Hyphen ranges: "1.0 - 2.0" is ">=1.0.0 <2.1.0"
+//   - AND with commas or spaces, OR with "||" or "|"
+//   - Stability flags: "@dev", "@alpha", "@beta", "@RC", "@stable"
+//
+// ParseRange maps a stable minimum stability to PrereleaseExcluded and anything lower to
+// PrereleaseInclusive. Use ParseComposerConstraint to enforce the exact minimum stability.
+//
+// Example:
+//
+//	r, err := semver.ComposerDialect.ParseRange("~1.2 | ^3.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.9.0"))) // Output: true
+var ComposerDialect Dialect = composerDialect{}
+
+type composerDialect struct{}
+
+// composerOperators lists the Composer operators, longest first so that prefixes match greedily.
+var composerOperators = []string{">=", "<=", "!=", "<>", "==", ">", "<", "=", "~", "^"}
+
+// Name returns the name of the dialect.
+func (composerDialect) Name() string {
+	return "composer"
+}
+
+// ParseVersion parses a Composer version, tolerating a "v" prefix and coercing missing minor
+// and patch components to zero.
+func (composerDialect) ParseVersion(v string) (Version, error) {
+	return parseCoercedVersion(strings.TrimSpace(v))
+}
+
+// FormatRange formats a range as a Composer constraint.
+func (composerDialect) FormatRange(r *VersionRange) (string, error) {
+	return formatComparisons(r, formatComposerRequirement), nil
+}
+
+// formatComposerRequirement writes a requirement in Composer syntax. Composer starts ">=1.2.3"
+// and "<2.0.0" at the lowest pre-release already, and writes that pre-release as "-dev"
+// elsewhere, so the "-0" sentinel is never written.
+func formatComposerRequirement(req Requirement) string {
+	if !req.Ver.IsMinimalPrerelease() {
+		return req.String()
+	}
+	req.Ver.PreRelease = nil
+	if req.Op == OpGte || req.Op == OpLt {
+		return req.String()
+	}
+	return req.String() + "-dev"
+}
+
+// ParseRange parses a Composer constraint into a VersionRange.
+func (composerDialect) ParseRange(r string) (*VersionRange, error) {
+	c, err := ParseComposerConstraint(r)
+	if err != nil {
+		return nil, err
+	}
+	return c.Range, nil
+}
+
+// ParseComposerConstraint parses a Composer constraint, including its stability flags.
+//
+// Example:
+//
+//	c, err := semver.ParseComposerConstraint(">=1.0 <1.1 || >=1.2@beta")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(c.MinStability) // Output: beta
+func ParseComposerConstraint(r string) (*ComposerConstraint, error) {
+	c := &ComposerConstraint{
+		Range:        &VersionRange{},
+		MinStability: StabilityStable,
+	}
+
+	for _, part := range strings.Split(strings.ReplaceAll(r, "||", "|"), "|") {
+		tokens := joinOperatorTokens(strings.Fields(strings.ReplaceAll(part, ",", " ")), composerOperators)
+		if len(tokens) == 0 {
+			return nil, invalidRangeToken(r)
+		}
+
+		var group [][]Requirement
+		for i := 0; i < len(tokens); i++ {
+			var reqs [][]Requirement
+			var err error
+
+			if i+2 < len(tokens) && tokens[i+1] == "-" {
+				var lower, upper string
+				if lower, err = c.stripStabilityFlag(tokens[i]); err == nil {
+					upper, err = c.stripStabilityFlag(tokens[i+2])
+				}
+				if err == nil {
+					reqs, err = parseHyphenTokens(lower, upper)
+				}
+				i += 2
+			} else {
+				reqs, err = c.parseToken(tokens[i])
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			group = andRequirements(group, reqs)
+		}
+
+		c.Range.Requirements = append(c.Range.Requirements, group...)
+	}
+
+	for _, reqs := range c.Range.Requirements {
+		for i, req := range reqs {
+			if len(req.Ver.PreRelease) == 0 {
+				// Like Composer, inclusive lower and exclusive upper bounds start at the
+				// lowest pre-release so that pre-releases are left to the stability check.
+				if req.Op == OpGte || req.Op == OpLt {
+					reqs[i].Ver = minimalPrerelease(req.Ver.Major, req.Ver.Minor, req.Ver.Patch)
+				}
+				continue
+			}
+			if req.Ver.IsMinimalPrerelease() {
+				continue
+			}
+			if len(req.Ver.PreRelease) == 1 && req.Ver.PreRelease[0].String() == "dev" {
+				// "1.2.3-dev" is the lowest pre-release of 1.2.3, as FormatRange writes it.
+				reqs[i].Ver.PreRelease = []PrereleaseVersion{{isNumeric: true}}
+			}
+			if s := StabilityOf(req.Ver); s < c.MinStability {
+				c.MinStability = s
+			}
+		}
+	}
+
+	if c.MinStability == StabilityStable {
+		c.Range.Prerelease = PrereleaseExcluded
+	}
+
+	return c, nil
+}
+
+// stripStabilityFlag removes a trailing stability flag from token, lowering MinStability to match.
+func (c *ComposerConstraint) stripStabilityFlag(token string) (string, error) {
+	i := strings.LastIndexByte(token, '@')
+	if i < 0 {
+		return token, nil
+	}
+
+	s, ok := parseStabilityFlag(token[i+1:])
+	if !ok {
+		return "", invalidRangeToken(token)
+	}
+	if s < c.MinStability {
+		c.MinStability = s
+	}
+	return token[:i], nil
+}
+
+// parseToken parses a single operator and version token.
+func (c *ComposerConstraint) parseToken(token string) ([][]Requirement, error) {
+	stripped, err := c.stripStabilityFlag(token)
+	if err != nil {
+		return nil, err
+	}
+	if stripped == "" {
+		stripped = "*"
+	}
+
+	op, rest := splitOperator(stripped, composerOperators)
+	p, err := parsePartialVersion(rest)
+	if err != nil {
+		return nil, invalidRangeToken(token)
+	}
+
+	// Unlike npm, Composer pads partial versions with zeros unless they contain a wildcard,
+	// so "1.0" is exactly 1.0.0 and ">1.0" matches 1.0.1.
+	core, _, _ := strings.Cut(rest, "-")
+	if op != "^" && op != "~" && p.parts > 0 && !strings.ContainsAny(core, "*xX") {
+		p.parts = 3
+	}
+
+	switch op {
+	case "^":
+		return expandCaret(p), nil
+	case "~":
+		return expandComposerTilde(p), nil
+	case "", "=", "==":
+		return expandComparison(OpEq, p), nil
+	case "<>":
+		return expandComparison(OpNeq, p), nil
+	default:
+		return expandComparison(Operator(op), p), nil
+	}
+}
+
+// expandComposerTilde expands a Composer tilde range, which allows the last given component
+// to increase (e.g., "~1.2" is ">=1.2.0 <2.0.0-0" and "~1.2.3" is ">=1.2.3 <1.3.0-0").
+func expandComposerTilde(p partialVersion) [][]Requirement {
+	if p.parts == 0 {
+		return matchAll()
+	}
+
+	upper := minimalPrerelease(p.Major+1, 0, 0)
+	if p.parts == 3 {
+		upper = minimalPrerelease(p.Major, p.Minor+1, 0)
+	}
+
+	return [][]Requirement{{
+		{Op: OpGte, Ver: p.floor()},
+		{Op: OpLt, Ver: upper},
+	}}
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStabilityOf(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		version  string
+		expected Stability
+	}{
+		{"1.0.0", StabilityStable},
+		{"1.0.0-dev", StabilityDev},
+		{"1.0.0-alpha1", StabilityAlpha},
+		{"1.0.0-a2", StabilityAlpha},
+		{"1.0.0-beta.2", StabilityBeta},
+		{"1.0.0-b1", StabilityBeta},
+		{"1.0.0-RC1", StabilityRC},
+		{"1.0.0-pl1", StabilityStable},
+		{"1.0.0-snapshot", StabilityDev},
+	}
+
+	for _, test := range tests {
+		is.Equal(test.expected, StabilityOf(MustParse(test.version)), "Stability of %s", test.version)
+	}
+	is.Equal("RC", StabilityRC.String())
+	is.Equal("stable", StabilityStable.String())
+}
+
+func TestComposerConstraintContains(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		constraint  string
+		version     string
+		shouldMatch bool
+	}{
+		{"^1.2.3", "1.9.0", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^0.3", "0.3.5", true},
+		{"^0.3", "0.4.0", false},
+		{"~1.2", "1.9.0", true},
+		{"~1.2", "2.0.0", false},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"1.0.*", "1.0.9", true},
+		{"1.0.*", "1.1.0", false},
+		{"1.0 - 2.0", "2.0.5", true},
+		{"1.0 - 2.0", "2.1.0", false},
+		{">=1.0 <1.1 || >=1.2", "1.0.5", true},
+		{">=1.0 <1.1 || >=1.2", "1.1.5", false},
+		{">=1.0,<1.1 | >=1.2", "1.3.0", true},
+		{"<>1.0.1", "1.0.1", false},
+		{"v1.0.2", "1.0.2", true},
+
+		// Stability handling.
+		{"^1.2", "1.5.0-beta1", false},
+		{"^1.2@beta", "1.5.0-beta1", true},
+		{"^1.2@beta", "1.5.0-RC1", true},
+		{"^1.2@beta", "1.5.0-alpha1", false},
+		{"^1.2@beta", "1.2.0-beta1", true},
+		{"^1.2@beta", "2.0.0-beta1", false},
+		{"*@dev", "3.0.0-dev", true},
+		{">=1.0.0-RC1", "1.0.0-RC2", true},
+		{">=1.0.0-RC1", "1.1.0-beta1", false},
+		{">1.0.0-dev", "1.0.0-alpha1", true},
+		{"<2.0.0-dev", "2.0.0-alpha1", false},
+	}
+
+	for _, test := range tests {
+		c, err := ParseComposerConstraint(test.constraint)
+		is.NoError(err, "Constraint %s should parse", test.constraint)
+		if err != nil {
+			continue
+		}
+		is.Equal(test.shouldMatch, c.Contains(MustParse(test.version)), "Version %s against constraint %s", test.version, test.constraint)
+	}
+}
+
+func TestComposerPartialComparisons(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Composer pads partial versions in comparisons with zeros, so ">1.2" is ">1.2.0" and
+	// "!=1.2" excludes only 1.2.0, while wildcards keep matching a whole release line.
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{">1.2", "1.2.0", false},
+		{">1.2", "1.2.1", true},
+		{">1.2", "1.3.0-beta", false},
+		{"!=1.2", "1.2.0", false},
+		{"!=1.2", "1.2.5", true},
+		{"!=1.2", "1.1.0", true},
+		{"!=1.2.*", "1.2.5", false},
+		{"1.2", "1.2.1", false},
+		{"1.2", "1.2.0", true},
+	}
+	for _, tc := range tests {
+		c, err := ParseComposerConstraint(tc.constraint)
+		if is.NoError(err, tc.constraint) {
+			is.Equal(tc.want, c.Contains(MustParse(tc.version)), "%s %s", tc.constraint, tc.version)
+		}
+	}
+}
+
+func TestComposerDialect(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("composer", ComposerDialect.Name())
+
+	r, err := ComposerDialect.ParseRange("^1.0")
+	is.NoError(err)
+	is.Equal(PrereleaseExcluded, r.Prerelease)
+	is.False(r.Contains(MustParse("1.1.0-beta1")))
+
+	r, err = ComposerDialect.ParseRange("^1.0@alpha")
+	is.NoError(err)
+	is.Equal(PrereleaseInclusive, r.Prerelease)
+	is.True(r.Contains(MustParse("1.1.0-beta1")))
+
+	c, err := ParseComposerConstraint(">=1.0 <1.1 || >=1.2@beta")
+	is.NoError(err)
+	is.Equal(StabilityBeta, c.MinStability)
+
+	for _, input := range []string{"", "1.0 |", "^1.0@unstable", "dev-master", ">=abc"} {
+		_, err := ParseComposerConstraint(input)
+		is.ErrorIs(err, ErrInvalidRangeToken, "Expected error for input: %q", input)
+	}
+}
@@ -5,10 +5,19 @@
 
 package semver
 
+import (
+	"io"
+)
+
 // ConfigOptions holds the configurable options for the Parser.
 // It is used with the Function Options pattern.
 type ConfigOptions struct {
-	Strict bool
+	Strict   bool
+	Revision bool
+	Epoch    bool
+	Observer Observer
+	Trace    io.Writer
+	Suggest  bool
 }
 
 // Config holds the runtime configuration for the parser.
@@ -42,7 +51,12 @@ type Configuration interface {
 }
 
 type runtimeConfig struct {
-	strict bool
+	strict   bool
+	revision bool
+	epoch    bool
+	observer Observer
+	trace    io.Writer
+	suggest  bool
 }
 
 // Option defines a function type for configuring the Parser.
@@ -83,6 +97,68 @@ func WithStrictAdherence(value bool) Option {
 	}
 }
 
+// WithRevision enables a compatibility mode that accepts an optional fourth numeric segment,
+// as used by Windows-style and some vendor versions such as "1.2.3.4".
+//
+// The fourth segment is stored in Version.Revision and compared numerically after the patch
+// version. It is not part of the Semantic Versioning specification, so it is disabled by default
+// and such inputs fail with ErrUnexpectedCharacter.
+//
+// Parameters:
+// - value: A boolean indicating whether a fourth segment should be accepted.
+//
+// Returns:
+// - Option: A functional option that can be passed to a configuration function to modify behavior.
+//
+// Example usage:
+//
+//	parser, err := NewParser(WithRevision(true))
+//	if err != nil {
+//	    log.Fatalf("Failed to create parser: %v", err)
+//	}
+//
+//	version, err := parser.Parse("10.0.19041.1165")
+//	if err != nil {
+//	    log.Fatalf("Failed to parse version: %v", err)
+//	}
+//	fmt.Println(version.Revision) // Output: 1165
+func WithRevision(value bool) Option {
+	return func(o *ConfigOptions) {
+		o.Revision = value
+	}
+}
+
+// WithEpoch enables parsing an optional epoch prefix, as in PEP 440 versions such as "2!1.4.0".
+//
+// The epoch is stored in Version.Epoch and takes precedence over every other field when
+// comparing, which gives migrations between incompatible versioning schemes a sanctioned
+// ordering. It is not part of the Semantic Versioning specification, so it is disabled by
+// default and such inputs fail to parse.
+//
+// Parameters:
+// - value: A boolean indicating whether an epoch prefix should be accepted.
+//
+// Returns:
+// - Option: A functional option that can be passed to a configuration function to modify behavior.
+//
+// Example usage:
+//
+//	parser, err := NewParser(WithEpoch(true))
+//	if err != nil {
+//	    log.Fatalf("Failed to create parser: %v", err)
+//	}
+//
+//	version, err := parser.Parse("2!1.4.0")
+//	if err != nil {
+//	    log.Fatalf("Failed to parse version: %v", err)
+//	}
+//	fmt.Println(version.Epoch) // Output: 2
+func WithEpoch(value bool) Option {
+	return func(o *ConfigOptions) {
+		o.Epoch = value
+	}
+}
+
 // StrictAdherence returns a boolean value indicating whether strict adherence is enabled.
 // This method is used to determine if the configuration should follow strict rules,
 // such as requiring full compliance with the Semantic Versioning specification.
@@ -106,6 +182,11 @@ func (c *runtimeConfig) StrictAdherence() bool {
 
 func buildRuntimeConfig(opts *ConfigOptions) (*runtimeConfig, error) {
 	return &runtimeConfig{
-		strict: opts.Strict,
+		strict:   opts.Strict,
+		revision: opts.Revision,
+		epoch:    opts.Epoch,
+		observer: opts.Observer,
+		trace:    opts.Trace,
+		suggest:  opts.Suggest,
 	}, nil
 }
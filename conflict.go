@@ -0,0 +1,172 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Conflict is a pair of named constraints that no version can satisfy together.
+type Conflict struct {
+	A           string `json:"a"`
+	B           string `json:"b"`
+	ConstraintA string `json:"constraint_a"`
+	ConstraintB string `json:"constraint_b"`
+}
+
+// ConflictReport is a human- and machine-readable analysis of a set of named constraints,
+// such as the ranges that several dependents place on a shared dependency.
+//
+// If Satisfiable is true, Combined is the narrowest range matching every version allowed by all
+// constraints. Otherwise, Conflicts lists every pair of constraints that cannot be satisfied
+// together, sorted by name. Constraints that only conflict as a group of three or more leave
+// Satisfiable false with no pairwise Conflicts.
+type ConflictReport struct {
+	Satisfiable   bool          `json:"satisfiable"`
+	Combined      *VersionRange `json:"-"`
+	CombinedRange string        `json:"combined,omitempty"`
+	Conflicts     []Conflict    `json:"conflicts,omitempty"`
+}
+
+// AnalyzeConflicts parses a map of constraint owner to range expression with ParseRange and
+// reports whether the constraints can be satisfied together.
+//
+// Requirements using "!=" are honoured only when their range has a single OR branch; otherwise
+// the excluded versions are treated as included. Pre-release policies are ignored.
+//
+// Example:
+//
+//	report, err := semver.AnalyzeConflicts(map[string]string{
+//	    "a": ">=2.0.0",
+//	    "b": "<2.0.0",
+//	    "c": ">=1.0.0",
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(report) // Output: unsatisfiable: a (>=2.0.0) conflicts with b (<2.0.0)
+func AnalyzeConflicts(constraints map[string]string) (*ConflictReport, error) {
+	return AnalyzeConflictsWithDialect(nil, constraints)
+}
+
+// AnalyzeConflictsWithDialect is like AnalyzeConflicts but parses the constraints with the given
+// Dialect. A nil Dialect uses ParseRange.
+//
+// Example:
+//
+//	report, _ := semver.AnalyzeConflictsWithDialect(semver.HelmDialect, map[string]string{
+//	    "a": ">=1.2",
+//	    "b": "^1.0",
+//	})
+//	fmt.Println(report) // Output: satisfiable: >=1.2.0 <2.0.0-0
+func AnalyzeConflictsWithDialect(d Dialect, constraints map[string]string) (*ConflictReport, error) {
+	parse := ParseRange
+	if d != nil {
+		parse = d.ParseRange
+	}
+
+	names := make([]string, 0, len(constraints))
+	for name := range constraints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ranges := make([]*VersionRange, len(names))
+	for i, name := range names {
+		vr, err := parse(constraints[name])
+		if err != nil {
+			return nil, fmt.Errorf("constraint %s: %w", name, err)
+		}
+		ranges[i] = vr
+	}
+
+	report := &ConflictReport{}
+	for i := range names {
+		for j := i + 1; j < len(names); j++ {
+			if len(combineConstraints(ranges[i], ranges[j])) == 0 {
+				report.Conflicts = append(report.Conflicts, Conflict{
+					A:           names[i],
+					B:           names[j],
+					ConstraintA: constraints[names[i]],
+					ConstraintB: constraints[names[j]],
+				})
+			}
+		}
+	}
+
+	if len(report.Conflicts) > 0 {
+		return report, nil
+	}
+
+	branches := combineConstraints(ranges...)
+	if len(branches) == 0 {
+		return report, nil
+	}
+
+	report.Satisfiable = true
+	report.Combined = &VersionRange{Requirements: branches}
+	report.CombinedRange = report.Combined.String()
+	return report, nil
+}
+
+// String returns a human-readable summary of the report.
+func (r *ConflictReport) String() string {
+	if r.Satisfiable {
+		return "satisfiable: " + r.CombinedRange
+	}
+	if len(r.Conflicts) == 0 {
+		return "unsatisfiable: no version satisfies every constraint"
+	}
+
+	parts := make([]string, len(r.Conflicts))
+	for i, c := range r.Conflicts {
+		parts[i] = fmt.Sprintf("%s (%s) conflicts with %s (%s)", c.A, c.ConstraintA, c.B, c.ConstraintB)
+	}
+	return "unsatisfiable: " + strings.Join(parts, "; ")
+}
+
+// combineConstraints returns the requirements, in disjunctive normal form, of the narrowest range
+// matching every version allowed by all of the ranges. The result is empty if no version does.
+func combineConstraints(ranges ...*VersionRange) [][]Requirement {
+	ivs := []Interval{unboundedInterval()}
+	var excluded []Version
+	for _, vr := range ranges {
+		ivs = intersectIntervals(ivs, vr.Intervals())
+		if len(vr.Requirements) != 1 {
+			continue
+		}
+		for _, req := range vr.Requirements[0] {
+			if req.Op == OpNeq && !slices.ContainsFunc(excluded, req.Ver.Equal) {
+				excluded = append(excluded, req.Ver)
+			}
+		}
+	}
+
+	var branches [][]Requirement
+	for _, iv := range ivs {
+		reqs := iv.requirements()
+		singleton := len(reqs) == 1 && reqs[0].Op == OpEq
+
+		skip := false
+		for _, v := range excluded {
+			if !iv.includes(Interval{Lower: Bound{Version: v, Inclusive: true}, Upper: Bound{Version: v, Inclusive: true}}) {
+				continue
+			}
+			if singleton {
+				skip = true
+				break
+			}
+			reqs = append(reqs, Requirement{Op: OpNeq, Ver: v})
+		}
+		if !skip {
+			branches = append(branches, reqs)
+		}
+	}
+	return branches
+}
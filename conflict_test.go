@@ -0,0 +1,83 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeConflicts(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	report, err := AnalyzeConflicts(map[string]string{
+		"a": ">=2.0.0",
+		"b": "<2.0.0",
+		"c": ">=1.0.0",
+	})
+	is.NoError(err)
+	is.False(report.Satisfiable)
+	is.Nil(report.Combined)
+	is.Equal([]Conflict{{A: "a", B: "b", ConstraintA: ">=2.0.0", ConstraintB: "<2.0.0"}}, report.Conflicts)
+	is.Equal("unsatisfiable: a (>=2.0.0) conflicts with b (<2.0.0)", report.String())
+
+	report, err = AnalyzeConflicts(map[string]string{
+		"a": ">=1.0.0 <3.0.0 !=1.5.0",
+		"b": ">=1.2.0 || >=4.0.0",
+		"c": "<=2.5.0",
+	})
+	is.NoError(err)
+	is.True(report.Satisfiable)
+	is.Empty(report.Conflicts)
+	is.Equal(">=1.2.0 <=2.5.0 !=1.5.0", report.CombinedRange)
+	is.True(report.Combined.Contains(MustParse("2.0.0")))
+	is.False(report.Combined.Contains(MustParse("1.5.0")))
+	is.False(report.Combined.Contains(MustParse("2.6.0")))
+}
+
+func TestAnalyzeConflictsExcludedSingleton(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	report, err := AnalyzeConflicts(map[string]string{"a": "=1.0.0", "b": "!=1.0.0"})
+	is.NoError(err)
+	is.False(report.Satisfiable)
+	is.Len(report.Conflicts, 1)
+}
+
+func TestAnalyzeConflictsGroup(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	report, err := AnalyzeConflicts(map[string]string{
+		"a": "1.0.0 || 2.0.0",
+		"b": "2.0.0 || 3.0.0",
+		"c": "1.0.0 || 3.0.0",
+	})
+	is.NoError(err)
+	is.False(report.Satisfiable)
+	is.Empty(report.Conflicts)
+	is.Equal("unsatisfiable: no version satisfies every constraint", report.String())
+}
+
+func TestAnalyzeConflictsWithDialect(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	report, err := AnalyzeConflictsWithDialect(HelmDialect, map[string]string{"a": ">=1.2", "b": "^1.0"})
+	is.NoError(err)
+	is.Equal("satisfiable: >=1.2.0 <2.0.0-0", report.String())
+
+	data, err := json.Marshal(report)
+	is.NoError(err)
+	is.JSONEq(`{"satisfiable":true,"combined":">=1.2.0 <2.0.0-0"}`, string(data))
+
+	_, err = AnalyzeConflicts(map[string]string{"a": ">=2"})
+	is.ErrorContains(err, "constraint a")
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// ContainsString is like Contains but takes a version string, which is validated as Parse would
+// validate it.
+//
+// The version is compared directly against the range's requirements without constructing its
+// PreRelease and BuildMetadata slices, so a valid version is checked without allocating. This
+// suits gatekeeping proxies that evaluate a policy range against very many version strings.
+// A range with an Observer parses the version instead, so that the evaluation can be reported.
+//
+// Example:
+//
+//	r := semver.MustParseRange("^1.2.0")
+//	ok, err := r.ContainsString("1.4.7+build.9")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(ok) // Output: true
+func (vr *VersionRange) ContainsString(s string) (bool, error) {
+	if vr.observer == nil {
+		if sv, ok := scanVersion(s); ok {
+			return vr.containsScanned(sv), nil
+		}
+	}
+
+	// Invalid input, numbers too large to compare as scanned digits, or an observed range,
+	// whose observer is reported the parsed Version.
+	v, err := Parse(s)
+	if err != nil {
+		return false, err
+	}
+	return vr.Contains(v), nil
+}
+
+// containsScanned is like Contains for a scanned version.
+func (vr *VersionRange) containsScanned(sv scannedVersion) bool {
+	isPrerelease := sv.prerelease != ""
+	if isPrerelease && vr.Prerelease == PrereleaseExcluded {
+		return false
+	}
+
+	for _, andReqs := range vr.Requirements {
+		if isPrerelease && vr.Prerelease == PrereleaseOptIn && !prereleaseOptedIn(andReqs) {
+			continue
+		}
+		matchesAll := true
+		for i := range andReqs {
+			req := &andReqs[i]
+			if !req.containsScanned(sv) {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			return true
+		}
+	}
+	return false
+}
+
+// containsScanned is like Contains for a scanned version.
+func (r *Requirement) containsScanned(sv scannedVersion) bool {
+	switch r.Op {
+	case OpEq:
+		return sv.compare(r.Ver) == 0
+	case OpGt:
+		return sv.compare(r.Ver) > 0
+	case OpGte:
+		return sv.compare(r.Ver) >= 0
+	case OpLt:
+		return sv.compare(r.Ver) < 0
+	case OpLte:
+		return sv.compare(r.Ver) <= 0
+	case OpNeq:
+		return sv.compare(r.Ver) != 0
+	case OpCaret, OpTilde:
+		// The exclusive upper bound is the ceiling with a "-0" pre-release, which every
+		// version with a lower core, and no version with the same core, precedes.
+		return sv.compare(r.Ver) >= 0 && sv.compareCore(r.shorthandCeiling()) < 0
+	default:
+		return false
+	}
+}
+
+// compare compares the scanned version with v by precedence.
+func (sv scannedVersion) compare(v Version) int {
+	if v.Epoch != 0 {
+		// Scanned versions have no epoch.
+		return -1
+	}
+	if c := sv.compareCore(v.Major, v.Minor, v.Patch); c != 0 {
+		return c
+	}
+	if v.Revision != 0 {
+		// Scanned versions have no revision.
+		return -1
+	}
+	return comparePrereleaseIdentifiers(sv.prerelease, v.PreRelease)
+}
+
+// compareCore compares the major, minor, and patch numbers of the scanned version.
+func (sv scannedVersion) compareCore(major, minor, patch uint64) int {
+	for i, n := range [3]uint64{major, minor, patch} {
+		if m := parseDigits(sv.core[i]); m != n {
+			if m < n {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// parseDigits converts a string of at most maxScannedDigits digits to a number.
+func parseDigits(s string) uint64 {
+	var n uint64
+	for i := 0; i < len(s); i++ {
+		n = n*10 + uint64(s[i]-'0')
+	}
+	return n
+}
+
+// comparePrereleaseIdentifiers compares a validated pre-release string with parsed identifiers.
+// An empty pre-release has higher precedence than any non-empty one.
+func comparePrereleaseIdentifiers(s string, ids []PrereleaseVersion) int {
+	switch {
+	case s == "" && len(ids) == 0:
+		return 0
+	case s == "":
+		return 1
+	case len(ids) == 0:
+		return -1
+	}
+
+	for i := 0; ; i++ {
+		if i == len(ids) {
+			return 1
+		}
+
+		id, rest, more := strings.Cut(s, ".")
+		var c int
+		switch numeric := isNumeric(id); {
+		case numeric && ids[i].isNumeric:
+			n := parseDigits(id)
+			switch {
+			case n < ids[i].partNumeric:
+				c = -1
+			case n > ids[i].partNumeric:
+				c = 1
+			}
+		case numeric:
+			c = -1
+		case ids[i].isNumeric:
+			c = 1
+		default:
+			c = strings.Compare(id, ids[i].partString)
+		}
+		if c != 0 {
+			return c
+		}
+
+		if !more {
+			if i+1 < len(ids) {
+				return -1
+			}
+			return 0
+		}
+		s = rest
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionRangeContainsString(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ranges := []*VersionRange{
+		MustParseRange(">=1.0.0 <2.0.0 || >=3.0.0 !=3.1.0"),
+		MustParseRange("^1.2.3 || ~0.2.3"),
+		MustParseRange("^0.0.3"),
+		MustParseRange(">1.0.0-alpha.1 <=1.0.0-beta.11"),
+		MustParseRange("1.0.0-rc.1+build"),
+		{Requirements: MustParseRange(">=1.0.0").Requirements, Prerelease: PrereleaseExcluded},
+		{Requirements: MustParseRange(">=1.0.0-0 <2.0.0").Requirements, Prerelease: PrereleaseOptIn},
+		{Requirements: [][]Requirement{{{Op: "=>", Ver: MustParse("1.0.0")}}}},
+	}
+	versions := []string{
+		"0.0.3", "0.0.4", "0.2.3", "0.2.9", "0.3.0", "1.0.0-alpha", "1.0.0-alpha.1",
+		"1.0.0-alpha.1.1", "1.0.0-alpha.beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-beta.12",
+		"1.0.0-rc.1", "1.0.0", "1.2.3", "1.9.9+meta", "2.0.0-0", "2.0.0", "3.0.0", "3.1.0",
+		"3.1.0+build", "18446744073709551615.0.0",
+	}
+
+	for _, r := range ranges {
+		for _, s := range versions {
+			ok, err := r.ContainsString(s)
+			is.NoError(err)
+			is.Equal(r.Contains(MustParse(s)), ok, "Range %s with version %s", r, s)
+		}
+	}
+
+	_, err := ranges[0].ContainsString("1.02.0")
+	is.ErrorIs(err, ErrLeadingZeroInNumericIdentifier)
+}
+
+func TestVersionRangeContainsStringAllocations(t *testing.T) {
+	r := MustParseRange("^1.2.0 || >=3.0.0-rc.1 !=3.1.0")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = r.ContainsString("3.0.0-rc.2+build.5")
+		_, _ = r.ContainsString("1.4.7")
+	})
+	assert.Zero(t, allocs)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"context"
+)
+
+// ParseContext is like Parse but honors the deadline and cancellation of ctx, so that servers
+// parsing untrusted input on behalf of a request can bound the time spent on it.
+//
+// The context is checked before and after parsing, and before each pre-release and build
+// identifier, so that a version with a very large number of identifiers stops being parsed soon
+// after the context is done. In that case ParseContext returns the context's error, which can be
+// tested with errors.Is against context.Canceled or context.DeadlineExceeded, instead of a
+// result produced after the deadline.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+//	defer cancel()
+//	v, err := semver.ParseContext(ctx, "1.2.3-alpha.1")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.3-alpha.1
+func ParseContext(ctx context.Context, version string) (Version, error) {
+	if err := ctx.Err(); err != nil {
+		return Version{}, err
+	}
+
+	var v Version
+	var err error
+	if p, ok := DefaultParser.(*parser); ok {
+		withContext := *p
+		withContext.ctx = ctx
+		v, err = withContext.Parse(version)
+	} else {
+		v, err = Parse(version)
+	}
+	if err != nil {
+		return Version{}, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return Version{}, err
+	}
+	return v, nil
+}
+
+// ParseRangeContext is like ParseRange but honors the deadline and cancellation of ctx.
+//
+// The context is checked before each token of the range, so that a range made of a very large
+// number of comparators stops being parsed soon after the context is done. In that case
+// ParseRangeContext returns the context's error.
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+//	defer cancel()
+//	r, err := semver.ParseRangeContext(ctx, ">=1.0.0 <2.0.0 || >=3.0.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.5.0"))) // Output: true
+func ParseRangeContext(ctx context.Context, r string) (*VersionRange, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return parseRange(ctx, r)
+}
+
+// ctxErr returns the error of the parser's context, or nil if it has none or is not done.
+func (p *parser) ctxErr() error {
+	if p.ctx == nil {
+		return nil
+	}
+	return p.ctx.Err()
+}
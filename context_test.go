@@ -0,0 +1,88 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// expiringContext is a context whose Err reports cancellation once it has been called more than
+// checks times, so that tests can cancel parsing part-way through.
+type expiringContext struct {
+	context.Context
+	checks int
+}
+
+func (c *expiringContext) Err() error {
+	if c.checks <= 0 {
+		return context.Canceled
+	}
+	c.checks--
+	return nil
+}
+
+func TestParseContext(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v, err := ParseContext(context.Background(), "1.2.3-alpha.1+build")
+	is.NoError(err)
+	is.Equal("1.2.3-alpha.1+build", v.String())
+
+	_, err = ParseContext(context.Background(), "1.2")
+	is.ErrorIs(err, ErrMissingVersionElements)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ParseContext(ctx, "1.2.3")
+	is.ErrorIs(err, context.Canceled)
+
+	// The context expiring while parsing discards the result.
+	_, err = ParseContext(&expiringContext{Context: context.Background(), checks: 1}, "1.2.3")
+	is.ErrorIs(err, context.Canceled)
+
+	// A version with many identifiers stops at the first identifier checked after the context
+	// is done, rather than only once parsing has finished.
+	long := "1.0.0-" + strings.TrimSuffix(strings.Repeat("a.", 1000), ".")
+	_, err = ParseContext(&expiringContext{Context: context.Background(), checks: 10}, long)
+	is.ErrorIs(err, context.Canceled)
+
+	_, err = ParseContext(&expiringContext{Context: context.Background(), checks: 1002}, long)
+	is.NoError(err)
+
+	long = "1.0.0+" + strings.TrimSuffix(strings.Repeat("b.", 1000), ".")
+	_, err = ParseContext(&expiringContext{Context: context.Background(), checks: 10}, long)
+	is.ErrorIs(err, context.Canceled)
+}
+
+func TestParseRangeContext(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r, err := ParseRangeContext(context.Background(), ">=1.0.0 <2.0.0 || >=3.0.0")
+	is.NoError(err)
+	is.Equal(MustParseRange(">=1.0.0 <2.0.0 || >=3.0.0"), r)
+
+	_, err = ParseRangeContext(context.Background(), ">=1.0.0 ?2")
+	is.Error(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = ParseRangeContext(ctx, ">=1.0.0")
+	is.ErrorIs(err, context.Canceled)
+
+	// A long range stops at the first token checked after the context is done.
+	long := strings.Repeat(">=1.0.0 ", 1000)
+	_, err = ParseRangeContext(&expiringContext{Context: context.Background(), checks: 10}, long)
+	is.ErrorIs(err, context.Canceled)
+
+	_, err = ParseRangeContext(&expiringContext{Context: context.Background(), checks: 1001}, long)
+	is.NoError(err)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// RangeCoverage reports which versions of a release catalog a range admits.
+//
+// Admitted holds the admitted versions in catalog order, and Newest the highest of them, or the
+// zero Version if none is admitted. Released is the number of versions in the catalog.
+// Unsatisfiable is true if the range admits no version at all, whatever the catalog, such as
+// ">=2.0.0 <1.0.0".
+type RangeCoverage struct {
+	Admitted      []Version
+	Newest        Version
+	Released      int
+	Unsatisfiable bool
+}
+
+// AdmitsNone reports whether the range admits none of the released versions, which makes it a
+// dead constraint for the catalog.
+func (c RangeCoverage) AdmitsNone() bool {
+	return len(c.Admitted) == 0
+}
+
+// AdmitsAll reports whether the range admits every released version, which makes it no
+// constraint at all for the catalog. It is false for an empty catalog.
+func (c RangeCoverage) AdmitsAll() bool {
+	return c.Released > 0 && len(c.Admitted) == c.Released
+}
+
+// Ratio returns the fraction of released versions the range admits, between 0 and 1, or 0 for
+// an empty catalog.
+func (c RangeCoverage) Ratio() float64 {
+	if c.Released == 0 {
+		return 0
+	}
+	return float64(len(c.Admitted)) / float64(c.Released)
+}
+
+// Coverage reports which of the released versions the range admits, following the range's
+// pre-release policy, as Contains does. Use it to lint manifests for constraints that are
+// overly broad, admitting every release, or dead, admitting none.
+//
+// Example:
+//
+//	released := []semver.Version{semver.MustParse("1.0.0"), semver.MustParse("1.4.2"), semver.MustParse("2.0.0")}
+//	c := semver.Coverage(semver.MustParseRange("^1.0.0"), released)
+//	fmt.Println(len(c.Admitted), c.Newest, c.AdmitsAll()) // Output: 2 1.4.2 false
+func Coverage(r *VersionRange, released []Version) RangeCoverage {
+	c := RangeCoverage{
+		Released:      len(released),
+		Unsatisfiable: len(r.exactIntervals()) == 0,
+	}
+
+	var newest *Version
+	for i := range released {
+		if !r.Contains(released[i]) {
+			continue
+		}
+		c.Admitted = append(c.Admitted, released[i])
+		if newest == nil || released[i].GreaterThan(*newest) {
+			newest = &released[i]
+		}
+	}
+	if newest != nil {
+		c.Newest = *newest
+	}
+	return c
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoverage(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	released := []Version{
+		MustParse("1.4.2"),
+		MustParse("1.0.0"),
+		MustParse("2.0.0-rc.1"),
+		MustParse("2.0.0"),
+	}
+
+	tests := []struct {
+		rng           string
+		admitted      []string
+		newest        string
+		none, all     bool
+		unsatisfiable bool
+	}{
+		{"^1.0.0", []string{"1.4.2", "1.0.0"}, "1.4.2", false, false, false},
+		{">=1.1.0", []string{"1.4.2", "2.0.0-rc.1", "2.0.0"}, "2.0.0", false, false, false},
+		{">=0.0.0-0", []string{"1.4.2", "1.0.0", "2.0.0-rc.1", "2.0.0"}, "2.0.0", false, true, false},
+		{"^3.0.0", nil, "0.0.0", true, false, false},
+		{">=2.0.0 <1.0.0", nil, "0.0.0", true, false, true},
+		{"=1.0.0 !=1.0.0", nil, "0.0.0", true, false, true},
+	}
+
+	for _, tt := range tests {
+		c := Coverage(MustParseRange(tt.rng), released)
+		var admitted []string
+		for _, v := range c.Admitted {
+			admitted = append(admitted, v.String())
+		}
+		is.Equal(tt.admitted, admitted, tt.rng)
+		is.Equal(tt.newest, c.Newest.String(), tt.rng)
+		is.Equal(tt.none, c.AdmitsNone(), tt.rng)
+		is.Equal(tt.all, c.AdmitsAll(), tt.rng)
+		is.Equal(tt.unsatisfiable, c.Unsatisfiable, tt.rng)
+		is.Equal(len(released), c.Released, tt.rng)
+	}
+
+	c := Coverage(MustParseRange("^1.0.0"), released)
+	is.InDelta(0.5, c.Ratio(), 1e-9)
+
+	empty := Coverage(MustParseRange(">=1.0.0"), nil)
+	is.True(empty.AdmitsNone())
+	is.False(empty.AdmitsAll())
+	is.Zero(empty.Ratio())
+}
@@ -0,0 +1,383 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Dialect parses range expressions written in an ecosystem-specific syntax into a VersionRange.
+//
+// Each Dialect maps its own operators, wildcards, and pre-release conventions onto the
+// requirements and PrereleasePolicy understood by VersionRange, so that ranges from different
+// ecosystems can be evaluated by the same engine.
+//
+// Example:
+//
+//	r, err := semver.HelmDialect.ParseRange("^1.2.x")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.5.0"))) // Output: true
+type Dialect interface {
+	// Name returns the name of the dialect, such as "helm".
+	Name() string
+
+	// ParseRange parses a range expression written in the dialect.
+	ParseRange(r string) (*VersionRange, error)
+}
+
+// VersionDialect is a Dialect that also parses single versions written in its ecosystem's
+// syntax, such as the "v1.2" accepted by Helm. The built-in dialects implement it.
+//
+// Example:
+//
+//	if vd, ok := semver.NuGetDialect.(semver.VersionDialect); ok {
+//	    v, _ := vd.ParseVersion("1.2")
+//	    fmt.Println(v) // Output: 1.2.0
+//	}
+type VersionDialect interface {
+	Dialect
+
+	// ParseVersion parses a single version written in the dialect.
+	ParseVersion(v string) (Version, error)
+}
+
+// partialVersion is a version whose trailing numeric components may be omitted or wildcards,
+// as found in the range syntaxes of many ecosystems (e.g., "1", "1.2", "1.2.x", "*").
+type partialVersion struct {
+	Version
+
+	// parts is the number of numeric components that were explicitly given (0 to 3).
+	parts int
+}
+
+// isWildcard reports whether s is a wildcard component.
+func isWildcard(s string) bool {
+	return s == "x" || s == "X" || s == "*"
+}
+
+// parsePartialVersion parses a possibly partial version. A leading "v" or "V" is tolerated.
+// Components after the first omitted or wildcard component must also be omitted or wildcards,
+// and a pre-release may only follow a version without wildcards.
+func parsePartialVersion(s string) (partialVersion, error) {
+	var p partialVersion
+
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "v"), "V")
+	if s == "" {
+		return p, ErrEmptyVersionString
+	}
+
+	core, suffix := s, ""
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		core, suffix = s[:i], s[i:]
+	}
+
+	if strings.Count(core, ".") > 2 {
+		return p, ErrUnexpectedCharacter
+	}
+
+	var numbers [3]uint64
+	hasWildcard := false
+	for i, rest, more := 0, core, true; more; i++ {
+		var c string
+		c, rest, more = strings.Cut(rest, ".")
+		if isWildcard(c) {
+			hasWildcard = true
+			continue
+		}
+		if p.parts != i {
+			return p, ErrInvalidNumericIdentifier
+		}
+		if c == "" || !isNumeric(c) {
+			return p, ErrInvalidNumericIdentifier
+		}
+		n, err := strconv.ParseUint(c, 10, 64)
+		if err != nil {
+			return p, ErrInvalidNumericIdentifier
+		}
+		numbers[i] = n
+		p.parts++
+	}
+
+	if hasWildcard && suffix != "" && suffix[0] == '-' {
+		return p, ErrMissingVersionElements
+	}
+
+	p.Version = Version{Major: numbers[0], Minor: numbers[1], Patch: numbers[2]}
+	if suffix != "" {
+		if _, err := suffixParser.parsePreReleaseAndBuildMetadata(suffix, 0, len(suffix), &p.Version, nil, nil); err != nil {
+			return p, err
+		}
+	}
+
+	return p, nil
+}
+
+// suffixParser parses the pre-release and build metadata of partial versions, whose numeric
+// components are already parsed, without the tracing or observer of DefaultParser.
+var suffixParser = &parser{config: &runtimeConfig{strict: true}}
+
+// parseCoercedVersion parses a version that may omit its minor and patch components, which are
+// coerced to zero. A leading "v" or "V" is tolerated, but wildcards are not.
+func parseCoercedVersion(version string) (Version, error) {
+	p, err := parsePartialVersion(version)
+	if err != nil {
+		return Version{}, err
+	}
+
+	core := version
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+	if p.parts == 0 || strings.ContainsAny(core, "xX*") {
+		return Version{}, ErrInvalidNumericIdentifier
+	}
+
+	return p.Version, nil
+}
+
+// parseInterval parses a bracketed interval such as "[1.0,2.0)" or "[1.0]", as used by NuGet,
+// Maven, and Gradle. Bounds are inclusive with "[" and "]", and exclusive with any of the
+// characters in exclusiveLower and exclusiveUpper respectively. Either bound may be omitted.
+func parseInterval(s string, exclusiveLower, exclusiveUpper string) ([]Requirement, error) {
+	if len(s) < 3 {
+		return nil, invalidRangeToken(s)
+	}
+
+	lowerBracket, upperBracket := s[0], s[len(s)-1]
+	if !strings.ContainsRune("["+exclusiveLower, rune(lowerBracket)) ||
+		!strings.ContainsRune("]"+exclusiveUpper, rune(upperBracket)) {
+		return nil, invalidRangeToken(s)
+	}
+	body := s[1 : len(s)-1]
+
+	lower, upper, isInterval := strings.Cut(body, ",")
+	if !isInterval {
+		if lowerBracket != '[' || upperBracket != ']' {
+			return nil, invalidRangeToken(s)
+		}
+		v, err := parseCoercedVersion(strings.TrimSpace(body))
+		if err != nil {
+			return nil, invalidRangeToken(s)
+		}
+		return []Requirement{{Op: OpEq, Ver: v}}, nil
+	}
+
+	var reqs []Requirement
+	if lower = strings.TrimSpace(lower); lower != "" {
+		v, err := parseCoercedVersion(lower)
+		if err != nil {
+			return nil, invalidRangeToken(lower)
+		}
+		op := OpGte
+		if lowerBracket != '[' {
+			op = OpGt
+		}
+		reqs = append(reqs, Requirement{Op: op, Ver: v})
+	}
+	if upper = strings.TrimSpace(upper); upper != "" {
+		v, err := parseCoercedVersion(upper)
+		if err != nil {
+			return nil, invalidRangeToken(upper)
+		}
+		op := OpLte
+		if upperBracket != ']' {
+			op = OpLt
+		}
+		reqs = append(reqs, Requirement{Op: op, Ver: v})
+	}
+
+	if len(reqs) == 0 {
+		return nil, invalidRangeToken(s)
+	}
+	return reqs, nil
+}
+
+// floor returns the lowest version covered by the partial version.
+func (p partialVersion) floor() Version {
+	return p.Version
+}
+
+// ceiling returns the lowest version above every version covered by the partial version,
+// with a "-0" pre-release so that pre-releases of the ceiling are also excluded.
+// It must not be called on a complete version or on a bare wildcard.
+func (p partialVersion) ceiling() Version {
+	switch p.parts {
+	case 1:
+		return minimalPrerelease(p.Major+1, 0, 0)
+	default:
+		return minimalPrerelease(p.Major, p.Minor+1, 0)
+	}
+}
+
+// nextRelease returns the lowest release above every version covered by the partial version.
+// Unlike ceiling, it is a lower bound that does not admit pre-releases of that release.
+// It must not be called on a complete version or on a bare wildcard.
+func (p partialVersion) nextRelease() Version {
+	v := p.ceiling()
+	v.PreRelease = nil
+	return v
+}
+
+// minimalPrerelease returns major.minor.patch-0, the lowest possible version with that core.
+func minimalPrerelease(major, minor, patch uint64) Version {
+	return Version{
+		Major:      major,
+		Minor:      minor,
+		Patch:      patch,
+		PreRelease: []PrereleaseVersion{{isNumeric: true}},
+	}
+}
+
+// matchAll returns requirements that match every release version.
+func matchAll() [][]Requirement {
+	return [][]Requirement{{{Op: OpGte, Ver: Version{}}}}
+}
+
+// matchNone returns requirements that match no version.
+func matchNone() [][]Requirement {
+	return [][]Requirement{{{Op: OpLt, Ver: minimalPrerelease(0, 0, 0)}}}
+}
+
+// expandComparison expands an operator applied to a partial version into requirements,
+// returned in disjunctive normal form. Supported operators are OpEq, OpNeq, OpGt, OpGte,
+// OpLt, and OpLte.
+func expandComparison(op Operator, p partialVersion) [][]Requirement {
+	if p.parts == 3 {
+		return [][]Requirement{{{Op: op, Ver: p.Version}}}
+	}
+
+	if p.parts == 0 {
+		switch op {
+		case OpLt, OpNeq:
+			return matchNone()
+		default:
+			return matchAll()
+		}
+	}
+
+	switch op {
+	case OpGt:
+		return [][]Requirement{{{Op: OpGte, Ver: p.nextRelease()}}}
+	case OpGte:
+		return [][]Requirement{{{Op: OpGte, Ver: p.floor()}}}
+	case OpLt:
+		return [][]Requirement{{{Op: OpLt, Ver: p.floor()}}}
+	case OpLte:
+		return [][]Requirement{{{Op: OpLt, Ver: p.ceiling()}}}
+	case OpNeq:
+		return [][]Requirement{
+			{{Op: OpLt, Ver: p.floor()}},
+			{{Op: OpGte, Ver: p.nextRelease()}},
+		}
+	default:
+		return [][]Requirement{{
+			{Op: OpGte, Ver: p.floor()},
+			{Op: OpLt, Ver: p.ceiling()},
+		}}
+	}
+}
+
+// expandCaret expands a caret range, which allows changes that do not modify the left-most
+// non-zero component (e.g., "^1.2.3" is ">=1.2.3 <2.0.0-0" and "^0.2.3" is ">=0.2.3 <0.3.0-0").
+func expandCaret(p partialVersion) [][]Requirement {
+	if p.parts == 0 {
+		return matchAll()
+	}
+
+	var upper Version
+	switch {
+	case p.Major > 0 || p.parts == 1:
+		upper = minimalPrerelease(p.Major+1, 0, 0)
+	case p.Minor > 0 || p.parts == 2:
+		upper = minimalPrerelease(0, p.Minor+1, 0)
+	default:
+		upper = minimalPrerelease(0, 0, p.Patch+1)
+	}
+
+	return [][]Requirement{{
+		{Op: OpGte, Ver: p.floor()},
+		{Op: OpLt, Ver: upper},
+	}}
+}
+
+// expandTilde expands a tilde range, which allows patch-level changes if a minor version is
+// given and minor-level changes if not (e.g., "~1.2.3" is ">=1.2.3 <1.3.0-0" and "~1" is ">=1.0.0 <2.0.0-0").
+func expandTilde(p partialVersion) [][]Requirement {
+	if p.parts == 0 {
+		return matchAll()
+	}
+
+	upper := minimalPrerelease(p.Major, p.Minor+1, 0)
+	if p.parts == 1 {
+		upper = minimalPrerelease(p.Major+1, 0, 0)
+	}
+
+	return [][]Requirement{{
+		{Op: OpGte, Ver: p.floor()},
+		{Op: OpLt, Ver: upper},
+	}}
+}
+
+// expandHyphen expands an inclusive hyphen range such as "1.2 - 2.3.4". A partial upper bound
+// includes every version it covers (e.g., "1.2.3 - 2.3" is ">=1.2.3 <2.4.0-0").
+func expandHyphen(lower, upper partialVersion) [][]Requirement {
+	var reqs []Requirement
+	if lower.parts > 0 {
+		reqs = append(reqs, Requirement{Op: OpGte, Ver: lower.floor()})
+	}
+
+	switch upper.parts {
+	case 0:
+	case 3:
+		reqs = append(reqs, Requirement{Op: OpLte, Ver: upper.Version})
+	default:
+		reqs = append(reqs, Requirement{Op: OpLt, Ver: upper.ceiling()})
+	}
+
+	if len(reqs) == 0 {
+		return matchAll()
+	}
+	return [][]Requirement{reqs}
+}
+
+// andRequirements combines two sets of requirements in disjunctive normal form using logical AND.
+func andRequirements(a, b [][]Requirement) [][]Requirement {
+	if a == nil {
+		return b
+	}
+
+	combined := make([][]Requirement, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			reqs := make([]Requirement, 0, len(x)+len(y))
+			reqs = append(reqs, x...)
+			reqs = append(reqs, y...)
+			combined = append(combined, reqs)
+		}
+	}
+	return combined
+}
+
+// invalidRangeToken returns an error for a token that could not be parsed.
+func invalidRangeToken(token string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRangeToken, token)
+}
+
+// IsMinimalPrerelease reports whether the version's only pre-release identifier is the numeric
+// "0", as in "2.0.0-0". No version with the same core has lower precedence, which makes it the
+// sentinel used by expanded upper bounds: "<2.0.0-0" excludes 2.0.0 and all of its
+// pre-releases, matching the bounds npm generates for caret and tilde ranges.
+//
+// Example:
+//
+//	fmt.Println(semver.MustParse("2.0.0-0").IsMinimalPrerelease()) // Output: true
+func (v Version) IsMinimalPrerelease() bool {
+	return len(v.PreRelease) == 1 && v.PreRelease[0].isNumeric && v.PreRelease[0].partNumeric == 0
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"math"
+)
+
+// Bit widths used to pack a VersionDistance into a single ordered scalar.
+const (
+	distanceMajorBits    = 20
+	distanceMinorBits    = 16
+	distancePatchBits    = 16
+	distanceRevisionBits = 12
+)
+
+// VersionDistance represents the semantic delta between two versions.
+//
+// Majors, Minors, and Patches count how far the older version is behind the newer one.
+// Once a more significant component differs, the less significant components are counted
+// from zero on the newer release line. For example, the distance from 1.9.4 to 2.3.1 is
+// 1 major, 3 minors, and 1 patch. Revisions counts the fourth numeric segment in the same way,
+// below Patches.
+//
+// Epochs counts how many epochs the older version is behind. When the epochs differ, the
+// major, minor, and patch components are counted from zero in the newer epoch, so the
+// distance from 2!5.0.0 to 3!1.0.0 is 1 epoch and 1 major.
+//
+// Sign is the result of comparing the first version to the second: -1 if it is behind,
+// 0 if both have equal precedence, and +1 if it is ahead.
+type VersionDistance struct {
+	Epochs    uint64
+	Majors    uint64
+	Minors    uint64
+	Patches   uint64
+	Revisions uint64
+	Sign      int
+}
+
+// Distance returns the semantic distance between a and b.
+//
+// Pre-release identifiers and build metadata do not contribute to the counts, but they do
+// affect Sign through regular precedence rules.
+//
+// Example:
+//
+//	d := semver.Distance(semver.MustParse("1.2.0"), semver.MustParse("1.5.3"))
+//	fmt.Println(d.Majors, d.Minors, d.Patches, d.Sign) // Output: 0 3 3 -1
+func Distance(a, b Version) VersionDistance {
+	d := VersionDistance{Sign: a.Compare(b)}
+
+	older, newer := a, b
+	if d.Sign > 0 {
+		older, newer = b, a
+	}
+
+	switch {
+	case older.Epoch != newer.Epoch:
+		d.Epochs = newer.Epoch - older.Epoch
+		d.Majors = newer.Major
+		d.Minors = newer.Minor
+		d.Patches = newer.Patch
+		d.Revisions = newer.Revision
+	case older.Major != newer.Major:
+		d.Majors = newer.Major - older.Major
+		d.Minors = newer.Minor
+		d.Patches = newer.Patch
+		d.Revisions = newer.Revision
+	case older.Minor != newer.Minor:
+		d.Minors = newer.Minor - older.Minor
+		d.Patches = newer.Patch
+		d.Revisions = newer.Revision
+	case older.Patch != newer.Patch:
+		d.Patches = newer.Patch - older.Patch
+		d.Revisions = newer.Revision
+	case older.Revision != newer.Revision:
+		d.Revisions = newer.Revision - older.Revision
+	}
+
+	return d
+}
+
+// IsZero reports whether the distance has no epoch, major, minor, patch, or revision component.
+//
+// Two versions that differ only in pre-release identifiers have a zero distance.
+func (d VersionDistance) IsZero() bool {
+	return d.Epochs == 0 && d.Majors == 0 && d.Minors == 0 && d.Patches == 0 && d.Revisions == 0
+}
+
+// Scalar returns a single number that orders distances by significance: any major
+// difference outweighs any minor difference, which outweighs any patch difference, which
+// outweighs any revision difference.
+// Components that exceed their packed width are saturated, and any epoch difference
+// saturates the whole value.
+//
+// The value is suitable for sorting or prioritizing dependency updates.
+//
+// Example:
+//
+//	d1 := semver.Distance(semver.MustParse("1.0.0"), semver.MustParse("2.0.0"))
+//	d2 := semver.Distance(semver.MustParse("1.0.0"), semver.MustParse("1.9.0"))
+//	fmt.Println(d1.Scalar() > d2.Scalar()) // Output: true
+func (d VersionDistance) Scalar() uint64 {
+	if d.Epochs > 0 {
+		return math.MaxUint64
+	}
+
+	majors := saturate(d.Majors, distanceMajorBits)
+	minors := saturate(d.Minors, distanceMinorBits)
+	patches := saturate(d.Patches, distancePatchBits)
+	revisions := saturate(d.Revisions, distanceRevisionBits)
+
+	return majors<<(distanceMinorBits+distancePatchBits+distanceRevisionBits) |
+		minors<<(distancePatchBits+distanceRevisionBits) |
+		patches<<distanceRevisionBits |
+		revisions
+}
+
+// saturate clamps n to the largest value that fits in the given number of bits.
+func saturate(n uint64, bits uint) uint64 {
+	limit := uint64(1)<<bits - 1
+	if n > limit {
+		return limit
+	}
+	return n
+}
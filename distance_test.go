@@ -0,0 +1,121 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistance(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		a        string
+		b        string
+		expected VersionDistance
+	}{
+		{"1.2.0", "1.5.3", VersionDistance{Majors: 0, Minors: 3, Patches: 3, Sign: -1}},
+		{"1.9.4", "2.3.1", VersionDistance{Majors: 1, Minors: 3, Patches: 1, Sign: -1}},
+		{"2.3.1", "1.9.4", VersionDistance{Majors: 1, Minors: 3, Patches: 1, Sign: 1}},
+		{"1.2.3", "1.2.7", VersionDistance{Patches: 4, Sign: -1}},
+		{"1.2.3", "1.2.3+build.1", VersionDistance{Sign: 0}},
+		{"1.2.3-alpha", "1.2.3", VersionDistance{Sign: -1}},
+	}
+
+	for _, test := range tests {
+		d := Distance(MustParse(test.a), MustParse(test.b))
+		is.Equal(test.expected, d, "Distance between %s and %s", test.a, test.b)
+	}
+
+	is.True(Distance(MustParse("1.2.3-alpha"), MustParse("1.2.3")).IsZero())
+	is.False(Distance(MustParse("1.2.3"), MustParse("1.2.4")).IsZero())
+}
+
+func TestDistanceEpoch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithEpoch(true))
+	is.NoError(err)
+
+	tests := []struct {
+		a        string
+		b        string
+		expected VersionDistance
+	}{
+		{"2!5.0.0", "3!1.0.0", VersionDistance{Epochs: 1, Majors: 1, Sign: -1}},
+		{"3!1.2.0", "1!9.0.0", VersionDistance{Epochs: 2, Majors: 1, Minors: 2, Sign: 1}},
+		{"1.9.0", "1!0.1.0", VersionDistance{Epochs: 1, Minors: 1, Sign: -1}},
+		{"2!1.2.0", "2!1.5.3", VersionDistance{Minors: 3, Patches: 3, Sign: -1}},
+	}
+
+	for _, test := range tests {
+		a, err := p.Parse(test.a)
+		is.NoError(err, "Input %s", test.a)
+		b, err := p.Parse(test.b)
+		is.NoError(err, "Input %s", test.b)
+
+		d := Distance(a, b)
+		is.Equal(test.expected, d, "Distance between %s and %s", test.a, test.b)
+		is.False(d.IsZero(), "Distance between %s and %s", test.a, test.b)
+	}
+
+	epoch := VersionDistance{Epochs: 1}
+	is.Greater(epoch.Scalar(), VersionDistance{Majors: 1000}.Scalar())
+}
+
+func TestDistanceScalar(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	major := Distance(MustParse("1.0.0"), MustParse("2.0.0"))
+	minor := Distance(MustParse("1.0.0"), MustParse("1.999.0"))
+	patch := Distance(MustParse("1.0.0"), MustParse("1.0.999999"))
+
+	is.Greater(major.Scalar(), minor.Scalar())
+	is.Greater(minor.Scalar(), patch.Scalar())
+	is.Equal(uint64(0), VersionDistance{}.Scalar())
+
+	saturated := VersionDistance{Patches: 1 << 40}
+	is.Equal((uint64(1)<<distancePatchBits-1)<<distanceRevisionBits, saturated.Scalar())
+}
+
+func TestDistanceRevision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithRevision(true))
+	is.NoError(err)
+
+	tests := []struct {
+		a        string
+		b        string
+		expected VersionDistance
+	}{
+		{"1.2.3.4", "1.2.3.9", VersionDistance{Revisions: 5, Sign: -1}},
+		{"1.2.3.9", "1.2.4.2", VersionDistance{Patches: 1, Revisions: 2, Sign: -1}},
+		{"1.2.3", "1.2.3.1", VersionDistance{Revisions: 1, Sign: -1}},
+		{"2.0.0.7", "1.9.0", VersionDistance{Majors: 1, Revisions: 7, Sign: 1}},
+	}
+
+	for _, test := range tests {
+		a, err := p.Parse(test.a)
+		is.NoError(err, "Input %s", test.a)
+		b, err := p.Parse(test.b)
+		is.NoError(err, "Input %s", test.b)
+
+		d := Distance(a, b)
+		is.Equal(test.expected, d, "Distance between %s and %s", test.a, test.b)
+		is.False(d.IsZero(), "Distance between %s and %s", test.a, test.b)
+	}
+
+	revision := VersionDistance{Revisions: 1}
+	is.Greater(revision.Scalar(), VersionDistance{}.Scalar())
+	is.Greater(VersionDistance{Patches: 1}.Scalar(), VersionDistance{Revisions: 1 << 40}.Scalar())
+}
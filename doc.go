@@ -0,0 +1,49 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package semver parses, compares, and matches versions that follow the Semantic Versioning
+// 2.0.0 specification (https://semver.org).
+//
+// # Versions
+//
+// Parse and MustParse turn a string such as "1.2.3-rc.1+build.5" into a Version. Versions
+// compare by precedence with Compare, Less, and Equal; build metadata never affects
+// precedence. Sort orders a slice of versions, and Bump, NextPrerelease, and NextRC compute the
+// next version of a release.
+//
+//	v := semver.MustParse("1.2.3-rc.1")
+//	fmt.Println(v.Compare(semver.MustParse("1.2.3"))) // -1
+//
+// NewParser creates parsers with non-default behavior: WithRevision accepts a fourth numeric
+// segment, WithEpoch a PEP 440 style "N!" prefix, WithSuggestions adds a "did you mean"
+// correction to errors, WithTrace logs every parsing step, and WithObserver reports parse and
+// evaluation telemetry. ParseLenient corrects common deviations, such as a "v" prefix or
+// missing components, and reports every correction it made.
+//
+// # Ranges
+//
+// ParseRange parses range expressions such as ">=1.2.0 <2.0.0 || ^3.1.0" into a VersionRange,
+// whose Contains method reports whether a version satisfies it. ParseExpression accepts a
+// boolean syntax with "&&", "||", and parentheses, and ParseAST exposes the parsed expression as
+// a tree. Pre-release versions are matched according to the range's PrereleasePolicy.
+//
+// Dialects parse the range syntax of other ecosystems: NpmDialect, HelmDialect,
+// ComposerDialect, CargoDialect, TerraformDialect, GradleDialect, and NuGetDialect. Translate
+// converts a range from one dialect to another, and Canonical gives a stable serialization for
+// storing ranges.
+//
+// # Errors
+//
+// Parse errors wrap sentinel errors such as ErrMissingVersionElements and
+// ErrLeadingZeroInNumericIdentifier, which can be tested with errors.Is; ErrorCode maps them to
+// short identifiers suitable as metric labels. Batch operations such as ParseAll,
+// CanonicalizeManifest, and VersionManifest.Validate return an Errors value holding every
+// failure with its position.
+//
+// # Concurrency
+//
+// Versions and ranges are safe for concurrent reads. Parsers, including DefaultParser, are safe
+// for concurrent use; an Arena is not.
+package semver
@@ -0,0 +1,191 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"slices"
+)
+
+// enumerateOptions holds the settings applied by EnumerateOption functions.
+type enumerateOptions struct {
+	candidates []Version
+	limit      int
+}
+
+// EnumerateOption configures Enumerate.
+type EnumerateOption func(*enumerateOptions)
+
+// WithCandidates makes Enumerate choose its samples from the given versions, such as the
+// versions actually published for a dependency, instead of synthesizing them.
+func WithCandidates(versions []Version) EnumerateOption {
+	return func(o *enumerateOptions) {
+		o.candidates = versions
+	}
+}
+
+// WithSampleLimit caps the number of versions Enumerate returns. The lowest and highest samples
+// are always kept, and the rest are chosen at evenly spaced positions. A limit below 1 means
+// no limit.
+func WithSampleLimit(n int) EnumerateOption {
+	return func(o *enumerateOptions) {
+		o.limit = n
+	}
+}
+
+// Enumerate returns representative versions inside the range, in increasing order, for
+// generating test matrices such as "run CI against the minimum and maximum supported
+// dependency versions".
+//
+// Without candidates, the samples are synthesized from each interval of the range: its bounds,
+// the releases one patch to either side of them, and a midpoint between them. With
+// WithCandidates, the samples are the lowest and highest candidates in each interval of the
+// range, and the median of all candidates in the range. Either way, every sample satisfies the
+// range, including its OpNeq requirements and PrereleasePolicy, and the result is deterministic.
+//
+// Example:
+//
+//	r := semver.MustParseRange(">=1.2.0 <2.0.0")
+//	fmt.Println(semver.Enumerate(r)) // Output: [1.2.0 1.2.1 1.3.0]
+func Enumerate(r *VersionRange, opts ...EnumerateOption) []Version {
+	var o enumerateOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var samples []Version
+	if o.candidates != nil {
+		samples = sampleCandidates(r, o.candidates)
+	} else {
+		for _, iv := range r.Intervals() {
+			samples = append(samples, iv.samples()...)
+		}
+		samples = slices.DeleteFunc(samples, func(v Version) bool { return !r.Contains(v) })
+	}
+
+	slices.SortFunc(samples, Compare)
+	samples = slices.CompactFunc(samples, Version.StrictEqual)
+	return limitSamples(samples, o.limit)
+}
+
+// sampleCandidates returns the lowest and highest candidates in each interval of the range,
+// and the median of all candidates in the range.
+func sampleCandidates(r *VersionRange, candidates []Version) []Version {
+	sorted := slices.Clone(candidates)
+	slices.SortStableFunc(sorted, Compare)
+
+	var matched, samples []Version
+	for _, iv := range r.Intervals() {
+		lo, hi := iv.span(sorted)
+		var inInterval []Version
+		for _, v := range sorted[lo:hi] {
+			if r.Contains(v) {
+				inInterval = append(inInterval, v)
+			}
+		}
+		if len(inInterval) > 0 {
+			samples = append(samples, inInterval[0], inInterval[len(inInterval)-1])
+			matched = append(matched, inInterval...)
+		}
+	}
+	if len(matched) > 0 {
+		samples = append(samples, matched[len(matched)/2])
+	}
+	return samples
+}
+
+// samples returns synthesized candidate versions around the bounds of the interval, which may
+// lie outside it or, for ranges using OpNeq, outside the range.
+func (iv Interval) samples() []Version {
+	var candidates []Version
+
+	lowest := Zero
+	if !iv.Lower.Unbounded {
+		lowest = iv.Lower.Version.release()
+		if !iv.Lower.Inclusive || len(iv.Lower.Version.PreRelease) > 0 {
+			candidates = append(candidates, iv.Lower.Version)
+		}
+		if !iv.Lower.Inclusive && len(iv.Lower.Version.PreRelease) == 0 {
+			lowest.Patch++
+		}
+	}
+	candidates = append(candidates, lowest, nextPatch(lowest))
+
+	if iv.Upper.Unbounded {
+		next := Version{Epoch: lowest.Epoch, Major: lowest.Major + 1}
+		return append(candidates, next, nextPatch(next))
+	}
+
+	highest := iv.Upper.Version.release()
+	candidates = append(candidates, highest, iv.Upper.Version)
+	if highest.Patch > 0 {
+		below := highest
+		below.Patch--
+		candidates = append(candidates, below)
+	}
+	if mid, ok := midpoint(lowest, highest); ok {
+		candidates = append(candidates, mid)
+	}
+	return candidates
+}
+
+// release returns the version without pre-release identifiers and build metadata.
+func (v Version) release() Version {
+	return Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor, Patch: v.Patch, Revision: v.Revision}
+}
+
+// nextPatch returns the release one patch above v.
+func nextPatch(v Version) Version {
+	next := v.release()
+	next.Patch++
+	return next
+}
+
+// midpoint returns a release between lo and hi: halfway along the most significant component
+// in which they differ, or one step above lo in the next component if there is no room.
+// The boolean is false if the releases share their epoch, major, and minor versions but not
+// enough patch versions to fit a release between them.
+func midpoint(lo, hi Version) (Version, bool) {
+	if lo.Epoch != hi.Epoch {
+		return Version{}, false
+	}
+	mid := Version{Epoch: lo.Epoch}
+	switch {
+	case lo.Major != hi.Major:
+		mid.Major = lo.Major + (hi.Major-lo.Major)/2
+		if mid.Major == lo.Major {
+			mid.Minor = lo.Minor + 1
+		}
+	case lo.Minor != hi.Minor:
+		mid.Major = lo.Major
+		mid.Minor = lo.Minor + (hi.Minor-lo.Minor)/2
+		if mid.Minor == lo.Minor {
+			mid.Patch = lo.Patch + 1
+		}
+	case hi.Patch > lo.Patch+1:
+		mid.Major, mid.Minor = lo.Major, lo.Minor
+		mid.Patch = lo.Patch + (hi.Patch-lo.Patch)/2
+	default:
+		return Version{}, false
+	}
+	return mid, true
+}
+
+// limitSamples keeps the first and last samples and fills the rest of the limit with samples
+// at evenly spaced positions.
+func limitSamples(samples []Version, limit int) []Version {
+	if limit < 1 || len(samples) <= limit {
+		return samples
+	}
+	if limit == 1 {
+		return samples[:1]
+	}
+
+	limited := make([]Version, limit)
+	for i := range limited {
+		limited[i] = samples[i*(len(samples)-1)/(limit-1)]
+	}
+	return limited
+}
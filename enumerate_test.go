@@ -0,0 +1,87 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumerate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		r        string
+		expected string
+	}{
+		{">=1.2.0 <2.0.0", "[1.2.0 1.2.1 1.3.0]"},
+		{"^1.2.3", "[1.2.3 1.2.4 1.3.0]"},
+		{">=1.0.0 <5.0.0", "[1.0.0 1.0.1 3.0.0]"},
+		{"~0.2.3 || >=3.0.0", "[0.2.3 0.2.4 3.0.0 3.0.1 4.0.0 4.0.1]"},
+		{">1.0.0 <=1.0.5", "[1.0.1 1.0.2 1.0.3 1.0.4 1.0.5]"},
+		{"1.4.6", "[1.4.6]"},
+		{">=1.0.0-rc.1 <1.0.0", "[1.0.0-rc.1]"},
+		{"<1.0.0", "[0.0.0 0.0.1 0.1.0]"},
+		{"!=1.2.1 >=1.2.0 <1.2.3", "[1.2.0 1.2.2]"},
+		{">2.0.0 <1.0.0", "[]"},
+	}
+
+	for _, test := range tests {
+		r := MustParseRange(test.r)
+		samples := Enumerate(r)
+		is.Equal(test.expected, fmt.Sprint(samples), "Range %s", test.r)
+		for _, v := range samples {
+			is.True(r.Contains(v), "Range %s should contain sample %s", test.r, v)
+		}
+		is.Equal(samples, Enumerate(r), "Range %s should be sampled deterministically", test.r)
+	}
+
+	excluded := MustParseRange(">=1.0.0-rc.1 <1.0.1")
+	excluded.Prerelease = PrereleaseExcluded
+	is.Equal("[1.0.0]", fmt.Sprint(Enumerate(excluded)))
+}
+
+func TestEnumerateWithCandidates(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var published []Version
+	for _, s := range []string{"0.9.0", "1.0.0", "1.1.0", "1.2.0-rc.1", "1.2.0", "1.3.5", "1.9.2", "2.0.0", "3.0.0", "3.1.0"} {
+		published = append(published, MustParse(s))
+	}
+
+	tests := []struct {
+		r        string
+		expected string
+	}{
+		{"^1.0.0", "[1.0.0 1.2.0 1.9.2]"},
+		{"^1.0.0 || ^3.0.0", "[1.0.0 1.3.5 1.9.2 3.0.0 3.1.0]"},
+		{">=1.2.0-0 <1.3.0", "[1.2.0-rc.1 1.2.0]"},
+		{"^4.0.0", "[]"},
+	}
+
+	for _, test := range tests {
+		samples := Enumerate(MustParseRange(test.r), WithCandidates(published))
+		is.Equal(test.expected, fmt.Sprint(samples), "Range %s", test.r)
+	}
+
+	is.Equal("0.9.0", published[0].String(), "Candidates must not be reordered")
+}
+
+func TestEnumerateWithSampleLimit(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := MustParseRange(">1.0.0 <=1.0.5")
+	is.Equal("[1.0.1 1.0.3 1.0.5]", fmt.Sprint(Enumerate(r, WithSampleLimit(3))))
+	is.Equal("[1.0.1 1.0.5]", fmt.Sprint(Enumerate(r, WithSampleLimit(2))))
+	is.Equal("[1.0.1]", fmt.Sprint(Enumerate(r, WithSampleLimit(1))))
+	is.Len(Enumerate(r, WithSampleLimit(0)), 5)
+	is.Len(Enumerate(r, WithSampleLimit(10)), 5)
+}
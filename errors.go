@@ -45,4 +45,31 @@ var (
 
 	// ErrUnsupportedType indicates that an unsupported type was provided for Version.
 	ErrUnsupportedType = errors.New("unsupported type for Version")
+
+	// ErrNoBuildInfo indicates that build information is not available in the running binary.
+	ErrNoBuildInfo = errors.New("build information is not available")
+
+	// ErrDevelVersion indicates that the main module was built without a version, as reported by "(devel)".
+	ErrDevelVersion = errors.New("main module has no version (devel build)")
+
+	// ErrIllegalTransition indicates that a release transition would move backwards, such as from "rc.2" to "alpha.3".
+	ErrIllegalTransition = errors.New("illegal release transition")
+
+	// ErrVersionDowngrade indicates that a version change was rejected because it does not move forward.
+	ErrVersionDowngrade = errors.New("version change rejected by upgrade policy")
+
+	// ErrUnknownOperator indicates that a requirement uses an operator that is not one of the defined Operator constants.
+	ErrUnknownOperator = errors.New("unknown operator")
+
+	// ErrInvalidRangeToken indicates that a token in a range expression could not be parsed.
+	ErrInvalidRangeToken = errors.New("invalid range token")
+
+	// ErrInvalidQuery indicates that a version query could not be parsed.
+	ErrInvalidQuery = errors.New("invalid version query")
+
+	// ErrSegmentOutOfRange indicates that a segment ordinal does not address a numeric segment of a version.
+	ErrSegmentOutOfRange = errors.New("segment ordinal out of range")
+
+	// ErrInvalidVers indicates that a "vers:" version range specifier is malformed or cannot be represented as a VersionRange.
+	ErrInvalidVers = errors.New("invalid vers range")
 )
@@ -0,0 +1,324 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/sixafter/semver"
+)
+
+func ExampleParse() {
+	v, err := semver.Parse("1.2.3-alpha.1+build.123")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(v.Major, v.Minor, v.Patch)
+	fmt.Println(v.PreRelease, v.BuildMetadata)
+	fmt.Println(v)
+
+	_, err = semver.Parse("1.2")
+	fmt.Println(errors.Is(err, semver.ErrMissingVersionElements))
+	// Output:
+	// 1 2 3
+	// [alpha 1] [build 123]
+	// 1.2.3-alpha.1+build.123
+	// true
+}
+
+func ExampleMustParse() {
+	v := semver.MustParse("2.0.0-rc.1")
+	fmt.Println(v)
+	// Output:
+	// 2.0.0-rc.1
+}
+
+func ExampleNewParser() {
+	parser, err := semver.NewParser(semver.WithRevision(true), semver.WithEpoch(true))
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+
+	v, err := parser.Parse("1!2.3.4.5")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(v.Epoch, v.Major, v.Minor, v.Patch, v.Revision)
+	fmt.Println(v)
+	// Output:
+	// 1 2 3 4 5
+	// 1!2.3.4.5
+}
+
+func ExampleWithSuggestions() {
+	parser, _ := semver.NewParser(semver.WithSuggestions(true))
+
+	_, err := parser.Parse("v1.2")
+	var se *semver.SuggestionError
+	if errors.As(err, &se) {
+		fmt.Println(se.Suggestion)
+	}
+	fmt.Println(err)
+	// Output:
+	// 1.2.0
+	// invalid version "v1.2": invalid numeric identifier; did you mean "1.2.0"?
+}
+
+func ExampleParseLenient() {
+	v, corrections, err := semver.ParseLenient(" v1.02-rc.01 ")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(v)
+	for _, c := range corrections {
+		fmt.Println(c)
+	}
+	// Output:
+	// 1.2.0-rc.1
+	// trimmed space at offset 0: " " -> ""
+	// stripped prefix at offset 1: "v" -> ""
+	// removed leading zeros at offset 4: "02" -> "2"
+	// added component at offset 6: "" -> ".0"
+	// removed leading zeros at offset 10: "01" -> "1"
+	// trimmed space at offset 12: " " -> ""
+}
+
+func ExampleParseAll() {
+	versions, err := semver.ParseAll([]string{"1.0.0", "1.2", "2.0.0", "01.0.0"})
+	fmt.Println(versions[0], versions[2])
+
+	var errs semver.Errors
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			fmt.Println(e.Index, semver.ErrorCode(e))
+		}
+	}
+	// Output:
+	// 1.0.0 2.0.0
+	// 1 missing-elements
+	// 3 leading-zero
+}
+
+func ExampleErrorCode() {
+	_, err := semver.Parse("1.2.3-rc..1")
+	fmt.Println(semver.ErrorCode(err))
+	fmt.Println(semver.ErrorCode(nil) == "")
+	// Output:
+	// empty-prerelease
+	// true
+}
+
+func ExampleVersion_Compare() {
+	a := semver.MustParse("1.0.0-alpha")
+	b := semver.MustParse("1.0.0-alpha.1")
+	c := semver.MustParse("1.0.0")
+
+	fmt.Println(a.Compare(b))
+	fmt.Println(c.Compare(b))
+	fmt.Println(c.Compare(semver.MustParse("1.0.0+build")))
+	// Output:
+	// -1
+	// 1
+	// 0
+}
+
+func ExampleCompare() {
+	versions := []semver.Version{
+		semver.MustParse("1.10.0"),
+		semver.MustParse("1.2.0"),
+		semver.MustParse("1.2.0-rc.1"),
+	}
+	slices.SortFunc(versions, semver.Compare)
+	fmt.Println(versions)
+	// Output:
+	// [1.2.0-rc.1 1.2.0 1.10.0]
+}
+
+func ExampleSort() {
+	a, b, c := semver.MustParse("2.0.0"), semver.MustParse("1.0.0-beta"), semver.MustParse("1.0.0")
+	versions := []*semver.Version{&a, &b, &c}
+	semver.Sort(versions)
+	for _, v := range versions {
+		fmt.Println(v)
+	}
+	// Output:
+	// 1.0.0-beta
+	// 1.0.0
+	// 2.0.0
+}
+
+func ExampleVersion_Bump() {
+	v := semver.MustParse("1.2.3-rc.1")
+	fmt.Println(v.Bump(semver.BumpPatch))
+	fmt.Println(v.Bump(semver.BumpMinor))
+	fmt.Println(v.Bump(semver.BumpMajor))
+	// Output:
+	// 1.2.3
+	// 1.3.0
+	// 2.0.0
+}
+
+func ExampleVersion_NextPrerelease() {
+	v, err := semver.MustParse("1.2.3-beta.1").NextPrerelease("beta")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(v)
+	// Output:
+	// 1.2.3-beta.2
+}
+
+func ExampleDistance() {
+	d := semver.Distance(semver.MustParse("1.2.3"), semver.MustParse("1.4.0"))
+	fmt.Println(d.Majors, d.Minors, d.Patches, d.Sign)
+	// Output:
+	// 0 2 0 -1
+}
+
+func ExampleParseRange() {
+	r, err := semver.ParseRange(">=1.2.0 <2.0.0 || ^3.1.0")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(r)
+	// Output:
+	// >=1.2.0 <2.0.0 || ^3.1.0
+}
+
+func ExampleVersionRange_Contains() {
+	r := semver.MustParseRange("^1.2.0")
+	for _, s := range []string{"1.2.0", "1.9.9", "2.0.0", "1.1.0"} {
+		fmt.Println(s, r.Contains(semver.MustParse(s)))
+	}
+	// Output:
+	// 1.2.0 true
+	// 1.9.9 true
+	// 2.0.0 false
+	// 1.1.0 false
+}
+
+func ExampleVersionRange_Canonical() {
+	s, err := semver.MustParseRange(">=1.0.0 <2.0.0").Canonical()
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(s)
+
+	r, err := semver.ParseCanonicalRange(s)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(r.Contains(semver.MustParse("1.5.0")))
+	// Output:
+	// v1;inclusive;>=1.0.0 <2.0.0
+	// true
+}
+
+func ExampleParseExpression() {
+	r, err := semver.ParseExpression("(>=1.0.0 && <1.5.0) || ==2.0.0")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(r.Contains(semver.MustParse("1.4.0")))
+	fmt.Println(r.Contains(semver.MustParse("1.6.0")))
+	fmt.Println(r.Contains(semver.MustParse("2.0.0")))
+	// Output:
+	// true
+	// false
+	// true
+}
+
+func ExampleParseAST() {
+	n, err := semver.ParseAST("^1.2.3 || >=2.0.0 <3.0.0")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	semver.Walk(n, func(n semver.Node) bool {
+		if c, ok := n.(*semver.ComparatorNode); ok {
+			fmt.Println(c)
+		}
+		return true
+	})
+	// Output:
+	// ^1.2.3
+	// >=2.0.0
+	// <3.0.0
+}
+
+func ExampleTranslate() {
+	r, err := semver.ParseRange(">=1.0.0 <2.0.0")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	// The range matches pre-releases such as 1.5.0-rc.1, which Helm's PrereleaseOptIn
+	// skips, so the translation is reported as lossy.
+	s, err := semver.Translate(r, semver.HelmDialect)
+	fmt.Println(s)
+	fmt.Println(errors.Is(err, semver.ErrLossyTranslation))
+	// Output:
+	// >=1.0.0 <2.0.0
+	// true
+}
+
+func ExampleHelmCheck() {
+	ok, err := semver.HelmCheck(">=1.2.3-0", "v1.3.0-beta.1")
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Println(ok)
+	// Output:
+	// true
+}
+
+func ExampleVerifyComparator() {
+	samples := []semver.Version{semver.MustParse("1.0.0-rc.1"), semver.MustParse("1.0.0"), semver.MustParse("2.0.0")}
+	fmt.Println(semver.VerifyComparator(semver.Compare, samples))
+
+	newestFirstBroken := func(a, b semver.Version) int {
+		if b.Less(a) {
+			return -1
+		}
+		return 1
+	}
+	err := semver.VerifyComparator(newestFirstBroken, samples)
+	fmt.Println(errors.Is(err, semver.ErrComparatorViolation))
+	fmt.Println(err)
+	// Output:
+	// <nil>
+	// true
+	// comparator violates ordering axioms: reflexivity: compare(1.0.0-rc.1, 1.0.0-rc.1) = 1, want 0
+}
+
+func ExampleVersion_Check() {
+	v := semver.Version{Major: 1, BuildMetadata: []string{"sha", ""}}
+	fmt.Println(v.Check())
+	// Output:
+	// build metadata identifier 1: build metadata is empty
+}
+
+func ExampleOperators() {
+	fmt.Println(semver.Operators())
+
+	info, _ := semver.OpCaret.Info()
+	fmt.Println(info.Symbol, info.Name)
+	// Output:
+	// [= != > >= < <= ^ ~]
+	// ^ compatible with
+}
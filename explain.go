@@ -0,0 +1,147 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RejectionReason describes why a requirement did not accept a version.
+//
+// Supported Reasons:
+//   - RejectedUnsatisfied: The version does not satisfy the requirement's operator.
+//   - RejectedPrerelease: The range's PrereleaseExcluded policy rejects every pre-release.
+//   - RejectedPrereleaseOptIn: The range's PrereleaseOptIn policy rejects the pre-release
+//     because the requirement's version has no pre-release identifiers.
+//   - RejectedUnknownOperator: The requirement's operator is not defined.
+type RejectionReason int
+
+const (
+	RejectedUnsatisfied RejectionReason = iota
+	RejectedPrerelease
+	RejectedPrereleaseOptIn
+	RejectedUnknownOperator
+)
+
+// String returns the string representation of the RejectionReason.
+func (r RejectionReason) String() string {
+	switch r {
+	case RejectedUnsatisfied:
+		return "unsatisfied"
+	case RejectedPrerelease:
+		return "prerelease excluded"
+	case RejectedPrereleaseOptIn:
+		return "prerelease not opted in"
+	case RejectedUnknownOperator:
+		return "unknown operator"
+	default:
+		return "unknown"
+	}
+}
+
+// Rejection records a requirement that rejected a version.
+//
+// Branch is the 1-based index of the OR branch containing the requirement, or 0 if the
+// rejection applies to the whole range, as with RejectedPrerelease.
+type Rejection struct {
+	Branch      int
+	Requirement Requirement
+	Reason      RejectionReason
+}
+
+// String returns a human-readable description of the rejection.
+func (r Rejection) String() string {
+	switch {
+	case r.Branch == 0:
+		return r.Reason.String()
+	case r.Reason == RejectedUnsatisfied:
+		return fmt.Sprintf("constraint %s in branch %d", r.Requirement, r.Branch)
+	default:
+		return fmt.Sprintf("constraint %s in branch %d (%s)", r.Requirement, r.Branch, r.Reason)
+	}
+}
+
+// Explanation describes how a VersionRange evaluated a version.
+//
+// If Matched is true, Branch is the 1-based index of the first OR branch that accepted the
+// version. Otherwise, Branch is 0 and Rejections lists every requirement that rejected the
+// version, in branch order.
+type Explanation struct {
+	Version    Version
+	Matched    bool
+	Branch     int
+	Rejections []Rejection
+}
+
+// String returns a human-readable explanation, suitable for user-facing error messages.
+//
+// Example:
+//
+//	r := semver.MustParseRange(">=2.0.0 || >=1.0.0 <1.3.0")
+//	fmt.Println(r.Explain(semver.MustParse("1.4.0")))
+//	// Output: 1.4.0 rejected because constraint >=2.0.0 in branch 1; constraint <1.3.0 in branch 2
+func (e Explanation) String() string {
+	if e.Matched {
+		return fmt.Sprintf("%s accepted by branch %d", e.Version, e.Branch)
+	}
+	if len(e.Rejections) == 0 {
+		return fmt.Sprintf("%s rejected because the range is empty", e.Version)
+	}
+
+	reasons := make([]string, len(e.Rejections))
+	for i, r := range e.Rejections {
+		reasons[i] = r.String()
+	}
+	return fmt.Sprintf("%s rejected because %s", e.Version, strings.Join(reasons, "; "))
+}
+
+// Explain evaluates v against the range like Contains and reports which OR branch matched or,
+// if none did, which requirement in each branch rejected it.
+//
+// Resolvers can use the result to produce actionable conflict reports.
+//
+// Example:
+//
+//	r := semver.MustParseRange(">=1.0.0 <2.0.0 || >=3.0.0")
+//	e := r.Explain(semver.MustParse("3.1.0"))
+//	fmt.Println(e.Matched, e.Branch) // Output: true 2
+func (vr *VersionRange) Explain(v Version) Explanation {
+	e := Explanation{Version: v}
+
+	isPrerelease := len(v.PreRelease) > 0
+	if isPrerelease && vr.Prerelease == PrereleaseExcluded {
+		e.Rejections = []Rejection{{Reason: RejectedPrerelease}}
+		return e
+	}
+
+	for i, andReqs := range vr.Requirements {
+		branch := i + 1
+		var rejections []Rejection
+		for _, req := range andReqs {
+			switch {
+			case !req.Op.IsValid():
+				rejections = append(rejections, Rejection{Branch: branch, Requirement: req, Reason: RejectedUnknownOperator})
+			case isPrerelease && vr.Prerelease == PrereleaseOptIn && !req.isSentinelUpper() && len(req.Ver.PreRelease) == 0:
+				rejections = append(rejections, Rejection{Branch: branch, Requirement: req, Reason: RejectedPrereleaseOptIn})
+			case !req.Contains(v):
+				rejections = append(rejections, Rejection{Branch: branch, Requirement: req, Reason: RejectedUnsatisfied})
+			}
+		}
+
+		if len(rejections) == 0 && isPrerelease && vr.Prerelease == PrereleaseOptIn && !prereleaseOptedIn(andReqs) {
+			// Every requirement is a "-0" upper bound, none of which opts in on its own.
+			rejections = append(rejections, Rejection{Branch: branch, Requirement: andReqs[0], Reason: RejectedPrereleaseOptIn})
+		}
+
+		if len(rejections) == 0 {
+			return Explanation{Version: v, Matched: true, Branch: branch}
+		}
+		e.Rejections = append(e.Rejections, rejections...)
+	}
+
+	return e
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionRangeExplain(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := MustParseRange(">=1.0.0 <2.0.0 || >=3.0.0 !=3.1.0")
+
+	e := r.Explain(MustParse("3.2.0"))
+	is.True(e.Matched)
+	is.Equal(2, e.Branch)
+	is.Empty(e.Rejections)
+	is.Equal("3.2.0 accepted by branch 2", e.String())
+
+	e = r.Explain(MustParse("3.1.0"))
+	is.False(e.Matched)
+	is.Equal(0, e.Branch)
+	is.Equal([]Rejection{
+		{Branch: 1, Requirement: Requirement{Op: OpLt, Ver: MustParse("2.0.0")}, Reason: RejectedUnsatisfied},
+		{Branch: 2, Requirement: Requirement{Op: OpNeq, Ver: MustParse("3.1.0")}, Reason: RejectedUnsatisfied},
+	}, e.Rejections)
+	is.Equal("3.1.0 rejected because constraint <2.0.0 in branch 1; constraint !=3.1.0 in branch 2", e.String())
+
+	for _, s := range []string{"0.9.0", "1.5.0", "2.5.0", "3.1.0", "4.0.0"} {
+		v := MustParse(s)
+		is.Equal(r.Contains(v), r.Explain(v).Matched, "Explain and Contains must agree for %s", s)
+	}
+}
+
+func TestVersionRangeExplainPrerelease(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	excluded := &VersionRange{Requirements: MustParseRange(">=1.0.0").Requirements, Prerelease: PrereleaseExcluded}
+	e := excluded.Explain(MustParse("1.1.0-beta"))
+	is.False(e.Matched)
+	is.Equal("1.1.0-beta rejected because prerelease excluded", e.String())
+
+	optIn := &VersionRange{Requirements: MustParseRange(">=1.0.0").Requirements, Prerelease: PrereleaseOptIn}
+	e = optIn.Explain(MustParse("1.1.0-beta"))
+	is.Equal(RejectedPrereleaseOptIn, e.Rejections[0].Reason)
+	is.Equal("1.1.0-beta rejected because constraint >=1.0.0 in branch 1 (prerelease not opted in)", e.String())
+
+	unknown := &VersionRange{Requirements: [][]Requirement{{{Op: "=>", Ver: MustParse("1.0.0")}}}}
+	e = unknown.Explain(MustParse("1.0.0"))
+	is.Equal(RejectedUnknownOperator, e.Rejections[0].Reason)
+
+	empty := &VersionRange{}
+	is.Equal("1.0.0 rejected because the range is empty", empty.Explain(MustParse("1.0.0")).String())
+}
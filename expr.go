@@ -0,0 +1,187 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidExpression indicates that a boolean range expression is malformed, or expands to
+// more OR branches than ParseExpression allows.
+var ErrInvalidExpression = errors.New("invalid range expression")
+
+// maxExpressionBranches is the number of OR branches an expression may expand to. Distributing
+// AND over OR multiplies branches, so the limit bounds the work done on untrusted input.
+const maxExpressionBranches = 1024
+
+// maxExpressionDepth is the number of groups an expression may nest, bounding recursion.
+const maxExpressionDepth = 64
+
+// expressionOperators are the comparison operators accepted in expressions, longest first so
+// that splitOperator matches ">=" before ">".
+var expressionOperators = []string{">=", "<=", "==", "!=", ">", "<", "=", "!", "^", "~"}
+
+// ParseExpression parses a boolean combination of comparisons, with parentheses and explicit
+// "&&" and "||" operators, into a VersionRange, such as "(>=1.2 <2.0) || (>=3.0 && !=3.1.4)".
+//
+// AND binds tighter than OR, and may be written as "&&" or as whitespace, as in ParseRange.
+// Comparisons use the operators of ParseRange, optionally separated from their version by
+// whitespace, and versions may be partial, so ">=1.2" is ">=1.2.0" and "1.2" or "1.2.x" matches
+// every 1.2 release. Nested groups are distributed into the OR-of-ANDs form of VersionRange;
+// use ParseAST to keep the grouping as written.
+//
+// Returns an error wrapping ErrInvalidExpression, with the byte offset of the failure, if the
+// expression is malformed, nests groups more than 64 deep, or expands to more than 1024 OR
+// branches.
+//
+// Example:
+//
+//	r, err := semver.ParseExpression("(>=1.2 <2.0) || (>=3.0 && !=3.1.4)")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("3.1.4"))) // Output: false
+func ParseExpression(s string) (*VersionRange, error) {
+	n, err := ParseAST(s)
+	if err != nil {
+		return nil, err
+	}
+	return NewRangeFromAST(n)
+}
+
+// exprParser is a recursive-descent parser for ParseExpression.
+type exprParser struct {
+	input string
+	pos   int
+	depth int
+}
+
+// errorf returns an ErrInvalidExpression error at the current offset.
+func (p *exprParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrInvalidExpression, fmt.Sprintf(format, args...), p.pos)
+}
+
+// skipSpace advances past whitespace.
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t' || p.input[p.pos] == '\n' || p.input[p.pos] == '\r') {
+		p.pos++
+	}
+}
+
+// accept advances past tok, after any whitespace, and reports whether it was present.
+func (p *exprParser) accept(tok string) bool {
+	p.skipSpace()
+	if strings.HasPrefix(p.input[p.pos:], tok) {
+		p.pos += len(tok)
+		return true
+	}
+	return false
+}
+
+// or parses: and ( "||" and )*
+func (p *exprParser) or() (Node, error) {
+	n, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+
+	or := &OrNode{Children: []Node{n}}
+	for p.accept("||") {
+		next, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		or.Children = append(or.Children, next)
+	}
+	if len(or.Children) == 1 {
+		return n, nil
+	}
+	return or, nil
+}
+
+// and parses: primary ( ["&&"] primary )*
+func (p *exprParser) and() (Node, error) {
+	n, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+
+	and := &AndNode{Children: []Node{n}}
+	for {
+		explicit := p.accept("&&")
+		if !explicit && (p.pos == len(p.input) || strings.HasPrefix(p.input[p.pos:], "||") || p.input[p.pos] == ')') {
+			break
+		}
+
+		next, err := p.primary()
+		if err != nil {
+			return nil, err
+		}
+		and.Children = append(and.Children, next)
+	}
+	if len(and.Children) == 1 {
+		return n, nil
+	}
+	return and, nil
+}
+
+// primary parses: "(" or ")" | comparison
+func (p *exprParser) primary() (Node, error) {
+	if !p.accept("(") {
+		return p.comparison()
+	}
+
+	if p.depth++; p.depth > maxExpressionDepth {
+		return nil, p.errorf("groups nested too deeply")
+	}
+	n, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if !p.accept(")") {
+		return nil, p.errorf("missing ')'")
+	}
+	p.depth--
+	return n, nil
+}
+
+// comparison parses an operator, optional whitespace, and a possibly partial version.
+func (p *exprParser) comparison() (Node, error) {
+	p.skipSpace()
+	start := p.pos
+	op, _ := splitOperator(p.input[p.pos:], expressionOperators)
+	p.pos += len(op)
+	p.skipSpace()
+
+	end := p.pos
+	for end < len(p.input) && !strings.ContainsRune(" \t\r\n()&|", rune(p.input[end])) {
+		end++
+	}
+	if end == p.pos {
+		if p.pos == len(p.input) {
+			return nil, p.errorf("missing version")
+		}
+		return nil, p.errorf("unexpected %q", p.input[p.pos])
+	}
+
+	pv, err := parsePartialVersion(p.input[p.pos:end])
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("invalid comparison %q: %v", p.input[start:end], err)
+	}
+	p.pos = end
+
+	n := &ComparatorNode{Op: Operator(op), Version: pv.Version, Parts: pv.parts}
+	switch op {
+	case "", "==":
+		n.Op = OpEq
+	case "!":
+		n.Op = OpNeq
+	}
+	return n, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExpression(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		expr     string
+		accepted []string
+		rejected []string
+	}{
+		{
+			"(>=1.2 <2.0) || (>=3.0 && !=3.1.4)",
+			[]string{"1.2.0", "1.9.9", "3.0.0", "3.1.5"},
+			[]string{"1.1.9", "2.0.0", "2.5.0", "3.1.4"},
+		},
+		{
+			">=1.0.0 && (<1.5.0 || >=2.0.0) && !=2.1.0",
+			[]string{"1.0.0", "1.4.9", "2.0.0", "2.2.0"},
+			[]string{"0.9.0", "1.5.0", "1.9.9", "2.1.0"},
+		},
+		{
+			"((^1.2.3))",
+			[]string{"1.2.3", "1.9.0"},
+			[]string{"1.2.2", "2.0.0"},
+		},
+		{
+			">= 1.0.0 < 2.0.0",
+			[]string{"1.0.0", "1.9.0"},
+			[]string{"2.0.0"},
+		},
+		{
+			"1.2 || ~2.1",
+			[]string{"1.2.0", "1.2.9", "2.1.5"},
+			[]string{"1.3.0", "2.2.0"},
+		},
+		{
+			"!1.0.0 && (>0.9.0 <=1.0.1)",
+			[]string{"1.0.1", "0.9.5"},
+			[]string{"1.0.0", "0.9.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		r, err := ParseExpression(tt.expr)
+		if !is.NoError(err, tt.expr) {
+			continue
+		}
+		for _, s := range tt.accepted {
+			is.True(r.Contains(MustParse(s)), "%s should contain %s", tt.expr, s)
+		}
+		for _, s := range tt.rejected {
+			is.False(r.Contains(MustParse(s)), "%s should not contain %s", tt.expr, s)
+		}
+	}
+}
+
+func TestParseExpressionMatchesParseRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, s := range []string{">1.0.0 <2.0.0", "<2.0.0 || >=3.0.0", "^1.2.3", "~1.2.3", "=1.0.0-rc.1"} {
+		r, err := ParseExpression(s)
+		is.NoError(err, s)
+		is.Equal(MustParseRange(s).String(), r.String(), s)
+	}
+}
+
+func TestParseExpressionErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, s := range []string{
+		"",
+		"(>=1.0.0",
+		">=1.0.0)",
+		">=1.0.0 ||",
+		"&& >=1.0.0",
+		">=1.0.0 && && <2.0.0",
+		">=",
+		">=1.0.0.0",
+		"()",
+		">=a.b.c",
+	} {
+		_, err := ParseExpression(s)
+		is.ErrorIs(err, ErrInvalidExpression, s)
+	}
+
+	// Each group doubles the branches when distributed.
+	blowup := strings.Repeat("(1.0.0 || 2.0.0) ", 11)
+	_, err := ParseExpression(blowup)
+	is.ErrorIs(err, ErrInvalidExpression)
+	is.Contains(err.Error(), "branches")
+
+	_, err = ParseExpression(strings.Repeat("(1.0.0 || 2.0.0) ", 10))
+	is.NoError(err)
+
+	_, err = ParseExpression(strings.Repeat("(", 65) + "1.0.0" + strings.Repeat(")", 65))
+	is.ErrorIs(err, ErrInvalidExpression)
+	_, err = ParseExpression(strings.Repeat("(", 64) + "1.0.0" + strings.Repeat(")", 64))
+	is.NoError(err)
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownFeature indicates that a feature is not present in a FeatureGates.
+var ErrUnknownFeature = errors.New("unknown feature")
+
+// FeatureGates maps feature names to the version ranges in which each feature is enabled,
+// such as ">=2.3.0 <2.4.0 || >2.4.2" for a feature enabled from 2.3 onward except in
+// 2.4.0 through 2.4.2.
+//
+// A FeatureGates is safe for concurrent use, so gates can be reloaded while they are queried.
+//
+// Example:
+//
+//	g := semver.NewFeatureGates()
+//	_ = g.Set("streaming", ">=2.3.0 <2.4.0 || >2.4.2")
+//	_ = g.Set("batch-export", ">=3.0.0")
+//
+//	fmt.Println(g.Enabled("streaming", semver.MustParse("2.4.1"))) // Output: false
+//	fmt.Println(g.EnabledFeatures(semver.MustParse("3.1.0")))     // Output: [batch-export streaming]
+type FeatureGates struct {
+	mu    sync.RWMutex
+	gates map[string]featureGate
+}
+
+// featureGate is the range in which a feature is enabled, along with the expression it was
+// parsed from.
+type featureGate struct {
+	constraint string
+	rng        *VersionRange
+}
+
+// NewFeatureGates creates an empty FeatureGates.
+func NewFeatureGates() *FeatureGates {
+	return &FeatureGates{
+		gates: make(map[string]featureGate),
+	}
+}
+
+// Set parses constraint with ParseRange and enables the feature in that range, replacing any
+// previous range for the feature.
+func (g *FeatureGates) Set(feature, constraint string) error {
+	r, err := ParseRange(constraint)
+	if err != nil {
+		return fmt.Errorf("feature %s: %w", feature, err)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.gates[feature] = featureGate{constraint: constraint, rng: r}
+	return nil
+}
+
+// Remove deletes the feature from the gates.
+func (g *FeatureGates) Remove(feature string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	delete(g.gates, feature)
+}
+
+// Range returns the range in which the feature is enabled.
+func (g *FeatureGates) Range(feature string) (*VersionRange, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	gate, ok := g.gates[feature]
+	return gate.rng, ok
+}
+
+// Enabled reports whether the feature is enabled in version v. Unknown features are disabled;
+// use Check to tell them apart.
+func (g *FeatureGates) Enabled(feature string, v Version) bool {
+	enabled, _ := g.Check(feature, v)
+	return enabled
+}
+
+// Check reports whether the feature is enabled in version v, or returns ErrUnknownFeature if
+// the feature has no range, so that misspelled feature names can be caught.
+func (g *FeatureGates) Check(feature string, v Version) (bool, error) {
+	r, ok := g.Range(feature)
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrUnknownFeature, feature)
+	}
+	return r.Contains(v), nil
+}
+
+// Features returns the sorted names of every feature.
+func (g *FeatureGates) Features() []string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	names := make([]string, 0, len(g.gates))
+	for name := range g.gates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Evaluate reports, for every feature, whether it is enabled in version v.
+//
+// Example:
+//
+//	g := semver.NewFeatureGates()
+//	_ = g.Set("streaming", ">=2.3.0")
+//	_ = g.Set("legacy-auth", "<2.0.0")
+//	fmt.Println(g.Evaluate(semver.MustParse("2.5.0"))) // Output: map[legacy-auth:false streaming:true]
+func (g *FeatureGates) Evaluate(v Version) map[string]bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	result := make(map[string]bool, len(g.gates))
+	for name, gate := range g.gates {
+		result[name] = gate.rng.Contains(v)
+	}
+	return result
+}
+
+// EnabledFeatures returns the sorted names of the features enabled in version v.
+func (g *FeatureGates) EnabledFeatures(v Version) []string {
+	var names []string
+	for name, enabled := range g.Evaluate(v) {
+		if enabled {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// MarshalJSON encodes the gates as an object mapping each feature to its range expression.
+func (g *FeatureGates) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.constraints())
+}
+
+// UnmarshalJSON decodes an object mapping features to range expressions, replacing every gate.
+func (g *FeatureGates) UnmarshalJSON(data []byte) error {
+	var constraints map[string]string
+	if err := json.Unmarshal(data, &constraints); err != nil {
+		return err
+	}
+	return g.replace(constraints)
+}
+
+// LoadFeatureGates reads an object mapping features to range expressions from r using
+// unmarshal, which defaults to json.Unmarshal when nil. Pass yaml.Unmarshal from a YAML
+// package to load YAML.
+//
+// Example:
+//
+//	f, err := os.Open("features.yaml")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer f.Close()
+//
+//	// streaming: ">=2.3.0 <2.4.0 || >2.4.2"
+//	// batch-export: ">=3.0.0"
+//	g, err := semver.LoadFeatureGates(f, yaml.Unmarshal)
+func LoadFeatureGates(r io.Reader, unmarshal UnmarshalFunc) (*FeatureGates, error) {
+	if unmarshal == nil {
+		unmarshal = json.Unmarshal
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var constraints map[string]string
+	if err := unmarshal(data, &constraints); err != nil {
+		return nil, err
+	}
+
+	g := NewFeatureGates()
+	if err := g.replace(constraints); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Save writes the gates to w as an object mapping features to range expressions using
+// marshal, which defaults to indented JSON when nil. Pass yaml.Marshal from a YAML package to
+// save YAML.
+func (g *FeatureGates) Save(w io.Writer, marshal MarshalFunc) error {
+	if marshal == nil {
+		marshal = func(v interface{}) ([]byte, error) {
+			data, err := json.MarshalIndent(v, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			return append(data, '\n'), nil
+		}
+	}
+
+	data, err := marshal(g.constraints())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// constraints returns the range expression of every feature.
+func (g *FeatureGates) constraints() map[string]string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	constraints := make(map[string]string, len(g.gates))
+	for name, gate := range g.gates {
+		constraints[name] = gate.constraint
+	}
+	return constraints
+}
+
+// replace parses every range expression and, if all of them are valid, replaces the gates.
+// Otherwise the gates are left unchanged and every parse error is returned, joined together.
+func (g *FeatureGates) replace(constraints map[string]string) error {
+	names := make([]string, 0, len(constraints))
+	for name := range constraints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	gates := make(map[string]featureGate, len(constraints))
+	var errs []error
+	for _, name := range names {
+		constraint := constraints[name]
+		r, err := ParseRange(constraint)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("feature %s: %w", name, err))
+			continue
+		}
+		gates[name] = featureGate{constraint: constraint, rng: r}
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.gates = gates
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestFeatureGatesEnabled(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	g := NewFeatureGates()
+	is.NoError(g.Set("streaming", ">=2.3.0 <2.4.0 || >2.4.2"))
+	is.NoError(g.Set("batch-export", ">=3.0.0"))
+	is.Error(g.Set("broken", "not a range"))
+
+	is.False(g.Enabled("streaming", MustParse("2.2.9")))
+	is.True(g.Enabled("streaming", MustParse("2.3.0")))
+	is.False(g.Enabled("streaming", MustParse("2.4.1")))
+	is.True(g.Enabled("streaming", MustParse("2.4.3")))
+	is.False(g.Enabled("unknown", MustParse("2.4.3")))
+
+	_, err := g.Check("streem", MustParse("2.4.3"))
+	is.ErrorIs(err, ErrUnknownFeature)
+
+	is.Equal([]string{"batch-export", "streaming"}, g.Features())
+	is.Equal(map[string]bool{"batch-export": false, "streaming": true}, g.Evaluate(MustParse("2.5.0")))
+	is.Equal([]string{"batch-export", "streaming"}, g.EnabledFeatures(MustParse("3.1.0")))
+	is.Empty(g.EnabledFeatures(MustParse("1.0.0")))
+
+	g.Remove("batch-export")
+	_, ok := g.Range("batch-export")
+	is.False(ok)
+}
+
+func TestFeatureGatesLoadAndSave(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	g, err := LoadFeatureGates(strings.NewReader("streaming: \">=2.3.0 <2.4.0 || >2.4.2\"\nbatch-export: \">=3.0.0\"\n"), yaml.Unmarshal)
+	is.NoError(err)
+	is.True(g.Enabled("batch-export", MustParse("3.0.0")))
+
+	var buf bytes.Buffer
+	is.NoError(g.Save(&buf, nil))
+	is.JSONEq(`{"batch-export": ">=3.0.0", "streaming": ">=2.3.0 <2.4.0 || >2.4.2"}`, buf.String())
+
+	loaded, err := LoadFeatureGates(&buf, nil)
+	is.NoError(err)
+	is.Equal(g.Features(), loaded.Features())
+
+	_, err = LoadFeatureGates(strings.NewReader(`{"a": "bad", "b": ">=1.0.0"}`), nil)
+	is.ErrorContains(err, "feature a")
+}
+
+func TestFeatureGatesJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var config struct {
+		Features *FeatureGates `json:"features"`
+	}
+	is.NoError(json.Unmarshal([]byte(`{"features": {"streaming": ">=2.3.0"}}`), &config))
+	is.True(config.Features.Enabled("streaming", MustParse("2.3.0")))
+
+	data, err := json.Marshal(config)
+	is.NoError(err)
+	is.JSONEq(`{"features": {"streaming": ">=2.3.0"}}`, string(data))
+
+	g := NewFeatureGates()
+	is.NoError(g.Set("keep", ">=1.0.0"))
+	is.Error(json.Unmarshal([]byte(`{"keep": "bad"}`), g))
+	is.Equal([]string{"keep"}, g.Features(), "A failed decode should leave the gates unchanged")
+}
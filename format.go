@@ -0,0 +1,81 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"io"
+	"strconv"
+)
+
+// formatBufferSize is the stack buffer size used when formatting a version, which fits
+// typical versions without growing.
+const formatBufferSize = 64
+
+// AppendFormat appends the string representation of the Version to b and returns the
+// extended buffer. It produces the same text as String without allocating an intermediate
+// string, which suits log encoders and serializers that reuse their buffers.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3-alpha.1+build.123")
+//	buf := v.AppendFormat([]byte("version="))
+//	fmt.Println(string(buf)) // Output: version=1.2.3-alpha.1+build.123
+func (v Version) AppendFormat(b []byte) []byte {
+	if v.Epoch != 0 {
+		b = strconv.AppendUint(b, v.Epoch, 10)
+		b = append(b, '!')
+	}
+	b = strconv.AppendUint(b, v.Major, 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, v.Minor, 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, v.Patch, 10)
+	if v.Revision != 0 {
+		b = append(b, '.')
+		b = strconv.AppendUint(b, v.Revision, 10)
+	}
+
+	for i, pr := range v.PreRelease {
+		if i == 0 {
+			b = append(b, '-')
+		} else {
+			b = append(b, '.')
+		}
+		if pr.isNumeric {
+			b = strconv.AppendUint(b, pr.partNumeric, 10)
+		} else {
+			b = append(b, pr.partString...)
+		}
+	}
+
+	for i, bm := range v.BuildMetadata {
+		if i == 0 {
+			b = append(b, '+')
+		} else {
+			b = append(b, '.')
+		}
+		b = append(b, bm...)
+	}
+
+	return b
+}
+
+// WriteTo implements io.WriterTo. It writes the string representation of the Version to w
+// and returns the number of bytes written.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	v := semver.MustParse("1.2.3-beta")
+//	if _, err := v.WriteTo(&buf); err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(buf.String()) // Output: 1.2.3-beta
+func (v Version) WriteTo(w io.Writer) (int64, error) {
+	var buf [formatBufferSize]byte
+	n, err := w.Write(v.AppendFormat(buf[:0]))
+	return int64(n), err
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"bytes"
+	"encoding"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	_ io.WriterTo           = Version{}
+	_ encoding.TextAppender = Version{}
+)
+
+func TestVersionAppendFormat(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []string{
+		"0.0.0",
+		"1.2.3",
+		"1.2.3-alpha",
+		"1.2.3-alpha.1.0",
+		"1.2.3+build.123",
+		"1.2.3-rc.1+build.5.sha-abc",
+		"18446744073709551615.18446744073709551615.18446744073709551615-very.long.pre-release.label.that.exceeds.the.stack.buffer",
+	}
+
+	for _, test := range tests {
+		v := MustParse(test)
+		is.Equal(test, string(v.AppendFormat(nil)))
+		is.Equal("v="+test, string(v.AppendFormat([]byte("v="))))
+		is.Equal(test, v.String())
+
+		text, err := v.AppendText([]byte("x"))
+		is.NoError(err)
+		is.Equal("x"+test, string(text))
+
+		var buf bytes.Buffer
+		n, err := v.WriteTo(&buf)
+		is.NoError(err)
+		is.Equal(int64(len(test)), n)
+		is.Equal(test, buf.String())
+	}
+
+	is.Equal("0.0.0", Version{}.String())
+}
+
+func TestVersionWriteToError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := MustParse("1.2.3").WriteTo(failingWriter{})
+	is.ErrorIs(err, io.ErrShortWrite)
+}
+
+func TestVersionAppendFormatAllocations(t *testing.T) {
+	v := MustParse("1.2.3-alpha.1+build.123")
+	buf := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = v.AppendFormat(buf[:0])
+	})
+	assert.Zero(t, allocs)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, io.ErrShortWrite
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"slices"
+)
+
+// FrozenVersion is an immutable Version that is safe to share between goroutines by construction.
+//
+// A FrozenVersion owns private copies of its pre-release identifiers and build metadata, and its
+// accessors return copies, so no caller can modify it after it is created. The zero value is the
+// frozen form of the zero Version.
+//
+// Example:
+//
+//	f := semver.MustParse("1.2.3-beta+build.1").Freeze()
+//
+//	meta := f.BuildMetadata()
+//	meta[0] = "changed"
+//	fmt.Println(f) // Output: 1.2.3-beta+build.1
+type FrozenVersion struct {
+	v Version
+}
+
+// Freeze returns an immutable copy of the version.
+func (v Version) Freeze() FrozenVersion {
+	return FrozenVersion{v: v.Clone()}
+}
+
+// Version returns a mutable copy of the frozen version.
+func (f FrozenVersion) Version() Version {
+	return f.v.Clone()
+}
+
+// Major returns the major version number.
+func (f FrozenVersion) Major() uint64 {
+	return f.v.Major
+}
+
+// Minor returns the minor version number.
+func (f FrozenVersion) Minor() uint64 {
+	return f.v.Minor
+}
+
+// Patch returns the patch version number.
+func (f FrozenVersion) Patch() uint64 {
+	return f.v.Patch
+}
+
+// Epoch returns the optional epoch, which is zero if absent.
+func (f FrozenVersion) Epoch() uint64 {
+	return f.v.Epoch
+}
+
+// Revision returns the optional fourth numeric segment, which is zero if absent.
+func (f FrozenVersion) Revision() uint64 {
+	return f.v.Revision
+}
+
+// PreRelease returns a copy of the pre-release identifiers.
+func (f FrozenVersion) PreRelease() []PrereleaseVersion {
+	return slices.Clone(f.v.PreRelease)
+}
+
+// BuildMetadata returns a copy of the build metadata identifiers.
+func (f FrozenVersion) BuildMetadata() []string {
+	return slices.Clone(f.v.BuildMetadata)
+}
+
+// String returns the string representation of the frozen version.
+func (f FrozenVersion) String() string {
+	return f.v.String()
+}
+
+// Compare compares the frozen version with another, returning -1, 0, or 1 by precedence.
+func (f FrozenVersion) Compare(other FrozenVersion) int {
+	return f.v.Compare(other.v)
+}
+
+// Equal reports whether the frozen version has the same precedence as another.
+func (f FrozenVersion) Equal(other FrozenVersion) bool {
+	return f.v.Equal(other.v)
+}
+
+// Satisfies reports whether the frozen version satisfies the range.
+func (f FrozenVersion) Satisfies(vr *VersionRange) bool {
+	return vr.Contains(f.v)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFrozenVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := MustParse("1.2.3-beta.1+build.1")
+	f := v.Freeze()
+
+	v.BuildMetadata[0] = "mutated"
+	v.PreRelease[0] = PrereleaseVersion{partString: "alpha"}
+	is.Equal("1.2.3-beta.1+build.1", f.String())
+
+	meta := f.BuildMetadata()
+	meta[0] = "mutated"
+	pre := f.PreRelease()
+	pre[0] = PrereleaseVersion{partString: "alpha"}
+	is.Equal("1.2.3-beta.1+build.1", f.String())
+
+	copied := f.Version()
+	copied.BuildMetadata[0] = "mutated"
+	is.Equal("1.2.3-beta.1+build.1", f.String())
+
+	is.Equal(uint64(1), f.Major())
+	is.Equal(uint64(2), f.Minor())
+	is.Equal(uint64(3), f.Patch())
+	is.Zero(f.Revision())
+	is.Zero(f.Epoch())
+	is.Equal(-1, f.Compare(MustParse("1.2.3").Freeze()))
+	is.True(f.Equal(MustParse("1.2.3-beta.1").Freeze()))
+	is.True(f.Satisfies(MustParseRange(">=1.2.3-alpha")))
+
+	var zero FrozenVersion
+	is.Equal("0.0.0", zero.String())
+	is.Nil(zero.BuildMetadata())
+}
+
+func TestParsedVersionConcurrentAppend(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	shared := MustParse("1.0.0-alpha.1.2+a.b.c")
+	is.Equal(len(shared.PreRelease), cap(shared.PreRelease))
+	is.Equal(len(shared.BuildMetadata), cap(shared.BuildMetadata))
+
+	var wg sync.WaitGroup
+	results := make([]string, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			v := shared
+			v.BuildMetadata = append(v.BuildMetadata, string(rune('0'+i)))
+			results[i] = v.String()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, s := range results {
+		is.Equal("1.0.0-alpha.1.2+a.b.c."+string(rune('0'+i)), s)
+	}
+	is.Equal("1.0.0-alpha.1.2+a.b.c", shared.String())
+}
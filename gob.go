@@ -0,0 +1,81 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/gob"
+)
+
+// RegisterGob registers the package's types with encoding/gob, so that values of them can be
+// sent as interface values, such as in a job queue payload of type any. Registration is only
+// needed for interface values; fields of concrete types encode without it. It is safe to call
+// more than once.
+//
+// Since gob does not distinguish pointers from the values they point to, interface values
+// decode as Version, *VersionRange, Requirement, and Versions, however they were encoded.
+//
+// Example:
+//
+//	semver.RegisterGob()
+//
+//	var payload any = semver.MustParseRange("^1.2.3")
+//	var buf bytes.Buffer
+//	if err := gob.NewEncoder(&buf).Encode(&payload); err != nil {
+//	    log.Fatal(err)
+//	}
+func RegisterGob() {
+	gob.Register(Version{})
+	gob.Register(&VersionRange{})
+	gob.Register(Requirement{})
+	gob.Register(Versions{})
+}
+
+// GobEncode implements gob.GobEncoder. It encodes the version with MarshalBinary, as its string
+// representation including any epoch and revision.
+//
+// Example:
+//
+//	data, _ := semver.MustParse("1.2.3-rc.1").GobEncode()
+//	fmt.Printf("%s\n", data) // Output: 1.2.3-rc.1
+func (v Version) GobEncode() ([]byte, error) {
+	return v.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder. It decodes a version encoded by GobEncode with
+// UnmarshalBinary.
+//
+// Example:
+//
+//	var v semver.Version
+//	_ = v.GobDecode([]byte("2!1.0.0"))
+//	fmt.Println(v.Epoch, v) // Output: 2 2!1.0.0
+func (v *Version) GobDecode(data []byte) error {
+	return v.UnmarshalBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder. It encodes the range in the canonical range syntax, so
+// that the requirements, their order, and the PrereleasePolicy are preserved, and ranges
+// encoded by one release of this package decode in later ones. It has a value receiver so
+// that VersionRange values held in interfaces, which are not addressable, encode as well.
+//
+// Returns the error of Canonical if the range cannot be written in canonical form.
+func (vr VersionRange) GobEncode() ([]byte, error) {
+	s, err := vr.Canonical()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// GobDecode implements gob.GobDecoder. It decodes a range encoded by GobEncode.
+func (vr *VersionRange) GobDecode(data []byte) error {
+	parsed, err := ParseCanonicalRange(string(data))
+	if err != nil {
+		return err
+	}
+	*vr = *parsed
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// gobTask is a job queue payload holding constraints both as concrete fields and as interface
+// values.
+type gobTask struct {
+	Name       string
+	Constraint *VersionRange
+	Value      VersionRange
+	Pinned     Version
+	Candidates Versions
+	Payload    any
+	Extra      []any
+}
+
+func gobRoundTrip(t *testing.T, in, out any) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if err := gob.NewDecoder(&buf).Decode(out); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+}
+
+func TestGobTask(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+	RegisterGob()
+	RegisterGob()
+
+	constraint := MustParseRange(">=1.0.0 <2.0.0 || ^3.1.0")
+	constraint.Prerelease = PrereleaseOptIn
+	a, b := MustParse("1.2.3"), Version{Epoch: 1, Major: 2, Revision: 5}
+
+	in := gobTask{
+		Name:       "upgrade",
+		Constraint: constraint,
+		Value:      *MustParseRange("~1.2.3"),
+		Pinned:     MustParse("1.2.3-rc.1+build.5"),
+		Candidates: Versions{&a, &b},
+		Payload:    MustParseRange("!=1.5.0"),
+		Extra:      []any{MustParse("3.0.0"), &b, *MustParseRange("=1.0.0")},
+	}
+
+	var out gobTask
+	gobRoundTrip(t, in, &out)
+
+	is.Equal("upgrade", out.Name)
+	is.Equal(constraint.String(), out.Constraint.String())
+	is.Equal(PrereleaseOptIn, out.Constraint.Prerelease)
+	is.Equal("~1.2.3", out.Value.String())
+	is.True(in.Pinned.StrictEqual(out.Pinned))
+	is.Len(out.Candidates, 2)
+	is.True(b.StrictEqual(*out.Candidates[1]))
+
+	payload, ok := out.Payload.(*VersionRange)
+	is.True(ok)
+	is.Equal("!=1.5.0", payload.String())
+
+	is.Len(out.Extra, 3)
+	is.Equal(MustParse("3.0.0"), out.Extra[0])
+	is.True(b.StrictEqual(out.Extra[1].(Version)))
+	is.Equal("=1.0.0", out.Extra[2].(*VersionRange).String())
+}
+
+func TestGobVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, v := range []Version{
+		{},
+		MustParse("1.2.3-alpha.1+build.7"),
+		{Epoch: 3, Major: 1, Minor: 2, Patch: 3, Revision: 4},
+	} {
+		var out Version
+		gobRoundTrip(t, v, &out)
+		is.True(v.StrictEqual(out), v.String())
+
+		gobData, err := v.GobEncode()
+		is.NoError(err)
+		binData, err := v.MarshalBinary()
+		is.NoError(err)
+		is.Equal(binData, gobData, "GobEncode should match MarshalBinary for %s", v)
+
+		var fromBinary Version
+		is.NoError(fromBinary.GobDecode(binData))
+		is.True(v.StrictEqual(fromBinary), v.String())
+	}
+
+	var v Version
+	is.Error(v.GobDecode([]byte("1.2")))
+}
+
+func TestGobVersionRangeErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := (&VersionRange{Prerelease: 9}).GobEncode()
+	is.ErrorIs(err, ErrInvalidCanonicalRange)
+
+	var vr VersionRange
+	is.ErrorIs(vr.GobDecode([]byte(">=1.0.0")), ErrInvalidCanonicalRange)
+}
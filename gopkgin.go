@@ -0,0 +1,142 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// gopkgInHost is the host of the gopkg.in import path service.
+	gopkgInHost = "gopkg.in"
+
+	// gopkgInUnstable marks a gopkg.in major version that follows an unstable branch.
+	gopkgInUnstable = "-unstable"
+)
+
+// GopkgInPath is a parsed gopkg.in import path, such as "gopkg.in/yaml.v3" or
+// "gopkg.in/src-d/go-git.v4/plumbing".
+//
+// Prefix is the path up to its major version suffix, such as "gopkg.in/yaml", and Subpath is the
+// package path following the suffix, if any, such as "plumbing". Unstable is true for suffixes
+// such as ".v3-unstable", which follow an unstable branch.
+type GopkgInPath struct {
+	Prefix   string
+	Major    uint64
+	Unstable bool
+	Subpath  string
+}
+
+// ParseGopkgInPath parses a gopkg.in import path. A leading "https://" or "http://" is tolerated.
+//
+// Returns an error wrapping ErrInvalidModulePath if the path is not on gopkg.in or its package
+// element has no valid ".vN" suffix.
+//
+// Example:
+//
+//	p, err := semver.ParseGopkgInPath("gopkg.in/yaml.v3")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(p.Major, p.Range(), p.Repository()) // Output: 3 >=3.0.0 <4.0.0 github.com/go-yaml/yaml
+func ParseGopkgInPath(path string) (GopkgInPath, error) {
+	s := strings.TrimPrefix(strings.TrimPrefix(path, "https://"), "http://")
+	if !strings.HasPrefix(s, gopkgInHost+"/") {
+		return GopkgInPath{}, fmt.Errorf("%w: %s is not a gopkg.in path", ErrInvalidModulePath, path)
+	}
+
+	// The version suffix is on the first or second element after the host.
+	elems := strings.Split(s, "/")
+	for i := 1; i < len(elems) && i <= 2; i++ {
+		prefix, pathMajor, ok := splitGopkgIn(strings.Join(elems[:i+1], "/"))
+		if !ok {
+			continue
+		}
+
+		p := GopkgInPath{Prefix: prefix, Subpath: strings.Join(elems[i+1:], "/")}
+		digits, unstable := strings.CutSuffix(pathMajor[2:], gopkgInUnstable)
+		major, err := strconv.ParseUint(digits, 10, 64)
+		if err != nil {
+			break
+		}
+		p.Major, p.Unstable = major, unstable
+		return p, nil
+	}
+	return GopkgInPath{}, fmt.Errorf("%w: %s has no .vN suffix", ErrInvalidModulePath, path)
+}
+
+// PathMajor returns the major version suffix of the path, such as ".v3" or ".v3-unstable".
+func (p GopkgInPath) PathMajor() string {
+	suffix := ".v" + strconv.FormatUint(p.Major, 10)
+	if p.Unstable {
+		suffix += gopkgInUnstable
+	}
+	return suffix
+}
+
+// String returns the import path.
+func (p GopkgInPath) String() string {
+	s := p.Prefix + p.PathMajor()
+	if p.Subpath != "" {
+		s += "/" + p.Subpath
+	}
+	return s
+}
+
+// Repository returns the GitHub repository gopkg.in serves the path from: "gopkg.in/pkg.vN" is
+// served from "github.com/go-pkg/pkg", and "gopkg.in/user/pkg.vN" from "github.com/user/pkg".
+func (p GopkgInPath) Repository() string {
+	name := strings.TrimPrefix(p.Prefix, gopkgInHost+"/")
+	if user, pkg, ok := strings.Cut(name, "/"); ok {
+		return "github.com/" + user + "/" + pkg
+	}
+	return "github.com/go-" + name + "/" + name
+}
+
+// Range returns the versions gopkg.in selects for the path's major version N: the releases
+// ">=N.0.0 <N+1.0.0", excluding pre-releases. An unstable path also matches the pre-releases of
+// major version N.
+//
+// Example:
+//
+//	p, _ := semver.ParseGopkgInPath("gopkg.in/check.v1")
+//	fmt.Println(p.Range().Contains(semver.MustParse("1.9.0"))) // Output: true
+func (p GopkgInPath) Range() *VersionRange {
+	lower, upper := Version{Major: p.Major}, Version{Major: p.Major + 1}
+	policy := PrereleaseExcluded
+	if p.Unstable {
+		lower, upper = minimalPrerelease(p.Major, 0, 0), minimalPrerelease(p.Major+1, 0, 0)
+		policy = PrereleaseInclusive
+	}
+	return &VersionRange{
+		Requirements: [][]Requirement{{{Op: OpGte, Ver: lower}, {Op: OpLt, Ver: upper}}},
+		Prerelease:   policy,
+	}
+}
+
+// splitGopkgIn splits a gopkg.in module path into its prefix and ".vN" suffix, as the go command
+// does. Every gopkg.in path must have a suffix, and ".v0" is the only one with a leading zero.
+func splitGopkgIn(path string) (string, string, bool) {
+	i := len(path)
+	if strings.HasSuffix(path, gopkgInUnstable) {
+		i -= len(gopkgInUnstable)
+	}
+	for i > 0 && path[i-1] >= '0' && path[i-1] <= '9' {
+		i--
+	}
+	if i <= 1 || path[i-1] != 'v' || path[i-2] != '.' {
+		return path, "", false
+	}
+
+	prefix, pathMajor := path[:i-2], path[i-2:]
+	digits := strings.TrimSuffix(pathMajor[2:], gopkgInUnstable)
+	if digits == "" || len(digits) > 1 && digits[0] == '0' || strings.HasSuffix(prefix, "/") {
+		return path, "", false
+	}
+	return prefix, pathMajor, true
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGopkgInPath(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		path       string
+		prefix     string
+		major      uint64
+		unstable   bool
+		subpath    string
+		repository string
+	}{
+		{"gopkg.in/yaml.v3", "gopkg.in/yaml", 3, false, "", "github.com/go-yaml/yaml"},
+		{"https://gopkg.in/check.v1", "gopkg.in/check", 1, false, "", "github.com/go-check/check"},
+		{"gopkg.in/src-d/go-git.v4/plumbing/object", "gopkg.in/src-d/go-git", 4, false, "plumbing/object", "github.com/src-d/go-git"},
+		{"gopkg.in/mgo.v2-unstable", "gopkg.in/mgo", 2, true, "", "github.com/go-mgo/mgo"},
+		{"gopkg.in/pkg.v0", "gopkg.in/pkg", 0, false, "", "github.com/go-pkg/pkg"},
+	}
+	for _, tt := range tests {
+		p, err := ParseGopkgInPath(tt.path)
+		is.NoError(err, tt.path)
+		is.Equal(tt.prefix, p.Prefix, tt.path)
+		is.Equal(tt.major, p.Major, tt.path)
+		is.Equal(tt.unstable, p.Unstable, tt.path)
+		is.Equal(tt.subpath, p.Subpath, tt.path)
+		is.Equal(tt.repository, p.Repository(), tt.path)
+	}
+
+	p, err := ParseGopkgInPath("gopkg.in/src-d/go-git.v4/plumbing")
+	is.NoError(err)
+	is.Equal("gopkg.in/src-d/go-git.v4/plumbing", p.String())
+	is.Equal(".v4", p.PathMajor())
+
+	for _, path := range []string{"github.com/x/y.v2", "gopkg.in/yaml", "gopkg.in/yaml.v03", "gopkg.in/.v3", "gopkg.in/a/b/c.v1", "gopkg.in/yaml.v"} {
+		_, err := ParseGopkgInPath(path)
+		is.ErrorIs(err, ErrInvalidModulePath, path)
+	}
+}
+
+func TestGopkgInPathRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := ParseGopkgInPath("gopkg.in/yaml.v3")
+	is.NoError(err)
+	r := p.Range()
+	is.Equal(">=3.0.0 <4.0.0", r.String())
+	is.True(r.Contains(MustParse("3.0.1")))
+	is.False(r.Contains(MustParse("3.1.0-rc.1")))
+	is.False(r.Contains(MustParse("4.0.0")))
+
+	p, err = ParseGopkgInPath("gopkg.in/mgo.v2-unstable")
+	is.NoError(err)
+	r = p.Range()
+	is.True(r.Contains(MustParse("2.0.0-beta.1")))
+	is.False(r.Contains(MustParse("3.0.0-0")))
+}
+
+func TestSplitModulePathGopkgIn(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	prefix, pathMajor, ok := SplitModulePath("gopkg.in/yaml.v3")
+	is.True(ok)
+	is.Equal("gopkg.in/yaml", prefix)
+	is.Equal(".v3", pathMajor)
+
+	_, _, ok = SplitModulePath("gopkg.in/yaml")
+	is.False(ok)
+
+	is.NoError(CheckModulePath("gopkg.in/yaml.v3", MustParse("3.0.1")))
+	is.NoError(CheckModulePath("gopkg.in/yaml.v1", MustParse("1.0.0")))
+	is.NoError(CheckModulePath("gopkg.in/yaml.v1", MustParse("0.0.0-20240101120000-abcdef123456")))
+	is.NoError(CheckModulePath("gopkg.in/mgo.v2-unstable", MustParse("5.0.0")))
+	is.ErrorIs(CheckModulePath("gopkg.in/yaml.v3", MustParse("2.4.0")), ErrPathMajorMismatch)
+	is.ErrorIs(CheckModulePath("gopkg.in/yaml.v0", MustParse("1.0.0")), ErrPathMajorMismatch)
+}
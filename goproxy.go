@@ -0,0 +1,224 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// pseudoTimeLayout is the layout of the UTC timestamp in a pseudo-version.
+const pseudoTimeLayout = "20060102150405"
+
+// PseudoVersionKind classifies a Go module version by the form of pseudo-version it has.
+//
+// Supported Kinds:
+//   - PseudoNone: Not a pseudo-version, such as a tagged release "v1.2.3".
+//   - PseudoUntagged: A pseudo-version with no base tag, such as "v0.0.0-20240101120000-abcdef123456".
+//   - PseudoRelease: A pseudo-version following a release tag, such as "v1.2.4-0.20240101120000-abcdef123456".
+//   - PseudoPrerelease: A pseudo-version following a pre-release tag, such as "v1.2.4-rc.1.0.20240101120000-abcdef123456".
+type PseudoVersionKind int
+
+const (
+	PseudoNone PseudoVersionKind = iota
+	PseudoUntagged
+	PseudoRelease
+	PseudoPrerelease
+)
+
+// String returns the string representation of the PseudoVersionKind.
+//
+// Example:
+//
+//	fmt.Println(semver.PseudoRelease.String()) // Output: release
+func (k PseudoVersionKind) String() string {
+	switch k {
+	case PseudoNone:
+		return "none"
+	case PseudoUntagged:
+		return "untagged"
+	case PseudoRelease:
+		return "release"
+	case PseudoPrerelease:
+		return "prerelease"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifyPseudoVersion returns the kind of pseudo-version v is, or PseudoNone if it is not one.
+//
+// It is stricter than IsPseudoVersion: the timestamp and revision must follow a base the way
+// the go command writes them, so "1.2.0-20240101120000-abcdef123456" is PseudoNone.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.4-0.20240101120000-abcdef123456")
+//	fmt.Println(semver.ClassifyPseudoVersion(v)) // Output: release
+func ClassifyPseudoVersion(v Version) PseudoVersionKind {
+	if !IsPseudoVersion(v) {
+		return PseudoNone
+	}
+
+	n := len(v.PreRelease)
+	switch {
+	case n == 1:
+		if v.Minor != 0 || v.Patch != 0 {
+			return PseudoNone
+		}
+		return PseudoUntagged
+	case v.PreRelease[n-2].String() != "0":
+		return PseudoNone
+	case n == 2:
+		return PseudoRelease
+	default:
+		return PseudoPrerelease
+	}
+}
+
+// PseudoVersionTime returns the commit time recorded in a pseudo-version. The boolean result is
+// false if v is not a pseudo-version.
+//
+// Example:
+//
+//	t, _ := semver.PseudoVersionTime(semver.MustParse("0.0.0-20240101120000-abcdef123456"))
+//	fmt.Println(t.Format(time.DateOnly)) // Output: 2024-01-01
+func PseudoVersionTime(v Version) (time.Time, bool) {
+	if ClassifyPseudoVersion(v) == PseudoNone {
+		return time.Time{}, false
+	}
+	timestamp, _, _ := strings.Cut(v.PreRelease[len(v.PreRelease)-1].String(), "-")
+	t, err := time.Parse(pseudoTimeLayout, timestamp)
+	return t, err == nil
+}
+
+// ModuleInfo is the version information served by a Go module proxy's @latest and
+// @v/<version>.info endpoints.
+type ModuleInfo struct {
+	Version Version
+	Time    time.Time
+	Kind    PseudoVersionKind
+}
+
+// Entry returns the information as a VersionEntry, for ordering by release time.
+func (m ModuleInfo) Entry() VersionEntry {
+	return VersionEntry{Version: m.Version, Released: m.Time}
+}
+
+// ParseProxyList reads the output of a Go module proxy's @v/list endpoint, one version per
+// line, into Versions sorted in increasing order without duplicates.
+//
+// Versions must carry the "v" prefix, as ParseModuleVersion requires. Blank lines are skipped,
+// as are any fields following the version on a line. Use ClassifyPseudoVersion to tell tagged
+// versions from pseudo-versions, which a proxy may list when serving a repository directly.
+//
+// Returns an error naming the line of the first version that cannot be parsed.
+//
+// Example:
+//
+//	resp, err := http.Get("https://proxy.golang.org/github.com/sixafter/semver/@v/list")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer resp.Body.Close()
+//
+//	versions, err := semver.ParseProxyList(resp.Body)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	latest, _ := semver.ProxyLatest(versions)
+//	fmt.Println(latest)
+func ParseProxyList(r io.Reader) (Versions, error) {
+	var versions Versions
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		v, err := ParseModuleVersion(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %q: %w", line, fields[0], err)
+		}
+		versions = append(versions, &v)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	Sort(versions)
+	return slices.CompactFunc(versions, func(a, b *Version) bool { return a.StrictEqual(*b) }), nil
+}
+
+// ParseProxyInfo reads the JSON served by a Go module proxy's @latest and @v/<version>.info
+// endpoints, such as {"Version": "v1.2.3", "Time": "2024-01-01T12:00:00Z"}. Fields other than
+// Version and Time are ignored.
+//
+// Example:
+//
+//	info, err := semver.ParseProxyInfo(strings.NewReader(`{"Version":"v0.0.0-20240101120000-abcdef123456"}`))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(info.Version, info.Kind) // Output: 0.0.0-20240101120000-abcdef123456 untagged
+func ParseProxyInfo(r io.Reader) (ModuleInfo, error) {
+	var raw struct {
+		Version string
+		Time    time.Time
+	}
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return ModuleInfo{}, err
+	}
+
+	v, err := ParseModuleVersion(raw.Version)
+	if err != nil {
+		return ModuleInfo{}, fmt.Errorf("%q: %w", raw.Version, err)
+	}
+	return ModuleInfo{Version: v, Time: raw.Time, Kind: ClassifyPseudoVersion(v)}, nil
+}
+
+// ProxyLatest returns the version the go command would select as "latest" from a proxy's
+// version list: the highest release, or the highest pre-release if there is no release.
+// Pseudo-versions are never selected. The boolean result is false if there is no candidate, in
+// which case the go command falls back to the @latest endpoint.
+//
+// Nil entries are ignored.
+//
+// Example:
+//
+//	versions, _ := semver.ParseProxyList(strings.NewReader("v1.0.0\nv1.1.0-rc.1\n"))
+//	v, ok := semver.ProxyLatest(versions)
+//	fmt.Println(v, ok) // Output: 1.0.0 true
+func ProxyLatest(versions Versions) (Version, bool) {
+	var release, prerelease *Version
+	for _, v := range versions {
+		switch {
+		case v == nil || ClassifyPseudoVersion(*v) != PseudoNone:
+		case len(v.PreRelease) == 0:
+			if release == nil || v.GreaterThan(*release) {
+				release = v
+			}
+		default:
+			if prerelease == nil || v.GreaterThan(*prerelease) {
+				prerelease = v
+			}
+		}
+	}
+
+	switch {
+	case release != nil:
+		return *release, true
+	case prerelease != nil:
+		return *prerelease, true
+	default:
+		return Version{}, false
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyPseudoVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected PseudoVersionKind
+	}{
+		{"1.2.3", PseudoNone},
+		{"1.2.3-rc.1", PseudoNone},
+		{"0.0.0-20240101120000-abcdef123456", PseudoUntagged},
+		{"2.0.0-20240101120000-abcdef123456+incompatible", PseudoUntagged},
+		{"1.2.0-20240101120000-abcdef123456", PseudoNone},
+		{"1.2.4-0.20240101120000-abcdef123456", PseudoRelease},
+		{"1.2.4-rc.1.0.20240101120000-abcdef123456", PseudoPrerelease},
+		{"1.2.4-rc.1.20240101120000-abcdef123456", PseudoNone},
+	}
+	for _, tt := range tests {
+		is.Equal(tt.expected, ClassifyPseudoVersion(MustParse(tt.input)), tt.input)
+	}
+
+	is.Equal("untagged", PseudoUntagged.String())
+	is.Equal("prerelease", PseudoPrerelease.String())
+	is.Equal("unknown", PseudoVersionKind(9).String())
+}
+
+func TestPseudoVersionTime(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tm, ok := PseudoVersionTime(MustParse("1.2.4-0.20240102030405-abcdef123456"))
+	is.True(ok)
+	is.Equal(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), tm)
+
+	_, ok = PseudoVersionTime(MustParse("1.2.4"))
+	is.False(ok)
+
+	_, ok = PseudoVersionTime(MustParse("0.0.0-20241399120000-abcdef123456"))
+	is.False(ok)
+}
+
+func TestParseProxyList(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	versions, err := ParseProxyList(strings.NewReader("v1.1.0\nv1.0.0\n\nv1.2.0-rc.1 2024-01-01T00:00:00Z\nv1.0.0\nv1.1.1-0.20240101120000-abcdef123456\n"))
+	is.NoError(err)
+	is.Len(versions, 4)
+	is.Equal("1.0.0", versions[0].String())
+	is.Equal("1.2.0-rc.1", versions[3].String())
+
+	v, ok := ProxyLatest(versions)
+	is.True(ok)
+	is.Equal("1.1.0", v.String())
+
+	versions, err = ParseProxyList(strings.NewReader(""))
+	is.NoError(err)
+	_, ok = ProxyLatest(versions)
+	is.False(ok)
+
+	_, err = ParseProxyList(strings.NewReader("v1.0.0\n1.1.0\n"))
+	is.ErrorIs(err, ErrUnexpectedCharacter)
+	is.ErrorContains(err, "line 2")
+}
+
+func TestProxyLatest(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	a, b, c := MustParse("1.0.0-rc.1"), MustParse("1.0.0-rc.2"), MustParse("1.0.1-0.20240101120000-abcdef123456")
+	v, ok := ProxyLatest(Versions{&a, nil, &b, &c})
+	is.True(ok)
+	is.Equal("1.0.0-rc.2", v.String())
+
+	_, ok = ProxyLatest(Versions{&c})
+	is.False(ok)
+}
+
+func TestParseProxyInfo(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	info, err := ParseProxyInfo(strings.NewReader(`{"Version":"v1.2.4-0.20240101120000-abcdef123456","Time":"2024-01-01T12:00:00Z","Origin":{"VCS":"git"}}`))
+	is.NoError(err)
+	is.Equal(PseudoRelease, info.Kind)
+	is.Equal(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC), info.Time)
+	is.Equal(VersionEntry{Version: info.Version, Released: info.Time}, info.Entry())
+
+	_, err = ParseProxyInfo(strings.NewReader(`{"Version":"1.2.3"}`))
+	is.Error(err)
+
+	_, err = ParseProxyInfo(strings.NewReader(`not json`))
+	is.Error(err)
+}
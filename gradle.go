@@ -0,0 +1,130 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+const (
+	// GradleLatestRelease is the Gradle dynamic version selecting the newest release.
+	GradleLatestRelease = "latest.release"
+
+	// GradleLatestIntegration is the Gradle dynamic version selecting the newest version of any status.
+	GradleLatestIntegration = "latest.integration"
+)
+
+// GradleDialect parses Gradle and Ivy dynamic versions.
+//
+// Supported syntax:
+//   - Exact versions: "1.2.3", "1.2"
+//   - Prefix versions: "1.+", "1.2.+", "+"
+//   - Intervals: "[1.0,2.0)", "[1.0,)", "(,2.0]", including the Ivy forms "]1.0,2.0[" and "[1.0,2.0["
+//   - Status selectors: "latest.release" and "latest.integration"
+//
+// The status selectors map to ranges that match every version: "latest.release" uses
+// PrereleaseExcluded and "latest.integration" uses PrereleaseInclusive. Other ranges use
+// PrereleaseInclusive, since Gradle does not treat pre-releases specially. Prefix versions
+// include the pre-releases of their lowest version, so "1.2.+" matches "1.2.0-rc.1".
+//
+// Example:
+//
+//	r, err := semver.GradleDialect.ParseRange("1.+")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.9.0"))) // Output: true
+var GradleDialect Dialect = gradleDialect{}
+
+type gradleDialect struct{}
+
+// Name returns the name of the dialect.
+func (gradleDialect) Name() string {
+	return "gradle"
+}
+
+// ParseVersion parses a Gradle version, tolerating a "v" prefix and coercing missing minor
+// and patch components to zero.
+func (gradleDialect) ParseVersion(v string) (Version, error) {
+	return parseCoercedVersion(strings.TrimSpace(v))
+}
+
+// FormatRange formats a range as a Gradle interval, which can hold a single interval only.
+// Bounds are written as plain releases, since Maven and Gradle order "2.0.0-0" as 2.0.0.
+func (gradleDialect) FormatRange(r *VersionRange) (string, error) {
+	return formatBracketInterval(r, "gradle", "+", formatGradleVersion)
+}
+
+// formatGradleVersion writes a bound of a Gradle interval, dropping the "-0" sentinel of
+// expanded upper bounds.
+func formatGradleVersion(v Version) string {
+	if v.IsMinimalPrerelease() {
+		v.PreRelease = nil
+	}
+	return v.String()
+}
+
+// ParseRange parses a Gradle dynamic version into a VersionRange.
+func (gradleDialect) ParseRange(r string) (*VersionRange, error) {
+	s := strings.TrimSpace(r)
+	if s == "" {
+		return nil, invalidRangeToken(r)
+	}
+
+	switch s {
+	case GradleLatestRelease:
+		return &VersionRange{
+			Requirements: matchAll(),
+			Prerelease:   PrereleaseExcluded,
+		}, nil
+	case GradleLatestIntegration, "+":
+		return &VersionRange{
+			Requirements: [][]Requirement{{{Op: OpGte, Ver: minimalPrerelease(0, 0, 0)}}},
+		}, nil
+	}
+
+	var reqs []Requirement
+	switch {
+	case strings.ContainsAny(s[:1], "[(]"):
+		var err error
+		reqs, err = parseInterval(s, "(]", ")[")
+		if err != nil {
+			return nil, err
+		}
+	case strings.HasSuffix(s, ".+"):
+		p, err := parsePartialVersion(strings.TrimSuffix(s, ".+"))
+		if err != nil || p.parts == 0 || p.parts > 2 || len(p.PreRelease) > 0 || len(p.BuildMetadata) > 0 {
+			return nil, invalidRangeToken(s)
+		}
+		reqs = []Requirement{
+			{Op: OpGte, Ver: minimalPrerelease(p.Major, p.Minor, 0)},
+			{Op: OpLt, Ver: p.ceiling()},
+		}
+	default:
+		v, err := parseCoercedVersion(s)
+		if err != nil {
+			return nil, invalidRangeToken(s)
+		}
+		reqs = []Requirement{{Op: OpEq, Ver: v}}
+	}
+
+	return &VersionRange{
+		Requirements: [][]Requirement{reqs},
+	}, nil
+}
+
+// ParseGradleRange parses a dynamic version using GradleDialect.
+//
+// Example:
+//
+//	r, err := semver.ParseGradleRange("[1.0,2.0[")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("2.0.0"))) // Output: false
+func ParseGradleRange(r string) (*VersionRange, error) {
+	return GradleDialect.ParseRange(r)
+}
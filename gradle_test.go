@@ -0,0 +1,66 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGradleDialectContains(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rangeStr    string
+		version     string
+		shouldMatch bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "1.2.4", false},
+		{"1.2", "1.2.0", true},
+		{"1.+", "1.0.0", true},
+		{"1.+", "1.99.0", true},
+		{"1.+", "2.0.0", false},
+		{"1.+", "2.0.0-rc.1", false},
+		{"1.2.+", "1.2.7", true},
+		{"1.2.+", "1.2.0-rc.1", true},
+		{"1.2.+", "1.3.0", false},
+		{"+", "0.0.1-alpha", true},
+		{"[1.0,2.0)", "1.5.0", true},
+		{"[1.0,2.0)", "2.0.0", false},
+		{"[1.0,2.0[", "2.0.0", false},
+		{"]1.0,2.0]", "1.0.0", false},
+		{"]1.0,2.0]", "2.0.0", true},
+		{"[1.0,)", "7.0.0", true},
+		{"(,2.0]", "0.1.0", true},
+		{"latest.release", "9.9.9", true},
+		{"latest.release", "9.9.9-rc.1", false},
+		{"latest.integration", "9.9.9-SNAPSHOT", true},
+		{"latest.integration", "0.0.0", true},
+	}
+
+	for _, test := range tests {
+		r, err := ParseGradleRange(test.rangeStr)
+		is.NoError(err, "Range %s should parse", test.rangeStr)
+		if err != nil {
+			continue
+		}
+		is.Equal(test.shouldMatch, r.Contains(MustParse(test.version)), "Version %s against range %s", test.version, test.rangeStr)
+	}
+}
+
+func TestGradleDialectInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, input := range []string{"", "1.2.3.+", "1.+.3", "1.2+", "latest.foo", "[1.0,2.0", ")1.0,2.0]"} {
+		_, err := GradleDialect.ParseRange(input)
+		is.ErrorIs(err, ErrInvalidRangeToken, "Expected error for input: %q", input)
+	}
+	is.Equal("gradle", GradleDialect.Name())
+}
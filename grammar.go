@@ -0,0 +1,262 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Production is a nonterminal of the Backus–Naur Form grammar for valid versions published
+// in the Semantic Versioning 2.0.0 specification.
+type Production string
+
+// Productions of the Semantic Versioning 2.0.0 grammar that ValidateGrammar can report.
+const (
+	ProductionValidSemver                       Production = "<valid semver>"
+	ProductionVersionCore                       Production = "<version core>"
+	ProductionMajor                             Production = "<major>"
+	ProductionMinor                             Production = "<minor>"
+	ProductionPatch                             Production = "<patch>"
+	ProductionPrerelease                        Production = "<pre-release>"
+	ProductionDotSeparatedPrereleaseIdentifiers Production = "<dot-separated pre-release identifiers>"
+	ProductionPrereleaseIdentifier              Production = "<pre-release identifier>"
+	ProductionBuild                             Production = "<build>"
+	ProductionDotSeparatedBuildIdentifiers      Production = "<dot-separated build identifiers>"
+	ProductionBuildIdentifier                   Production = "<build identifier>"
+	ProductionAlphanumericIdentifier            Production = "<alphanumeric identifier>"
+	ProductionNumericIdentifier                 Production = "<numeric identifier>"
+)
+
+// GrammarError reports where a string deviates from the Semantic Versioning 2.0.0 grammar.
+//
+// Path lists the productions being matched when validation failed, from <valid semver> down
+// to the innermost production, and Offset is the byte offset of the offending input. Err is
+// one of the package's sentinel errors, such as ErrLeadingZeroInNumericIdentifier, so that
+// errors.Is works on a GrammarError as it does on parser errors. Rule cites the rule of the
+// specification that the input violates.
+type GrammarError struct {
+	Input  string
+	Offset int
+	Path   []Production
+	Rule   SpecRule
+	Err    error
+}
+
+// Production returns the innermost production that failed to match.
+func (e *GrammarError) Production() Production {
+	if len(e.Path) == 0 {
+		return ProductionValidSemver
+	}
+	return e.Path[len(e.Path)-1]
+}
+
+// Error returns a description of the failure that cites the violated rule, such as
+// `"1.2.3-01" does not match <numeric identifier> at offset 6: leading zeros are not allowed in
+// numeric identifiers (rule 9: Numeric identifiers MUST NOT include leading zeroes.)`.
+func (e *GrammarError) Error() string {
+	return fmt.Sprintf("%q does not match %s at offset %d: %v (%s)", e.Input, e.Production(), e.Offset, e.Err, e.Rule)
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *GrammarError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateGrammar checks s against the Backus–Naur Form grammar of the Semantic Versioning
+// 2.0.0 specification and returns a *GrammarError naming the production that failed, or nil
+// if s is a valid version.
+//
+// Unlike Parse, ValidateGrammar imposes nothing the grammar does not: there is no limit on the
+// length of the string, of its identifiers, or on the magnitude of numeric identifiers, which
+// the grammar allows to exceed the range of uint64. It is intended for certification-style
+// validation that must cite the rule a version violates.
+//
+// Example:
+//
+//	err := semver.ValidateGrammar("1.2.3-01")
+//	var ge *semver.GrammarError
+//	if errors.As(err, &ge) {
+//	    fmt.Println(ge.Production()) // Output: <numeric identifier>
+//	}
+func ValidateGrammar(s string) error {
+	g := grammar{input: s, path: []Production{ProductionValidSemver}}
+	if s == "" {
+		return g.fail(0, ErrEmptyVersionString)
+	}
+
+	if err := g.versionCore(); err != nil {
+		return err
+	}
+	if g.peek() == '-' {
+		g.pos++
+		if err := g.dotSeparated(ProductionPrerelease, ProductionDotSeparatedPrereleaseIdentifiers, ".+", g.prereleaseIdentifier); err != nil {
+			return err
+		}
+	}
+	if g.peek() == '+' {
+		g.pos++
+		if err := g.dotSeparated(ProductionBuild, ProductionDotSeparatedBuildIdentifiers, ".", g.buildIdentifier); err != nil {
+			return err
+		}
+	}
+	if g.pos != len(s) {
+		return g.fail(g.pos, ErrUnexpectedCharacter)
+	}
+	return nil
+}
+
+// grammar is a recursive-descent recognizer for the Semantic Versioning 2.0.0 grammar.
+type grammar struct {
+	input string
+	pos   int
+	path  []Production
+}
+
+// peek returns the byte at the current position, or 0 at the end of the input.
+func (g *grammar) peek() byte {
+	if g.pos < len(g.input) {
+		return g.input[g.pos]
+	}
+	return 0
+}
+
+// enter pushes a production onto the path and returns a function that pops it.
+func (g *grammar) enter(p Production) func() {
+	g.path = append(g.path, p)
+	return func() { g.path = g.path[:len(g.path)-1] }
+}
+
+// fail returns a GrammarError at offset with a copy of the current path.
+func (g *grammar) fail(offset int, err error) error {
+	path := append([]Production(nil), g.path...)
+	return &GrammarError{Input: g.input, Offset: offset, Path: path, Rule: specRule(path, err), Err: err}
+}
+
+// versionCore matches <major> "." <minor> "." <patch>.
+func (g *grammar) versionCore() error {
+	defer g.enter(ProductionVersionCore)()
+
+	for i, p := range []Production{ProductionMajor, ProductionMinor, ProductionPatch} {
+		if i > 0 {
+			if g.peek() != '.' {
+				return g.fail(g.pos, ErrMissingVersionElements)
+			}
+			g.pos++
+		}
+		if err := g.numericComponent(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// numericComponent matches <major>, <minor>, or <patch>, each a <numeric identifier>.
+func (g *grammar) numericComponent(p Production) error {
+	defer g.enter(p)()
+
+	start := g.pos
+	for isDigit(g.peek()) {
+		g.pos++
+	}
+	return g.numericIdentifier(g.input[start:g.pos], start)
+}
+
+// numericIdentifier checks that s, found at offset, is "0" or a positive digit followed by
+// digits.
+func (g *grammar) numericIdentifier(s string, offset int) error {
+	defer g.enter(ProductionNumericIdentifier)()
+
+	switch {
+	case s == "" && g.pos >= len(g.input):
+		return g.fail(offset, ErrUnexpectedEndOfInput)
+	case s == "":
+		return g.fail(offset, ErrInvalidNumericIdentifier)
+	case len(s) > 1 && s[0] == '0':
+		return g.fail(offset, ErrLeadingZeroInNumericIdentifier)
+	}
+	return nil
+}
+
+// dotSeparated matches one or more identifiers separated by dots under the given production
+// and its dot-separated list production. Each identifier extends up to the next byte in stop.
+func (g *grammar) dotSeparated(p, list Production, stop string, identifier func(s string, offset int) error) error {
+	defer g.enter(p)()
+	defer g.enter(list)()
+
+	for {
+		start := g.pos
+		g.pos = len(g.input)
+		if end := strings.IndexAny(g.input[start:], stop); end >= 0 {
+			g.pos = start + end
+		}
+		if err := identifier(g.input[start:g.pos], start); err != nil {
+			return err
+		}
+		if g.peek() != '.' {
+			return nil
+		}
+		g.pos++
+	}
+}
+
+// prereleaseIdentifier matches <alphanumeric identifier> | <numeric identifier>.
+func (g *grammar) prereleaseIdentifier(s string, offset int) error {
+	defer g.enter(ProductionPrereleaseIdentifier)()
+
+	switch {
+	case s == "":
+		return g.fail(offset, ErrEmptyPrereleaseIdentifier)
+	case isNumeric(s):
+		return g.numericIdentifier(s, offset)
+	default:
+		return g.alphanumericIdentifier(s, offset)
+	}
+}
+
+// buildIdentifier matches <alphanumeric identifier> | <digits>.
+func (g *grammar) buildIdentifier(s string, offset int) error {
+	defer g.enter(ProductionBuildIdentifier)()
+
+	if s == "" {
+		if offset == len(g.input) && g.input[offset-1] == '+' {
+			return g.fail(offset, ErrEmptyBuildMetadata)
+		}
+		return g.fail(offset, ErrInvalidBuildMetadataIdentifier)
+	}
+	if isNumeric(s) {
+		return nil
+	}
+	return g.alphanumericIdentifier(s, offset)
+}
+
+// alphanumericIdentifier checks that s, found at offset, consists of identifier characters
+// and at least one non-digit.
+func (g *grammar) alphanumericIdentifier(s string, offset int) error {
+	defer g.enter(ProductionAlphanumericIdentifier)()
+
+	if i := invalidIdentifierChar(s); i >= 0 {
+		return g.fail(offset+i, ErrInvalidCharacterInIdentifier)
+	}
+	return nil
+}
+
+// invalidIdentifierChar returns the index of the first byte of s that is not an
+// <identifier character>, or -1 if there is none.
+func invalidIdentifierChar(s string) int {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !isDigit(c) && !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && c != '-' {
+			return i
+		}
+	}
+	return -1
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateGrammarValid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	valid := []string{
+		"0.0.4",
+		"1.2.3",
+		"10.20.30",
+		"1.1.2-prerelease+meta",
+		"1.1.2+meta-valid",
+		"1.0.0-alpha.beta.1",
+		"1.0.0-alpha0.valid",
+		"1.0.0-0A.is.legal",
+		"1.0.0+0.build.1-rc.10000aaa-kk-0.1",
+		"2.0.0+build.1848",
+		"1.0.0-alpha-a.b-c-somethinglong+build.1-aef.1-its-okay",
+		"1.0.0+001",
+		"99999999999999999999999.999999999999999999.99999999999999999",
+		"1.0.0-" + strings.Repeat("a", 300),
+	}
+	for _, s := range valid {
+		is.NoError(ValidateGrammar(s), s)
+	}
+}
+
+func TestValidateGrammarInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input      string
+		production Production
+		offset     int
+		err        error
+	}{
+		{"", ProductionValidSemver, 0, ErrEmptyVersionString},
+		{"01.2.3", ProductionNumericIdentifier, 0, ErrLeadingZeroInNumericIdentifier},
+		{"1.2", ProductionVersionCore, 3, ErrMissingVersionElements},
+		{"1.2.", ProductionNumericIdentifier, 4, ErrUnexpectedEndOfInput},
+		{"1.x.3", ProductionNumericIdentifier, 2, ErrInvalidNumericIdentifier},
+		{"1.2.3x", ProductionValidSemver, 5, ErrUnexpectedCharacter},
+		{"1.2.3-", ProductionPrereleaseIdentifier, 6, ErrEmptyPrereleaseIdentifier},
+		{"1.2.3-a..b", ProductionPrereleaseIdentifier, 8, ErrEmptyPrereleaseIdentifier},
+		{"1.2.3-01", ProductionNumericIdentifier, 6, ErrLeadingZeroInNumericIdentifier},
+		{"1.2.3-a_b", ProductionAlphanumericIdentifier, 7, ErrInvalidCharacterInIdentifier},
+		{"1.2.3+", ProductionBuildIdentifier, 6, ErrEmptyBuildMetadata},
+		{"1.2.3+a.", ProductionBuildIdentifier, 8, ErrInvalidBuildMetadataIdentifier},
+		{"1.2.3+a+b", ProductionAlphanumericIdentifier, 7, ErrInvalidCharacterInIdentifier},
+	}
+
+	for _, tc := range tests {
+		err := ValidateGrammar(tc.input)
+		var ge *GrammarError
+		if !is.True(errors.As(err, &ge), tc.input) {
+			continue
+		}
+		is.Equal(tc.production, ge.Production(), tc.input)
+		is.Equal(tc.offset, ge.Offset, tc.input)
+		is.ErrorIs(err, tc.err, tc.input)
+		is.Equal(ProductionValidSemver, ge.Path[0], tc.input)
+	}
+}
+
+func TestGrammarErrorPath(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var ge *GrammarError
+	is.True(errors.As(ValidateGrammar("1.2.3-rc.01"), &ge))
+	is.Equal([]Production{
+		ProductionValidSemver,
+		ProductionPrerelease,
+		ProductionDotSeparatedPrereleaseIdentifiers,
+		ProductionPrereleaseIdentifier,
+		ProductionNumericIdentifier,
+	}, ge.Path)
+	is.Equal(`"1.2.3-rc.01" does not match <numeric identifier> at offset 9: leading zeros are not allowed in numeric identifiers (rule 9: Numeric identifiers MUST NOT include leading zeroes.)`, ge.Error())
+
+	is.True(errors.As(ValidateGrammar("1.02.3"), &ge))
+	is.Equal([]Production{ProductionValidSemver, ProductionVersionCore, ProductionMinor, ProductionNumericIdentifier}, ge.Path)
+}
+
+func TestValidateGrammarAgreesWithParse(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	inputs := []string{"1.2.3", "1.2.3-rc.1+b.2", "1.2", "1.2.3-", "1.2.3+", "01.2.3", "1.2.3-a_b", "v1.2.3", "1.2.3 ", "1.2.3-0.0"}
+	for _, s := range inputs {
+		_, err := Parse(s)
+		is.Equal(err == nil, ValidateGrammar(s) == nil, s)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"io"
+	"strconv"
+)
+
+// GQLMarshaler is implemented by types that can marshal themselves as a GraphQL scalar.
+// It has the same method set as graphql.Marshaler from gqlgen, so a Version can be bound
+// to a custom scalar without this package depending on gqlgen.
+type GQLMarshaler interface {
+	// MarshalGQL writes the JSON representation of the scalar to w.
+	MarshalGQL(w io.Writer)
+}
+
+// GQLUnmarshaler is implemented by types that can unmarshal a GraphQL scalar input value.
+// It has the same method set as graphql.Unmarshaler from gqlgen.
+type GQLUnmarshaler interface {
+	// UnmarshalGQL decodes the input value of the scalar.
+	UnmarshalGQL(v interface{}) error
+}
+
+var (
+	_ GQLMarshaler   = Version{}
+	_ GQLUnmarshaler = (*Version)(nil)
+)
+
+// MarshalGQL implements GQLMarshaler.
+// It writes the Version as a quoted JSON string.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	semver.MustParse("1.2.3-beta").MarshalGQL(&buf)
+//	fmt.Println(buf.String()) // Output: "1.2.3-beta"
+func (v Version) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(v.String()))
+}
+
+// UnmarshalGQL implements GQLUnmarshaler.
+// It parses a string input value into a Version, rejecting any other input type.
+//
+// Example:
+//
+//	var v semver.Version
+//	err := v.UnmarshalGQL("1.2.3-beta")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.3-beta
+func (v *Version) UnmarshalGQL(value interface{}) error {
+	switch t := value.(type) {
+	case string:
+		return v.UnmarshalText([]byte(t))
+	case []byte:
+		return v.UnmarshalText(t)
+	default:
+		return ErrUnsupportedType
+	}
+}
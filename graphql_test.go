@@ -0,0 +1,38 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionMarshalGQL(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var buf bytes.Buffer
+	MustParse("1.2.3-beta+build.1").MarshalGQL(&buf)
+	is.Equal(`"1.2.3-beta+build.1"`, buf.String())
+}
+
+func TestVersionUnmarshalGQL(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var v Version
+	is.NoError(v.UnmarshalGQL("1.2.3-beta"))
+	is.Equal(MustParse("1.2.3-beta"), v)
+
+	is.NoError(v.UnmarshalGQL([]byte("2.0.0")))
+	is.Equal(MustParse("2.0.0"), v)
+
+	is.Error(v.UnmarshalGQL("1.2"))
+	is.ErrorIs(v.UnmarshalGQL(123), ErrUnsupportedType)
+	is.ErrorIs(v.UnmarshalGQL(nil), ErrUnsupportedType)
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// HelmDialect parses range expressions the way Helm does (via Masterminds/semver), so that chart
+// tooling written in Go makes the same include/exclude decisions as helm itself.
+//
+// Supported syntax:
+//   - Comparisons: "=", "==", "!=", ">", ">=", "=>", "<", "<=", "=<"
+//   - Caret and tilde: "^1.2.3", "~1.2", "~>1.2"
+//   - Wildcards and partial versions: "1.2.x", "1.*", "1.2", "*"
+//   - Hyphen ranges: "1.2 - 1.4.5"
+//   - AND with commas or spaces, OR with "||"
+//   - An optional "v" prefix on every version
+//
+// Ranges use PrereleaseOptIn: a pre-release version only matches a requirement whose own version
+// carries a pre-release, so ">=1.2.3" skips pre-releases while ">=1.2.3-0" includes them.
+//
+// Example:
+//
+//	r, err := semver.HelmDialect.ParseRange(">= v1.2, < 2")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.9.0")))      // Output: true
+//	fmt.Println(r.Contains(semver.MustParse("1.9.0-rc.1"))) // Output: false
+var HelmDialect Dialect = helmDialect{}
+
+type helmDialect struct{}
+
+// helmOperators lists the Helm operators, longest first so that prefixes match greedily.
+var helmOperators = []string{">=", "=>", "<=", "=<", "!=", "==", "~>", ">", "<", "=", "~", "^"}
+
+// Name returns the name of the dialect.
+func (helmDialect) Name() string {
+	return "helm"
+}
+
+// ParseVersion parses a Helm version, tolerating a "v" prefix and coercing missing minor
+// and patch components to zero.
+func (helmDialect) ParseVersion(v string) (Version, error) {
+	return parseCoercedVersion(strings.TrimSpace(v))
+}
+
+// FormatRange formats a range as a Helm range expression.
+func (helmDialect) FormatRange(r *VersionRange) (string, error) {
+	return formatComparisons(r, Requirement.String), nil
+}
+
+// ParseRange parses a Helm range expression into a VersionRange.
+func (helmDialect) ParseRange(r string) (*VersionRange, error) {
+	vr := &VersionRange{
+		Prerelease: PrereleaseOptIn,
+	}
+
+	for _, part := range strings.Split(r, "||") {
+		tokens := joinOperatorTokens(strings.Fields(strings.ReplaceAll(part, ",", " ")), helmOperators)
+		if len(tokens) == 0 {
+			return nil, invalidRangeToken(r)
+		}
+
+		var group [][]Requirement
+		for i := 0; i < len(tokens); i++ {
+			var reqs [][]Requirement
+			var err error
+
+			if i+2 < len(tokens) && tokens[i+1] == "-" {
+				reqs, err = parseHyphenTokens(tokens[i], tokens[i+2])
+				i += 2
+			} else {
+				reqs, err = parseHelmToken(tokens[i])
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			group = andRequirements(group, reqs)
+		}
+
+		vr.Requirements = append(vr.Requirements, group...)
+	}
+
+	return vr, nil
+}
+
+// parseHelmToken parses a single operator and version token.
+func parseHelmToken(token string) ([][]Requirement, error) {
+	op, rest := splitOperator(token, helmOperators)
+
+	p, err := parsePartialVersion(rest)
+	if err != nil {
+		return nil, invalidRangeToken(token)
+	}
+
+	switch op {
+	case "^":
+		return expandCaret(p), nil
+	case "~", "~>":
+		return expandTilde(p), nil
+	case "", "=", "==":
+		return expandComparison(OpEq, p), nil
+	case "=>":
+		return expandComparison(OpGte, p), nil
+	case "=<":
+		return expandComparison(OpLte, p), nil
+	default:
+		return expandComparison(Operator(op), p), nil
+	}
+}
+
+// parseHyphenTokens parses the lower and upper bounds of a hyphen range.
+func parseHyphenTokens(lower, upper string) ([][]Requirement, error) {
+	lp, err := parsePartialVersion(lower)
+	if err != nil {
+		return nil, invalidRangeToken(lower)
+	}
+	up, err := parsePartialVersion(upper)
+	if err != nil {
+		return nil, invalidRangeToken(upper)
+	}
+	return expandHyphen(lp, up), nil
+}
+
+// splitOperator splits the longest matching operator prefix from token.
+func splitOperator(token string, operators []string) (string, string) {
+	for _, op := range operators {
+		if strings.HasPrefix(token, op) {
+			return op, strings.TrimSpace(token[len(op):])
+		}
+	}
+	return "", token
+}
+
+// joinOperatorTokens joins tokens that consist only of an operator with the token that follows,
+// so that "> 1.2" and ">1.2" are handled alike.
+func joinOperatorTokens(tokens []string, operators []string) []string {
+	joined := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		op, rest := splitOperator(tokens[i], operators)
+		if op != "" && rest == "" && i+1 < len(tokens) {
+			joined = append(joined, op+tokens[i+1])
+			i++
+			continue
+		}
+		joined = append(joined, tokens[i])
+	}
+	return joined
+}
+
+// ParseHelmRange parses a range expression using HelmDialect.
+//
+// Example:
+//
+//	r, err := semver.ParseHelmRange("~1.2.3")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.2.9"))) // Output: true
+func ParseHelmRange(r string) (*VersionRange, error) {
+	return HelmDialect.ParseRange(r)
+}
+
+// ParseHelmVersion parses a version the way Helm does, tolerating a "v" prefix and
+// coercing missing minor and patch components to zero.
+//
+// Example:
+//
+//	v, err := semver.ParseHelmVersion("v1.2")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.0
+func ParseHelmVersion(version string) (Version, error) {
+	return parseCoercedVersion(version)
+}
+
+// HelmCheck reports whether version satisfies constraint using Helm's rules.
+//
+// Example:
+//
+//	ok, err := semver.HelmCheck(">=1.2.3-0", "v1.3.0-beta.1")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(ok) // Output: true
+func HelmCheck(constraint, version string) (bool, error) {
+	r, err := ParseHelmRange(constraint)
+	if err != nil {
+		return false, err
+	}
+	v, err := ParseHelmVersion(version)
+	if err != nil {
+		return false, err
+	}
+	return r.Contains(v), nil
+}
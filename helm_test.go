@@ -0,0 +1,206 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHelmDialectContains(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rangeStr    string
+		version     string
+		shouldMatch bool
+	}{
+		{"^1.2.3", "1.2.3", true},
+		{"^1.2.3", "1.9.9", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^1.2.3", "2.0.0-alpha", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.4", false},
+		{"^0", "0.9.0", true},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~>1", "1.9.0", true},
+		{"~1", "2.0.0", false},
+		{"1.2.x", "1.2.7", true},
+		{"1.2.x", "1.3.0", false},
+		{"1.*", "1.9.0", true},
+		{"1.2", "1.2.5", true},
+		{"*", "3.4.5", true},
+		{">1.2", "1.2.9", false},
+		{">1.2", "1.3.0", true},
+		{"<=1.2", "1.2.9", true},
+		{"<=1.2", "1.3.0", false},
+		{"!=1.2", "1.2.4", false},
+		{"!=1.2", "1.3.0", true},
+		{">= v1.2, < 2", "1.9.0", true},
+		{">= v1.2, < 2", "2.0.0", false},
+		{"=>1.0.0 =<1.5.0", "1.5.0", true},
+		{"1.2 - 1.4.5", "1.4.5", true},
+		{"1.2 - 1.4.5", "1.4.6", false},
+		{"1.2.3 - 2.3", "2.3.9", true},
+		{"1.2.3 - 2.3", "2.4.0", false},
+		{"<1.0.0 || >=2.0.0", "2.1.0", true},
+		{"<1.0.0 || >=2.0.0", "1.5.0", false},
+
+		// Pre-releases are only matched by requirements that opt in.
+		{">=1.2.3", "1.3.0-beta.1", false},
+		{">=1.2.3-0", "1.3.0-beta.1", true},
+		{">0.0.0-0", "0.1.0-rc.1", true},
+		{"^1.2.3-beta", "1.2.3-beta.2", true},
+		{"^1.2.3-beta", "1.5.0-rc.1", true},
+		{"^1.2.3", "1.5.0-rc.1", false},
+		{"1.2.x", "1.2.5-rc.1", false},
+	}
+
+	for _, test := range tests {
+		r, err := HelmDialect.ParseRange(test.rangeStr)
+		is.NoError(err, "Range %s should parse", test.rangeStr)
+		if err != nil {
+			continue
+		}
+		is.Equal(test.shouldMatch, r.Contains(MustParse(test.version)), "Version %s against range %s", test.version, test.rangeStr)
+	}
+}
+
+func TestHelmDialectInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, input := range []string{"", "1.0.0 ||", ">=abc", "1.x.3", "1.x-beta", "1.2.3.4"} {
+		_, err := ParseHelmRange(input)
+		is.ErrorIs(err, ErrInvalidRangeToken, "Expected error for input: %q", input)
+	}
+	is.Equal("helm", HelmDialect.Name())
+}
+
+func TestHelmDialectPartialExclusions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// ">1.2" and "!=1.2" resume at the 1.3.0 release, so they must not opt in to the
+	// pre-releases of 1.3.0 that precede it.
+	tests := []struct {
+		r       string
+		version string
+		want    bool
+	}{
+		{">1.2", "1.2.9", false},
+		{">1.2", "1.3.0-beta", false},
+		{">1.2", "1.3.0", true},
+		{"!=1.2", "1.1.9", true},
+		{"!=1.2", "1.2.5", false},
+		{"!=1.2", "1.3.0-beta", false},
+		{"!=1.2", "1.3.0", true},
+	}
+	for _, tc := range tests {
+		r, err := HelmDialect.ParseRange(tc.r)
+		if is.NoError(err, tc.r) {
+			is.Equal(tc.want, r.Contains(MustParse(tc.version)), "%s %s", tc.r, tc.version)
+		}
+	}
+}
+
+func TestParseHelmVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input       string
+		expected    string
+		shouldError bool
+	}{
+		{"v1.2.3", "1.2.3", false},
+		{"1.2", "1.2.0", false},
+		{"V2", "2.0.0", false},
+		{"1.2.3-rc.1+build", "1.2.3-rc.1+build", false},
+		{"1.2-rc.1", "1.2.0-rc.1", false},
+		{"1+build.5", "1.0.0+build.5", false},
+		{"18446744073709551615.0.0", "18446744073709551615.0.0", false},
+		{"1.2.3-", "", true},
+		{"1.2.3+", "", true},
+		{"1.2.3-01", "", true},
+		{"1.2.3-rc..1", "", true},
+		{"1.2.3+build_1", "", true},
+		{"1.2.x", "", true},
+		{"*", "", true},
+		{"", "", true},
+	}
+
+	for _, test := range tests {
+		v, err := ParseHelmVersion(test.input)
+		if test.shouldError {
+			is.Error(err, "Expected error for input: %s", test.input)
+		} else {
+			is.NoError(err, "Did not expect error for input: %s", test.input)
+			is.Equal(test.expected, v.String())
+		}
+	}
+}
+
+func TestParseHelmVersionAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = ParseHelmVersion("v1.2")
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestHelmCheck(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ok, err := HelmCheck(">=1.2.3-0", "v1.3.0-beta.1")
+	is.NoError(err)
+	is.True(ok)
+
+	_, err = HelmCheck("bad", "1.0.0")
+	is.Error(err)
+
+	_, err = HelmCheck(">=1.0.0", "bad")
+	is.Error(err)
+}
+
+func TestHelmDialectMinimalPrereleaseBounds(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		r       string
+		version string
+		want    bool
+	}{
+		{"<=1.2", "1.2.5-beta", false},
+		{"<=1.2", "1.2.5", true},
+		{"^1.2.3-beta", "1.2.3-beta", true},
+		{"^1.2.3-beta", "1.5.0-alpha", true},
+		{"~1.2", "1.2.5-beta", false},
+		{">=1.2.3-0 <2.0.0-0", "1.9.0-rc.1", true},
+	}
+
+	for _, tc := range tests {
+		r, err := HelmDialect.ParseRange(tc.r)
+		is.NoError(err, tc.r)
+		is.Equal(tc.want, r.Contains(MustParse(tc.version)), "%s %s", tc.r, tc.version)
+
+		ok, err := r.ContainsString(tc.version)
+		is.NoError(err)
+		is.Equal(tc.want, ok, "%s %s", tc.r, tc.version)
+		is.Equal(tc.want, r.Explain(MustParse(tc.version)).Matched, "%s %s", tc.r, tc.version)
+	}
+
+	r, err := HelmDialect.ParseRange("<=1.2")
+	is.NoError(err)
+	e := r.Explain(MustParse("1.2.5-beta"))
+	is.Len(e.Rejections, 1)
+	is.Equal(RejectedPrereleaseOptIn, e.Rejections[0].Reason)
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// UpgradePolicy controls which version changes a VersionHolder accepts.
+//
+// Supported Policies:
+//   - UpgradeMonotonic: The new version must have strictly higher precedence.
+//   - UpgradeNonDecreasing: The new version must not have lower precedence.
+//   - UpgradeAny: Every change is accepted.
+type UpgradePolicy int
+
+const (
+	UpgradeMonotonic UpgradePolicy = iota
+	UpgradeNonDecreasing
+	UpgradeAny
+)
+
+// Allows reports whether changing from current to next is permitted by the policy.
+//
+// Example:
+//
+//	fmt.Println(semver.UpgradeMonotonic.Allows(semver.MustParse("1.2.0"), semver.MustParse("1.1.0"))) // Output: false
+func (p UpgradePolicy) Allows(current, next Version) bool {
+	switch p {
+	case UpgradeAny:
+		return true
+	case UpgradeNonDecreasing:
+		return next.GreaterThanOrEqual(current)
+	default:
+		return next.GreaterThan(current)
+	}
+}
+
+// VersionHolder holds a "current version" that can be read and replaced concurrently,
+// such as the schema or protocol version of a long-running service.
+//
+// Changes are checked against the holder's UpgradePolicy, so concurrent writers cannot move
+// the version backwards. The holder stores private copies of the versions it is given, so
+// callers may keep modifying their own values, but the versions returned by Load share their
+// slices and must be treated as read-only. The zero value holds the zero Version and uses UpgradeMonotonic.
+//
+// Example:
+//
+//	h := semver.NewVersionHolder(semver.MustParse("1.0.0"), semver.UpgradeMonotonic)
+//	if err := h.Store(semver.MustParse("1.1.0")); err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(h.Load()) // Output: 1.1.0
+//
+//	err := h.Store(semver.MustParse("1.0.5"))
+//	fmt.Println(errors.Is(err, semver.ErrVersionDowngrade)) // Output: true
+type VersionHolder struct {
+	current atomic.Pointer[Version]
+	policy  UpgradePolicy
+}
+
+// NewVersionHolder creates a VersionHolder with an initial version and an upgrade policy.
+func NewVersionHolder(initial Version, policy UpgradePolicy) *VersionHolder {
+	h := &VersionHolder{policy: policy}
+	initial = initial.Clone()
+	h.current.Store(&initial)
+	return h
+}
+
+// Policy returns the holder's upgrade policy.
+func (h *VersionHolder) Policy() UpgradePolicy {
+	return h.policy
+}
+
+// Load returns the current version.
+func (h *VersionHolder) Load() Version {
+	if p := h.current.Load(); p != nil {
+		return *p
+	}
+	return Version{}
+}
+
+// Store replaces the current version with v if the upgrade policy allows it.
+//
+// Returns ErrVersionDowngrade, leaving the current version unchanged, if it does not.
+func (h *VersionHolder) Store(v Version) error {
+	v = v.Clone()
+	next := &v
+	for {
+		p := h.current.Load()
+		current := Version{}
+		if p != nil {
+			current = *p
+		}
+		if !h.policy.Allows(current, v) {
+			return fmt.Errorf("%w: %s to %s", ErrVersionDowngrade, current, v)
+		}
+		if h.current.CompareAndSwap(p, next) {
+			return nil
+		}
+	}
+}
+
+// CompareAndSwap replaces the current version with next only if it is identical to old,
+// as reported by StrictEqual, and the upgrade policy allows the change.
+//
+// Returns false with a nil error if the current version is not old, and false with
+// ErrVersionDowngrade if the policy rejects the change.
+//
+// Example:
+//
+//	h := semver.NewVersionHolder(semver.MustParse("1.0.0"), semver.UpgradeMonotonic)
+//	swapped, err := h.CompareAndSwap(semver.MustParse("1.0.0"), semver.MustParse("2.0.0"))
+//	fmt.Println(swapped, err) // Output: true <nil>
+func (h *VersionHolder) CompareAndSwap(old, next Version) (bool, error) {
+	p := h.current.Load()
+	current := Version{}
+	if p != nil {
+		current = *p
+	}
+
+	if !current.StrictEqual(old) {
+		return false, nil
+	}
+	if !h.policy.Allows(current, next) {
+		return false, fmt.Errorf("%w: %s to %s", ErrVersionDowngrade, current, next)
+	}
+	next = next.Clone()
+	return h.current.CompareAndSwap(p, &next), nil
+}
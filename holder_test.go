@@ -0,0 +1,79 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradePolicyAllows(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v1, v2 := MustParse("1.0.0"), MustParse("2.0.0")
+
+	is.True(UpgradeMonotonic.Allows(v1, v2))
+	is.False(UpgradeMonotonic.Allows(v1, v1))
+	is.False(UpgradeMonotonic.Allows(v2, v1))
+	is.True(UpgradeNonDecreasing.Allows(v1, v1))
+	is.False(UpgradeNonDecreasing.Allows(v2, v1))
+	is.True(UpgradeAny.Allows(v2, v1))
+}
+
+func TestVersionHolder(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var zero VersionHolder
+	is.Equal(Version{}, zero.Load())
+	is.Equal(UpgradeMonotonic, zero.Policy())
+	is.NoError(zero.Store(MustParse("0.1.0")))
+	is.Equal("0.1.0", zero.Load().String())
+
+	h := NewVersionHolder(MustParse("1.0.0"), UpgradeMonotonic)
+	is.NoError(h.Store(MustParse("1.1.0")))
+	is.ErrorIs(h.Store(MustParse("1.0.5")), ErrVersionDowngrade)
+	is.ErrorIs(h.Store(MustParse("1.1.0")), ErrVersionDowngrade)
+	is.Equal("1.1.0", h.Load().String())
+
+	swapped, err := h.CompareAndSwap(MustParse("1.1.0+build"), MustParse("2.0.0"))
+	is.NoError(err)
+	is.False(swapped, "Build metadata must match for CompareAndSwap")
+
+	swapped, err = h.CompareAndSwap(MustParse("1.1.0"), MustParse("1.0.0"))
+	is.ErrorIs(err, ErrVersionDowngrade)
+	is.False(swapped)
+
+	swapped, err = h.CompareAndSwap(MustParse("1.1.0"), MustParse("2.0.0"))
+	is.NoError(err)
+	is.True(swapped)
+	is.Equal("2.0.0", h.Load().String())
+
+	permissive := NewVersionHolder(MustParse("2.0.0"), UpgradeAny)
+	is.NoError(permissive.Store(MustParse("1.0.0")))
+}
+
+func TestVersionHolderConcurrentStore(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	h := NewVersionHolder(MustParse("0.0.0"), UpgradeMonotonic)
+
+	var wg sync.WaitGroup
+	for i := uint64(1); i <= 100; i++ {
+		wg.Add(1)
+		go func(patch uint64) {
+			defer wg.Done()
+			_ = h.Store(Version{Patch: patch})
+		}(i)
+	}
+	wg.Wait()
+
+	is.Equal("0.0.100", h.Load().String())
+}
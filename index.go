@@ -0,0 +1,230 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"slices"
+	"sort"
+	"sync"
+)
+
+// VersionIndex is a sorted index of versions that answers range queries without scanning
+// every version, for registries that hold very many versions of a package.
+//
+// A range is first reduced to its intervals, which are located by binary search; only the
+// versions inside them are checked against the range itself, so that OpNeq requirements and
+// the range's PrereleasePolicy are honoured. MaxSatisfying and MinSatisfying therefore take
+// O(log n) time unless many versions near the result are rejected by those rules.
+//
+// Versions marked with Retract are skipped by range queries unless the range requests them
+// explicitly with an exact requirement, as Go does for retracted module versions.
+//
+// A VersionIndex is safe for concurrent use.
+//
+// Example:
+//
+//	idx := semver.NewVersionIndex(versions)
+//	idx.Insert(semver.MustParse("1.4.8"))
+//
+//	v, ok := idx.MaxSatisfying(semver.MustParseRange("^1.4.0"))
+//	fmt.Println(v, ok) // Output: 1.4.8 true
+type VersionIndex struct {
+	mu        sync.RWMutex
+	versions  []Version
+	retracted Retractions
+}
+
+// NewVersionIndex creates a VersionIndex holding copies of the given versions.
+// Nil entries are ignored.
+//
+// Example:
+//
+//	a, b := semver.MustParse("1.0.0"), semver.MustParse("2.0.0")
+//	idx := semver.NewVersionIndex(semver.Versions{&a, &b})
+//	fmt.Println(idx.Len()) // Output: 2
+func NewVersionIndex(versions Versions) *VersionIndex {
+	idx := &VersionIndex{versions: make([]Version, 0, len(versions))}
+	for _, v := range versions {
+		if v != nil {
+			idx.versions = append(idx.versions, v.Clone())
+		}
+	}
+	slices.SortStableFunc(idx.versions, Version.Compare)
+	return idx
+}
+
+// Insert adds a copy of v to the index, after any versions of equal precedence.
+//
+// Example:
+//
+//	idx := semver.NewVersionIndex(nil)
+//	idx.Insert(semver.MustParse("1.2.3"))
+//	fmt.Println(idx.Contains(semver.MustParse("1.2.3"))) // Output: true
+func (idx *VersionIndex) Insert(v Version) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	i := sort.Search(len(idx.versions), func(i int) bool {
+		return idx.versions[i].Compare(v) > 0
+	})
+	idx.versions = slices.Insert(idx.versions, i, v.Clone())
+}
+
+// Retract marks versions as retracted. Retracted versions stay in the index, but range queries
+// skip them unless the range requests them explicitly with an exact requirement.
+//
+// Example:
+//
+//	idx.Retract(semver.RetractVersion(semver.MustParse("1.4.8"), "Published by mistake."))
+//	v, _ := idx.MaxSatisfying(semver.MustParseRange("^1.4.0"))
+//	fmt.Println(v) // Output: 1.4.7
+//
+//	v, _ = idx.MaxSatisfying(semver.MustParseRange("=1.4.8"))
+//	fmt.Println(v) // Output: 1.4.8
+func (idx *VersionIndex) Retract(rs ...Retraction) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.retracted = append(idx.retracted, rs...)
+}
+
+// Retractions returns a copy of the retractions marked with Retract.
+func (idx *VersionIndex) Retractions() Retractions {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return append(Retractions(nil), idx.retracted...)
+}
+
+// Len returns the number of versions in the index.
+func (idx *VersionIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return len(idx.versions)
+}
+
+// Versions returns copies of the indexed versions in increasing order.
+func (idx *VersionIndex) Versions() []Version {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	out := make([]Version, len(idx.versions))
+	for i, v := range idx.versions {
+		out[i] = v.Clone()
+	}
+	return out
+}
+
+// Contains reports whether the index holds a version with the same precedence as v.
+//
+// Example:
+//
+//	a := semver.MustParse("1.2.3+build.1")
+//	idx := semver.NewVersionIndex(semver.Versions{&a})
+//	fmt.Println(idx.Contains(semver.MustParse("1.2.3"))) // Output: true
+func (idx *VersionIndex) Contains(v Version) bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	_, found := slices.BinarySearchFunc(idx.versions, v, Version.Compare)
+	return found
+}
+
+// MaxSatisfying returns the highest indexed version that satisfies the range and is not
+// retracted. The boolean result is false if no version does.
+//
+// Example:
+//
+//	a, b, c := semver.MustParse("1.2.0"), semver.MustParse("1.9.0"), semver.MustParse("2.0.0")
+//	idx := semver.NewVersionIndex(semver.Versions{&a, &b, &c})
+//	v, ok := idx.MaxSatisfying(semver.MustParseRange("<2.0.0"))
+//	fmt.Println(v, ok) // Output: 1.9.0 true
+func (idx *VersionIndex) MaxSatisfying(r *VersionRange) (Version, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	ivs := r.Intervals()
+	for i := len(ivs) - 1; i >= 0; i-- {
+		lo, hi := ivs[i].span(idx.versions)
+		for j := hi - 1; j >= lo; j-- {
+			if r.Contains(idx.versions[j]) && idx.retracted.allows(r, idx.versions[j]) {
+				return idx.versions[j].Clone(), true
+			}
+		}
+	}
+	return Version{}, false
+}
+
+// MinSatisfying returns the lowest indexed version that satisfies the range and is not
+// retracted. The boolean result is false if no version does.
+//
+// Example:
+//
+//	a, b, c := semver.MustParse("1.2.0"), semver.MustParse("1.9.0"), semver.MustParse("2.0.0")
+//	idx := semver.NewVersionIndex(semver.Versions{&a, &b, &c})
+//	v, ok := idx.MinSatisfying(semver.MustParseRange(">1.2.0"))
+//	fmt.Println(v, ok) // Output: 1.9.0 true
+func (idx *VersionIndex) MinSatisfying(r *VersionRange) (Version, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	for _, iv := range r.Intervals() {
+		lo, hi := iv.span(idx.versions)
+		for j := lo; j < hi; j++ {
+			if r.Contains(idx.versions[j]) && idx.retracted.allows(r, idx.versions[j]) {
+				return idx.versions[j].Clone(), true
+			}
+		}
+	}
+	return Version{}, false
+}
+
+// Satisfying returns copies of every indexed version that satisfies the range and is not
+// retracted, in increasing order.
+//
+// Example:
+//
+//	a, b, c := semver.MustParse("1.2.0"), semver.MustParse("1.9.0"), semver.MustParse("2.0.0")
+//	idx := semver.NewVersionIndex(semver.Versions{&a, &b, &c})
+//	fmt.Println(idx.Satisfying(semver.MustParseRange("^1.0.0"))) // Output: [1.2.0 1.9.0]
+func (idx *VersionIndex) Satisfying(r *VersionRange) []Version {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var out []Version
+	for _, iv := range r.Intervals() {
+		lo, hi := iv.span(idx.versions)
+		for _, v := range idx.versions[lo:hi] {
+			if r.Contains(v) && idx.retracted.allows(r, v) {
+				out = append(out, v.Clone())
+			}
+		}
+	}
+	return out
+}
+
+// span returns the half-open range of indices of the sorted versions that lie within the
+// interval.
+func (iv Interval) span(versions []Version) (int, int) {
+	lo := 0
+	if !iv.Lower.Unbounded {
+		lo = sort.Search(len(versions), func(i int) bool {
+			c := versions[i].Compare(iv.Lower.Version)
+			return c > 0 || (c == 0 && iv.Lower.Inclusive)
+		})
+	}
+
+	hi := len(versions)
+	if !iv.Upper.Unbounded {
+		hi = sort.Search(len(versions), func(i int) bool {
+			c := versions[i].Compare(iv.Upper.Version)
+			return c > 0 || (c == 0 && !iv.Upper.Inclusive)
+		})
+	}
+
+	return lo, max(lo, hi)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionIndex(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var versions Versions
+	for _, s := range []string{"2.0.0", "1.4.7", "1.3.9", "1.5.0-rc.1", "1.4.6", "1.4.0+build.1", "3.1.0"} {
+		v := MustParse(s)
+		versions = append(versions, &v)
+	}
+	versions = append(versions, nil)
+
+	idx := NewVersionIndex(versions)
+	is.Equal(7, idx.Len())
+	is.True(idx.Contains(MustParse("1.4.0")))
+	is.False(idx.Contains(MustParse("1.4.1")))
+
+	idx.Insert(MustParse("1.4.8"))
+	idx.Insert(MustParse("0.9.0"))
+	is.Equal(9, idx.Len())
+	is.Equal("[0.9.0 1.3.9 1.4.0+build.1 1.4.6 1.4.7 1.4.8 1.5.0-rc.1 2.0.0 3.1.0]", fmt.Sprint(idx.Versions()))
+
+	tests := []struct {
+		r       string
+		max     string
+		min     string
+		matches string
+	}{
+		{"^1.4.0", "1.5.0-rc.1", "1.4.0+build.1", "[1.4.0+build.1 1.4.6 1.4.7 1.4.8 1.5.0-rc.1]"},
+		{"^1.4.0 !=1.5.0-rc.1 !=1.4.8", "1.4.7", "1.4.0+build.1", "[1.4.0+build.1 1.4.6 1.4.7]"},
+		{"<1.4.0 || >=3.0.0", "3.1.0", "0.9.0", "[0.9.0 1.3.9 3.1.0]"},
+		{">2.0.0 <=3.1.0", "3.1.0", "3.1.0", "[3.1.0]"},
+		{"1.4.6", "1.4.6", "1.4.6", "[1.4.6]"},
+		{">=4.0.0", "", "", "[]"},
+	}
+
+	for _, test := range tests {
+		r := MustParseRange(test.r)
+
+		v, ok := idx.MaxSatisfying(r)
+		is.Equal(test.max != "", ok, "Range %s", test.r)
+		if ok {
+			is.Equal(test.max, v.String(), "Range %s", test.r)
+		}
+
+		v, ok = idx.MinSatisfying(r)
+		is.Equal(test.min != "", ok, "Range %s", test.r)
+		if ok {
+			is.Equal(test.min, v.String(), "Range %s", test.r)
+		}
+
+		is.Equal(test.matches, fmt.Sprint(idx.Satisfying(r)), "Range %s", test.r)
+	}
+
+	excluded := MustParseRange("^1.4.0")
+	excluded.Prerelease = PrereleaseExcluded
+	v, ok := idx.MaxSatisfying(excluded)
+	is.True(ok)
+	is.Equal("1.4.8", v.String())
+}
+
+func TestVersionIndexMatchesLinearScan(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	rnd := rand.New(rand.NewSource(1))
+	idx := NewVersionIndex(nil)
+	var all []Version
+	for i := 0; i < 500; i++ {
+		v := Version{Major: uint64(rnd.Intn(4)), Minor: uint64(rnd.Intn(5)), Patch: uint64(rnd.Intn(5))}
+		if rnd.Intn(4) == 0 {
+			v.PreRelease = []PrereleaseVersion{{partString: "rc"}, {isNumeric: true, partNumeric: uint64(rnd.Intn(3))}}
+		}
+		idx.Insert(v)
+		all = append(all, v)
+	}
+
+	for _, s := range []string{"^1.2.0", "~2.3.1 || <0.1.0", ">1.0.0-rc.1 <1.0.0", "!=2.0.0 >=1.9.9 <3.0.0-0", "3.4.4"} {
+		r := MustParseRange(s)
+
+		var expected []Version
+		for _, v := range idx.Versions() {
+			if r.Contains(v) {
+				expected = append(expected, v)
+			}
+		}
+		is.Equal(fmt.Sprint(expected), fmt.Sprint(idx.Satisfying(r)), "Range %s", s)
+
+		v, ok := idx.MaxSatisfying(r)
+		is.Equal(len(expected) > 0, ok, "Range %s", s)
+		if ok {
+			is.Equal(0, v.Compare(expected[len(expected)-1]), "Range %s", s)
+		}
+	}
+	is.Len(all, idx.Len())
+}
+
+func TestVersionIndexConcurrency(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	idx := NewVersionIndex(nil)
+	r := MustParseRange(">=0.0.0")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				idx.Insert(Version{Major: uint64(i), Minor: uint64(j)})
+				_, _ = idx.MaxSatisfying(r)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	is.Equal(400, idx.Len())
+	v, ok := idx.MaxSatisfying(r)
+	is.True(ok)
+	is.Equal("7.49.0", v.String())
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"sort"
+	"strings"
+)
+
+// Bound is one end of an Interval of versions.
+//
+// An unbounded Bound has no limit in its direction and ignores Version and Inclusive. Open
+// ranges such as "*" and ">=1.2.0" are modelled with unbounded bounds rather than sentinel
+// versions, so that no version, including one with an Epoch, lies beyond them.
+type Bound struct {
+	Version   Version
+	Inclusive bool
+	Unbounded bool
+}
+
+// Interval is a contiguous set of versions between a Lower and an Upper Bound.
+//
+// Example:
+//
+//	ivs := semver.MustParseRange(">=1.2.0").Intervals()
+//	fmt.Println(ivs[0].Upper.Unbounded) // Output: true
+type Interval struct {
+	Lower Bound
+	Upper Bound
+}
+
+// String returns the bound's version, or "*" for an unbounded bound.
+func (b Bound) String() string {
+	if b.Unbounded {
+		return "*"
+	}
+	return b.Version.String()
+}
+
+// String returns the requirements matching exactly the versions in the interval, such as
+// ">=1.2.0 <2.0.0-0".
+func (iv Interval) String() string {
+	reqs := iv.requirements()
+	parts := make([]string, len(reqs))
+	for i, req := range reqs {
+		parts[i] = req.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+// compareLower orders two lower bounds by where they start.
+func compareLower(a, b Bound) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return -1
+	case b.Unbounded:
+		return 1
+	}
+	if c := a.Version.Compare(b.Version); c != 0 {
+		return c
+	}
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareUpper orders two upper bounds by where they end.
+func compareUpper(a, b Bound) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return 1
+	case b.Unbounded:
+		return -1
+	}
+	if c := a.Version.Compare(b.Version); c != 0 {
+		return c
+	}
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// IsEmpty reports whether no version lies within the interval.
+func (iv Interval) IsEmpty() bool {
+	if iv.Lower.Unbounded || iv.Upper.Unbounded {
+		return false
+	}
+	c := iv.Lower.Version.Compare(iv.Upper.Version)
+	return c > 0 || (c == 0 && !(iv.Lower.Inclusive && iv.Upper.Inclusive))
+}
+
+// includes reports whether other lies entirely within the interval.
+func (iv Interval) includes(other Interval) bool {
+	return compareLower(iv.Lower, other.Lower) <= 0 && compareUpper(other.Upper, iv.Upper) <= 0
+}
+
+// Contains reports whether v lies within the interval.
+func (iv Interval) Contains(v Version) bool {
+	if !iv.Lower.Unbounded {
+		if c := v.Compare(iv.Lower.Version); c < 0 || (c == 0 && !iv.Lower.Inclusive) {
+			return false
+		}
+	}
+	if !iv.Upper.Unbounded {
+		if c := v.Compare(iv.Upper.Version); c > 0 || (c == 0 && !iv.Upper.Inclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// unboundedInterval returns the interval containing every version.
+func unboundedInterval() Interval {
+	return Interval{Lower: Bound{Unbounded: true}, Upper: Bound{Unbounded: true}}
+}
+
+// requirementInterval returns the interval matched by a single requirement. The boolean is
+// false for requirements that do not describe an interval, such as OpNeq.
+func requirementInterval(req Requirement) (Interval, bool) {
+	iv := unboundedInterval()
+	switch req.Op {
+	case OpEq:
+		iv.Lower = Bound{Version: req.Ver, Inclusive: true}
+		iv.Upper = Bound{Version: req.Ver, Inclusive: true}
+	case OpGt:
+		iv.Lower = Bound{Version: req.Ver}
+	case OpGte:
+		// Nothing precedes 0.0.0-0, so a range starting there has no lower limit.
+		if !req.Ver.StrictEqual(minimalPrerelease(0, 0, 0)) {
+			iv.Lower = Bound{Version: req.Ver, Inclusive: true}
+		}
+	case OpLt:
+		iv.Upper = Bound{Version: req.Ver}
+	case OpLte:
+		iv.Upper = Bound{Version: req.Ver, Inclusive: true}
+	case OpCaret, OpTilde:
+		lower, upper := req.shorthandBounds()
+		iv.Lower = Bound{Version: lower, Inclusive: true}
+		iv.Upper = Bound{Version: upper}
+	default:
+		return iv, false
+	}
+	return iv, true
+}
+
+// intersect returns the intersection of two intervals, which may be empty.
+func (iv Interval) intersect(other Interval) Interval {
+	if compareLower(other.Lower, iv.Lower) > 0 {
+		iv.Lower = other.Lower
+	}
+	if compareUpper(other.Upper, iv.Upper) < 0 {
+		iv.Upper = other.Upper
+	}
+	return iv
+}
+
+// Intervals returns the sorted, disjoint intervals covered by the range. A range without
+// lower or upper limits, such as "*" or ">=1.2.0", yields intervals with unbounded bounds.
+//
+// The result over-approximates ranges that use OpNeq, whose excluded versions are treated as
+// included, and ignores the range's PrereleasePolicy.
+//
+// Example:
+//
+//	for _, iv := range semver.MustParseRange("<1.0.0 || >=2.0.0").Intervals() {
+//	    fmt.Println(iv)
+//	}
+//	// Output:
+//	// <1.0.0
+//	// >=2.0.0
+func (vr *VersionRange) Intervals() []Interval {
+	var ivs []Interval
+	for _, andReqs := range vr.Requirements {
+		iv := unboundedInterval()
+		for _, req := range andReqs {
+			if reqIv, ok := requirementInterval(req); ok {
+				iv = iv.intersect(reqIv)
+			}
+		}
+		if !iv.IsEmpty() {
+			ivs = append(ivs, iv)
+		}
+	}
+	return mergeIntervals(ivs)
+}
+
+// mergeIntervals sorts intervals and merges those that overlap or touch.
+func mergeIntervals(ivs []Interval) []Interval {
+	if len(ivs) < 2 {
+		return ivs
+	}
+
+	sorted := append([]Interval(nil), ivs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return compareLower(sorted[i].Lower, sorted[j].Lower) < 0
+	})
+
+	merged := []Interval{sorted[0]}
+	for _, next := range sorted[1:] {
+		cur := &merged[len(merged)-1]
+		if !touches(*cur, next) {
+			merged = append(merged, next)
+			continue
+		}
+		if compareUpper(next.Upper, cur.Upper) > 0 {
+			cur.Upper = next.Upper
+		}
+	}
+	return merged
+}
+
+// touches reports whether next, which starts no earlier than cur, overlaps or abuts cur.
+func touches(cur, next Interval) bool {
+	if cur.Upper.Unbounded || next.Lower.Unbounded {
+		return true
+	}
+	c := next.Lower.Version.Compare(cur.Upper.Version)
+	return c < 0 || (c == 0 && (next.Lower.Inclusive || cur.Upper.Inclusive))
+}
+
+// intervalsContain reports whether every interval of inner lies within some interval of outer.
+// Both must be merged.
+func intervalsContain(outer, inner []Interval) bool {
+	for _, in := range inner {
+		found := false
+		for _, out := range outer {
+			if out.includes(in) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// intersectIntervals returns the merged intersection of two sets of merged intervals.
+func intersectIntervals(a, b []Interval) []Interval {
+	var ivs []Interval
+	for _, x := range a {
+		for _, y := range b {
+			if iv := x.intersect(y); !iv.IsEmpty() {
+				ivs = append(ivs, iv)
+			}
+		}
+	}
+	return mergeIntervals(ivs)
+}
+
+// requirements returns the requirements matching exactly the versions in the interval.
+func (iv Interval) requirements() []Requirement {
+	if !iv.Lower.Unbounded && !iv.Upper.Unbounded && iv.Lower.Inclusive && iv.Upper.Inclusive &&
+		iv.Lower.Version.Compare(iv.Upper.Version) == 0 {
+		return []Requirement{{Op: OpEq, Ver: iv.Lower.Version}}
+	}
+
+	var reqs []Requirement
+	switch {
+	case iv.Lower.Unbounded:
+	case iv.Lower.Inclusive:
+		reqs = append(reqs, Requirement{Op: OpGte, Ver: iv.Lower.Version})
+	default:
+		reqs = append(reqs, Requirement{Op: OpGt, Ver: iv.Lower.Version})
+	}
+	switch {
+	case iv.Upper.Unbounded:
+	case iv.Upper.Inclusive:
+		reqs = append(reqs, Requirement{Op: OpLte, Ver: iv.Upper.Version})
+	default:
+		reqs = append(reqs, Requirement{Op: OpLt, Ver: iv.Upper.Version})
+	}
+
+	if len(reqs) == 0 {
+		reqs = append(reqs, Requirement{Op: OpGte, Ver: minimalPrerelease(0, 0, 0)})
+	}
+	return reqs
+}
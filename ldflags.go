@@ -0,0 +1,67 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// LDFlagsVersion holds a version injected at link time. It is empty unless set with -ldflags:
+//
+//	go build -ldflags "-X github.com/sixafter/semver.LDFlagsVersion=v1.2.3"
+//
+// Binaries that already inject their version into a variable of their own can pass that
+// variable to FromLDFlags or MustFromLDFlags instead.
+var LDFlagsVersion string
+
+// FromLDFlags parses a version injected at link time with -ldflags "-X ...".
+//
+// Surrounding whitespace and a leading "v" are ignored, so output from "git describe --tags"
+// can be injected directly. If raw is empty, the version is read from the binary's build
+// information with FromBuildInfo instead.
+//
+// Example:
+//
+//	// Set with: go build -ldflags "-X main.version=v1.2.3"
+//	var version string
+//
+//	func main() {
+//	    v, err := semver.FromLDFlags(version)
+//	    if err != nil {
+//	        log.Fatalf("invalid version: %v", err)
+//	    }
+//	    fmt.Println(v) // Output: 1.2.3
+//	}
+func FromLDFlags(raw string) (Version, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return FromBuildInfo()
+	}
+	return Parse(strings.TrimPrefix(raw, "v"))
+}
+
+// MustFromLDFlags is like FromLDFlags but is intended to initialize package variables at startup.
+//
+// It panics if raw is set but is not a valid version, so a bad release build fails immediately.
+// If raw is empty and no version is available from build information, as with "go run" or a
+// development build, it returns the zero Version (0.0.0) instead of panicking.
+//
+// Example:
+//
+//	// Set with: go build -ldflags "-X main.version=v1.2.3"
+//	var version string
+//
+//	var Version = semver.MustFromLDFlags(version)
+func MustFromLDFlags(raw string) Version {
+	v, err := FromLDFlags(raw)
+	if err != nil {
+		if strings.TrimSpace(raw) == "" {
+			return Version{}
+		}
+		panic(`semver: MustFromLDFlags(` + raw + `): ` + err.Error())
+	}
+	return v
+}
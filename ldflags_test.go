@@ -0,0 +1,51 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromLDFlags(t *testing.T) {
+	is := assert.New(t)
+
+	original := readBuildInfo
+	defer func() { readBuildInfo = original }()
+
+	v, err := FromLDFlags(" v1.2.3-4-gabcdef0 \n")
+	is.NoError(err)
+	is.Equal("1.2.3-4-gabcdef0", v.String())
+
+	_, err = FromLDFlags("not-a-version")
+	is.Error(err)
+
+	readBuildInfo = func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Main: debug.Module{Version: "v2.0.0"}}, true
+	}
+	v, err = FromLDFlags("")
+	is.NoError(err)
+	is.Equal("2.0.0", v.String())
+}
+
+func TestMustFromLDFlags(t *testing.T) {
+	is := assert.New(t)
+
+	original := readBuildInfo
+	defer func() { readBuildInfo = original }()
+
+	is.Equal("1.2.3", MustFromLDFlags("1.2.3").String())
+
+	readBuildInfo = func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Main: debug.Module{Version: "(devel)"}}, true
+	}
+	is.Equal(Version{}, MustFromLDFlags(""))
+	is.Equal(Version{}, MustFromLDFlags(LDFlagsVersion))
+
+	is.Panics(func() { MustFromLDFlags("1.2") })
+}
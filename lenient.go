@@ -0,0 +1,186 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// CorrectionKind identifies the kind of change ParseLenient made to its input.
+//
+// Supported Kinds:
+//   - CorrectionTrimmedSpace: Leading or trailing whitespace was removed.
+//   - CorrectionStrippedPrefix: A "v", "V", or "=" prefix was removed.
+//   - CorrectionAddedComponent: A missing minor or patch component was added as zero.
+//   - CorrectionRemovedLeadingZeros: Leading zeros were removed from a numeric identifier.
+type CorrectionKind int
+
+const (
+	CorrectionTrimmedSpace CorrectionKind = iota
+	CorrectionStrippedPrefix
+	CorrectionAddedComponent
+	CorrectionRemovedLeadingZeros
+)
+
+// String returns the string representation of the CorrectionKind.
+//
+// Example:
+//
+//	fmt.Println(semver.CorrectionAddedComponent.String()) // Output: added component
+func (k CorrectionKind) String() string {
+	switch k {
+	case CorrectionTrimmedSpace:
+		return "trimmed space"
+	case CorrectionStrippedPrefix:
+		return "stripped prefix"
+	case CorrectionAddedComponent:
+		return "added component"
+	case CorrectionRemovedLeadingZeros:
+		return "removed leading zeros"
+	default:
+		return "unknown"
+	}
+}
+
+// Correction describes one change ParseLenient made to its input to obtain a valid version.
+//
+// Offset is the byte offset in the original input at which Original was replaced by
+// Replacement. Original is empty for insertions and Replacement is empty for removals.
+type Correction struct {
+	Kind        CorrectionKind
+	Offset      int
+	Original    string
+	Replacement string
+}
+
+// String returns a human-readable description of the correction.
+//
+// Example:
+//
+//	_, corrections, _ := semver.ParseLenient("v1.02")
+//	for _, c := range corrections {
+//	    fmt.Println(c)
+//	}
+//
+// Output:
+// stripped prefix at offset 0: "v" -> ""
+// removed leading zeros at offset 3: "02" -> "2"
+// added component at offset 5: "" -> ".0"
+func (c Correction) String() string {
+	return fmt.Sprintf("%s at offset %d: %q -> %q", c.Kind, c.Offset, c.Original, c.Replacement)
+}
+
+// ParseLenient parses a version that may not strictly follow the Semantic Versioning
+// specification, and reports every correction it applied so that linters can fix manifests
+// and tell users exactly what changed.
+//
+// The following deviations are corrected:
+//   - Leading and trailing whitespace.
+//   - A single "v", "V", or "=" prefix.
+//   - Missing minor and patch components, which become zero.
+//   - Leading zeros in the major, minor, and patch numbers and in numeric pre-release identifiers.
+//
+// The corrected string must then be a valid version, or an error is returned as Parse would.
+// Corrections are reported in the order of their offsets; a strictly valid version yields none.
+// The returned Version's String is the corrected form of the input.
+//
+// Example:
+//
+//	v, corrections, err := semver.ParseLenient(" v1.2-rc.01 ")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v, len(corrections)) // Output: 1.2.0-rc.1 5
+func ParseLenient(s string) (Version, []Correction, error) {
+	return parseLenient(s, Parse)
+}
+
+// parseLenient corrects s as described by ParseLenient and parses the result with parse.
+func parseLenient(s string, parse func(string) (Version, error)) (Version, []Correction, error) {
+	var corrections []Correction
+
+	start := len(s) - len(strings.TrimLeftFunc(s, unicode.IsSpace))
+	end := len(strings.TrimRightFunc(s, unicode.IsSpace))
+	if start >= end {
+		return Version{}, nil, ErrEmptyVersionString
+	}
+	if start > 0 {
+		corrections = append(corrections, Correction{Kind: CorrectionTrimmedSpace, Original: s[:start]})
+	}
+
+	i := start
+	if strings.IndexByte("vV=", s[i]) >= 0 {
+		corrections = append(corrections, Correction{Kind: CorrectionStrippedPrefix, Offset: i, Original: s[i : i+1]})
+		i++
+	}
+
+	coreEnd := end
+	if j := strings.IndexAny(s[i:end], "-+"); j >= 0 {
+		coreEnd = i + j
+	}
+	preEnd := coreEnd
+	if coreEnd < end && s[coreEnd] == '-' {
+		preEnd = end
+		if j := strings.IndexByte(s[coreEnd:end], '+'); j >= 0 {
+			preEnd = coreEnd + j
+		}
+	}
+
+	var sb strings.Builder
+	sb.Grow(end - start + 4)
+
+	components := strings.Split(s[i:coreEnd], ".")
+	corrections = appendTrimmedIdentifiers(&sb, corrections, components, i)
+	for n := len(components); n < 3; n++ {
+		sb.WriteString(".0")
+		corrections = append(corrections, Correction{Kind: CorrectionAddedComponent, Offset: coreEnd, Replacement: ".0"})
+	}
+
+	if preEnd > coreEnd {
+		sb.WriteByte('-')
+		corrections = appendTrimmedIdentifiers(&sb, corrections, strings.Split(s[coreEnd+1:preEnd], "."), coreEnd+1)
+	}
+	sb.WriteString(s[preEnd:end])
+
+	if end < len(s) {
+		corrections = append(corrections, Correction{Kind: CorrectionTrimmedSpace, Offset: end, Original: s[end:]})
+	}
+
+	v, err := parse(sb.String())
+	if err != nil {
+		return Version{}, nil, err
+	}
+	return v, corrections, nil
+}
+
+// appendTrimmedIdentifiers writes the dot-separated identifiers, which start at offset in the
+// original input, to sb with leading zeros removed from numeric ones, and returns corrections
+// extended with a correction for each identifier it changed.
+func appendTrimmedIdentifiers(sb *strings.Builder, corrections []Correction, identifiers []string, offset int) []Correction {
+	for k, id := range identifiers {
+		if k > 0 {
+			sb.WriteByte('.')
+		}
+		trimmed := id
+		if len(id) > 1 && id[0] == '0' && isNumeric(id) {
+			trimmed = strings.TrimLeft(id, "0")
+			if trimmed == "" {
+				trimmed = "0"
+			}
+			corrections = append(corrections, Correction{
+				Kind:        CorrectionRemovedLeadingZeros,
+				Offset:      offset,
+				Original:    id,
+				Replacement: trimmed,
+			})
+		}
+		sb.WriteString(trimmed)
+		offset += len(id) + 1
+	}
+	return corrections
+}
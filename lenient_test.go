@@ -0,0 +1,89 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLenient(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input       string
+		expected    string
+		corrections []Correction
+	}{
+		{"1.2.3-rc.1+build.007", "1.2.3-rc.1+build.007", nil},
+		{"v1.2.3", "1.2.3", []Correction{
+			{Kind: CorrectionStrippedPrefix, Offset: 0, Original: "v"},
+		}},
+		{"=1", "1.0.0", []Correction{
+			{Kind: CorrectionStrippedPrefix, Offset: 0, Original: "="},
+			{Kind: CorrectionAddedComponent, Offset: 2, Replacement: ".0"},
+			{Kind: CorrectionAddedComponent, Offset: 2, Replacement: ".0"},
+		}},
+		{"01.002.0+b", "1.2.0+b", []Correction{
+			{Kind: CorrectionRemovedLeadingZeros, Offset: 0, Original: "01", Replacement: "1"},
+			{Kind: CorrectionRemovedLeadingZeros, Offset: 3, Original: "002", Replacement: "2"},
+		}},
+		{" V1.2-rc.01.00a.000 \n", "1.2.0-rc.1.00a.0", []Correction{
+			{Kind: CorrectionTrimmedSpace, Offset: 0, Original: " "},
+			{Kind: CorrectionStrippedPrefix, Offset: 1, Original: "V"},
+			{Kind: CorrectionAddedComponent, Offset: 5, Replacement: ".0"},
+			{Kind: CorrectionRemovedLeadingZeros, Offset: 9, Original: "01", Replacement: "1"},
+			{Kind: CorrectionRemovedLeadingZeros, Offset: 16, Original: "000", Replacement: "0"},
+			{Kind: CorrectionTrimmedSpace, Offset: 19, Original: " \n"},
+		}},
+	}
+
+	for _, test := range tests {
+		v, corrections, err := ParseLenient(test.input)
+		is.NoError(err, "Input %q", test.input)
+		is.Equal(test.expected, v.String(), "Input %q", test.input)
+		is.Equal(test.corrections, corrections, "Input %q", test.input)
+	}
+}
+
+func TestParseLenientErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected error
+	}{
+		{"", ErrEmptyVersionString},
+		{" \t", ErrEmptyVersionString},
+		{"v", ErrInvalidNumericIdentifier},
+		{"vv1.2.3", ErrInvalidNumericIdentifier},
+		{"1..2", ErrInvalidNumericIdentifier},
+		{"1.2.3.4", ErrUnexpectedCharacter},
+		{"1.2.3-", ErrEmptyPrereleaseIdentifier},
+	}
+
+	for _, test := range tests {
+		_, corrections, err := ParseLenient(test.input)
+		is.ErrorIs(err, test.expected, "Input %q", test.input)
+		is.Nil(corrections, "Input %q", test.input)
+	}
+}
+
+func TestCorrectionString(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, corrections, err := ParseLenient("v1.02")
+	is.NoError(err)
+	is.Len(corrections, 3)
+	is.Equal(`stripped prefix at offset 0: "v" -> ""`, corrections[0].String())
+	is.Equal(`removed leading zeros at offset 3: "02" -> "2"`, corrections[1].String())
+	is.Equal(`added component at offset 5: "" -> ".0"`, corrections[2].String())
+	is.Equal("unknown", CorrectionKind(-1).String())
+}
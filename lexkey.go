@@ -0,0 +1,181 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidLexKey indicates that a string is not a key produced by Version.LexKey.
+var ErrInvalidLexKey = errors.New("invalid version lex key")
+
+const (
+	// lexKeyWidth is the number of digits of a numeric field in a lex key, enough for any uint64.
+	lexKeyWidth = 20
+
+	// lexKeyRelease follows the numeric fields of a release. It sorts above lexKeyPrerelease, so
+	// a release sorts above its pre-releases.
+	lexKeyRelease = '~'
+
+	// lexKeyPrerelease follows the numeric fields of a pre-release, ahead of its identifiers.
+	lexKeyPrerelease = '-'
+
+	// lexKeySeparator separates pre-release identifiers. It sorts below every identifier
+	// character, so "alpha" sorts below "alpha-1" and "alpha.1".
+	lexKeySeparator = '!'
+
+	// lexKeyNumeric and lexKeyAlphanumeric prefix each pre-release identifier, so numeric
+	// identifiers sort below alphanumeric ones.
+	lexKeyNumeric      = '0'
+	lexKeyAlphanumeric = '1'
+)
+
+// lexKeyCoreLen is the length of the numeric fields of a lex key: epoch, major, minor, patch,
+// and revision, separated by dots.
+const lexKeyCoreLen = 5*lexKeyWidth + 4
+
+// LexKey returns a key for the version whose byte-wise order matches version precedence, for
+// stores that order keys lexicographically, such as Redis sorted sets with equal scores, LSM
+// key ranges, or database text indexes.
+//
+// The numeric fields are zero-padded to a fixed width, followed by a flag byte that sorts a
+// release above its pre-releases, and then the pre-release identifiers, each tagged so that
+// numeric identifiers sort numerically and below alphanumeric ones. Build metadata does not
+// affect precedence and is not encoded, so versions that are Equal have the same key.
+//
+// Example:
+//
+//	a, b := semver.MustParse("1.0.0-rc.2"), semver.MustParse("1.0.0-rc.10")
+//	fmt.Println(a.LexKey() < b.LexKey()) // Output: true
+func (v Version) LexKey() string {
+	var sb strings.Builder
+	sb.Grow(lexKeyCoreLen + 1 + len(v.PreRelease)*(lexKeyWidth+2))
+
+	for i, n := range []uint64{v.Epoch, v.Major, v.Minor, v.Patch, v.Revision} {
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		writeLexKeyNumber(&sb, n)
+	}
+
+	if len(v.PreRelease) == 0 {
+		sb.WriteByte(lexKeyRelease)
+		return sb.String()
+	}
+
+	sb.WriteByte(lexKeyPrerelease)
+	for i, id := range v.PreRelease {
+		if i > 0 {
+			sb.WriteByte(lexKeySeparator)
+		}
+		if id.isNumeric {
+			sb.WriteByte(lexKeyNumeric)
+			writeLexKeyNumber(&sb, id.partNumeric)
+		} else {
+			sb.WriteByte(lexKeyAlphanumeric)
+			sb.WriteString(id.partString)
+		}
+	}
+	return sb.String()
+}
+
+// ParseLexKey parses a key produced by LexKey. The returned version has no build metadata.
+//
+// Returns an error wrapping ErrInvalidLexKey if the key is malformed.
+//
+// Example:
+//
+//	v, err := semver.ParseLexKey(semver.MustParse("1.2.3-beta.1+sha.5").LexKey())
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.3-beta.1
+func ParseLexKey(key string) (Version, error) {
+	if len(key) <= lexKeyCoreLen {
+		return Version{}, fmt.Errorf("%w: %q is too short", ErrInvalidLexKey, key)
+	}
+
+	var nums [5]uint64
+	for i := range nums {
+		start := i * (lexKeyWidth + 1)
+		if i > 0 && key[start-1] != '.' {
+			return Version{}, fmt.Errorf("%w: expected '.' at offset %d", ErrInvalidLexKey, start-1)
+		}
+		n, err := parseLexKeyNumber(key[start : start+lexKeyWidth])
+		if err != nil {
+			return Version{}, err
+		}
+		nums[i] = n
+	}
+	v := Version{Epoch: nums[0], Major: nums[1], Minor: nums[2], Patch: nums[3], Revision: nums[4]}
+
+	rest := key[lexKeyCoreLen:]
+	switch rest[0] {
+	case lexKeyRelease:
+		if len(rest) > 1 {
+			return Version{}, fmt.Errorf("%w: unexpected %q after release flag", ErrInvalidLexKey, rest[1:])
+		}
+		return v, nil
+	case lexKeyPrerelease:
+	default:
+		return Version{}, fmt.Errorf("%w: unknown flag %q", ErrInvalidLexKey, rest[0])
+	}
+
+	ids := strings.Split(rest[1:], string(lexKeySeparator))
+	v.PreRelease = make([]PrereleaseVersion, len(ids))
+	for i, id := range ids {
+		if id == "" {
+			return Version{}, fmt.Errorf("%w: empty pre-release identifier %d", ErrInvalidLexKey, i)
+		}
+		switch id[0] {
+		case lexKeyNumeric:
+			n, err := parseLexKeyNumber(id[1:])
+			if err != nil {
+				return Version{}, err
+			}
+			v.PreRelease[i] = PrereleaseVersion{partNumeric: n, isNumeric: true}
+		case lexKeyAlphanumeric:
+			v.PreRelease[i] = PrereleaseVersion{partString: id[1:]}
+		default:
+			return Version{}, fmt.Errorf("%w: unknown identifier tag %q", ErrInvalidLexKey, id[0])
+		}
+	}
+
+	if err := v.Check(); err != nil {
+		return Version{}, fmt.Errorf("%w: %w", ErrInvalidLexKey, err)
+	}
+	return v, nil
+}
+
+// writeLexKeyNumber writes n zero-padded to lexKeyWidth digits.
+func writeLexKeyNumber(sb *strings.Builder, n uint64) {
+	var buf [lexKeyWidth]byte
+	digits := strconv.AppendUint(buf[:0], n, 10)
+	for range lexKeyWidth - len(digits) {
+		sb.WriteByte('0')
+	}
+	sb.Write(digits)
+}
+
+// parseLexKeyNumber parses a numeric field of exactly lexKeyWidth digits.
+func parseLexKeyNumber(s string) (uint64, error) {
+	if len(s) != lexKeyWidth {
+		return 0, fmt.Errorf("%w: numeric field %q is not %d digits", ErrInvalidLexKey, s, lexKeyWidth)
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, fmt.Errorf("%w: numeric field %q is not %d digits", ErrInvalidLexKey, s, lexKeyWidth)
+		}
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: numeric field %q: %w", ErrInvalidLexKey, s, err)
+	}
+	return n, nil
+}
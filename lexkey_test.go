@@ -0,0 +1,91 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLexKeyOrder(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Ordered by increasing precedence, per the Semantic Versioning specification and beyond.
+	ordered := []Version{
+		MustParse("0.0.0-0"),
+		MustParse("0.0.0"),
+		MustParse("1.0.0-0.3.7"),
+		MustParse("1.0.0-alpha"),
+		MustParse("1.0.0-alpha.1"),
+		MustParse("1.0.0-alpha.beta"),
+		MustParse("1.0.0-alpha-1"),
+		MustParse("1.0.0-beta"),
+		MustParse("1.0.0-beta.2"),
+		MustParse("1.0.0-beta.11"),
+		MustParse("1.0.0-rc.1"),
+		MustParse("1.0.0"),
+		{Major: 1, Revision: 1},
+		MustParse("1.0.1"),
+		MustParse("1.10.0"),
+		MustParse("2.0.0-rc.1"),
+		MustParse("10.0.0"),
+		{Major: math.MaxUint64},
+		{Epoch: 1},
+	}
+
+	for i := 1; i < len(ordered); i++ {
+		a, b := ordered[i-1], ordered[i]
+		is.Less(a.LexKey(), b.LexKey(), "%s < %s", a, b)
+		is.Equal(-1, strings.Compare(a.LexKey(), b.LexKey()), "%s < %s", a, b)
+	}
+	is.Equal(MustParse("1.0.0+a").LexKey(), MustParse("1.0.0+b").LexKey())
+}
+
+func TestParseLexKey(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, v := range []Version{
+		MustParse("1.2.3"),
+		MustParse("1.2.3-beta.1"),
+		MustParse("0.0.0-0.a-b.18446744073709551615"),
+		{Epoch: 3, Major: 4, Revision: 2},
+	} {
+		got, err := ParseLexKey(v.LexKey())
+		is.NoError(err, v.String())
+		is.True(v.StrictEqual(got), v.String())
+	}
+
+	got, err := ParseLexKey(MustParse("1.2.3+sha.5").LexKey())
+	is.NoError(err)
+	is.Equal("1.2.3", got.String())
+
+	valid := MustParse("1.0.0-rc.1").LexKey()
+	for _, key := range []string{
+		"",
+		"1.0.0",
+		valid[:lexKeyCoreLen],
+		strings.Replace(valid, ".", ",", 1),
+		strings.Replace(valid, "1", "x", 1),
+		valid[:lexKeyCoreLen] + "*",
+		valid[:lexKeyCoreLen] + "~x",
+		valid[:lexKeyCoreLen] + "-",
+		valid + "!",
+		valid[:lexKeyCoreLen] + "-2rc",
+		valid[:lexKeyCoreLen] + "-1rc!01",
+		valid[:lexKeyCoreLen] + "-1a.b",
+		valid[:lexKeyCoreLen] + "-1123",
+		valid[:lexKeyCoreLen] + "-1",
+		valid[:lexKeyCoreLen] + "-099999999999999999999",
+	} {
+		_, err := ParseLexKey(key)
+		is.ErrorIs(err, ErrInvalidLexKey, key)
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+)
+
+// LintSeverity ranks how risky a LintFinding is.
+//
+// Supported Severities:
+//   - SeverityInfo: The constraint is unusual but may be intended.
+//   - SeverityWarning: The constraint is likely to select unwanted versions in the future.
+//   - SeverityError: The constraint cannot select any wanted version.
+type LintSeverity int
+
+const (
+	SeverityInfo LintSeverity = iota
+	SeverityWarning
+	SeverityError
+)
+
+// String returns the string representation of the LintSeverity.
+//
+// Example:
+//
+//	fmt.Println(semver.SeverityWarning.String()) // Output: warning
+func (s LintSeverity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, so that findings encode the severity by name.
+func (s LintSeverity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// LintRule identifies the check that produced a LintFinding.
+//
+// Supported Rules:
+//   - LintUnboundedUpper: A branch has no upper bound, so it admits every future major version.
+//   - LintExactPrerelease: A requirement pins an exact pre-release, which is superseded by
+//     later pre-releases and by its release.
+//   - LintMultipleMajors: A branch admits versions of more than one major version.
+//   - LintUnsatisfiable: The range admits no version at all.
+//   - LintNoRelease: The range admits none of the released versions.
+type LintRule int
+
+const (
+	LintUnboundedUpper LintRule = iota
+	LintExactPrerelease
+	LintMultipleMajors
+	LintUnsatisfiable
+	LintNoRelease
+)
+
+// String returns the string representation of the LintRule.
+//
+// Example:
+//
+//	fmt.Println(semver.LintUnboundedUpper.String()) // Output: unbounded-upper
+func (r LintRule) String() string {
+	switch r {
+	case LintUnboundedUpper:
+		return "unbounded-upper"
+	case LintExactPrerelease:
+		return "exact-prerelease"
+	case LintMultipleMajors:
+		return "multiple-majors"
+	case LintUnsatisfiable:
+		return "unsatisfiable"
+	case LintNoRelease:
+		return "no-release"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, so that findings encode the rule by name.
+func (r LintRule) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// LintFinding is a risky property of a constraint found by Lint.
+//
+// Branch is the 1-based index of the OR branch the finding applies to, or 0 if it applies to
+// the whole range.
+type LintFinding struct {
+	Rule     LintRule     `json:"rule"`
+	Severity LintSeverity `json:"severity"`
+	Branch   int          `json:"branch,omitempty"`
+	Message  string       `json:"message"`
+}
+
+// String returns a human-readable description of the finding, such as
+// "warning: unbounded-upper: branch 1 has no upper bound".
+func (f LintFinding) String() string {
+	return fmt.Sprintf("%s: %s: %s", f.Severity, f.Rule, f.Message)
+}
+
+// Lint checks a constraint for risky patterns and returns its findings, for CI bots that review
+// dependency manifests. Findings are returned in the order of the rules listed under LintRule,
+// and in branch order within a rule.
+//
+// An unsatisfiable range is reported as such, with no other findings. Otherwise, if released is
+// not nil, a range admitting none of the released versions is reported with LintNoRelease, as
+// Coverage determines.
+//
+// Example:
+//
+//	for _, f := range semver.Lint(semver.MustParseRange(">=1.2.0 || =2.0.0-rc.1"), nil) {
+//	    fmt.Println(f)
+//	}
+//	// Output:
+//	// warning: unbounded-upper: branch 1 has no upper bound
+//	// warning: exact-prerelease: branch 2 pins pre-release =2.0.0-rc.1
+func Lint(r *VersionRange, released []Version) []LintFinding {
+	if len(r.exactIntervals()) == 0 {
+		return []LintFinding{{
+			Rule:     LintUnsatisfiable,
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("%s admits no version", r),
+		}}
+	}
+
+	var unbounded, pinned, majors []LintFinding
+	for i, andReqs := range r.Requirements {
+		branch := i + 1
+		iv := unboundedInterval()
+		for _, req := range andReqs {
+			if reqIv, ok := requirementInterval(req); ok {
+				iv = iv.intersect(reqIv)
+			}
+			if req.Op == OpEq && len(req.Ver.PreRelease) > 0 {
+				pinned = append(pinned, LintFinding{
+					Rule:     LintExactPrerelease,
+					Severity: SeverityWarning,
+					Branch:   branch,
+					Message:  fmt.Sprintf("branch %d pins pre-release %s", branch, req),
+				})
+			}
+		}
+		if iv.IsEmpty() {
+			continue
+		}
+
+		if iv.Upper.Unbounded {
+			unbounded = append(unbounded, LintFinding{
+				Rule:     LintUnboundedUpper,
+				Severity: SeverityWarning,
+				Branch:   branch,
+				Message:  fmt.Sprintf("branch %d has no upper bound", branch),
+			})
+			continue
+		}
+
+		var low uint64
+		if !iv.Lower.Unbounded {
+			low = iv.Lower.Version.Major
+		}
+		if high := highestMajor(iv.Upper); high > low {
+			majors = append(majors, LintFinding{
+				Rule:     LintMultipleMajors,
+				Severity: SeverityInfo,
+				Branch:   branch,
+				Message:  fmt.Sprintf("branch %d admits major versions %d through %d", branch, low, high),
+			})
+		}
+	}
+
+	findings := append(append(unbounded, pinned...), majors...)
+	if released != nil && Coverage(r, released).AdmitsNone() {
+		findings = append(findings, LintFinding{
+			Rule:     LintNoRelease,
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("%s admits none of the %d released versions", r, len(released)),
+		})
+	}
+	return findings
+}
+
+// highestMajor returns the highest major version below a bounded upper bound. An exclusive
+// bound at the start of a major version, such as "<2.0.0" or "<2.0.0-0", ends the major
+// version before it.
+func highestMajor(upper Bound) uint64 {
+	v := upper.Version
+	atStart := v.Minor == 0 && v.Patch == 0 && v.Revision == 0 &&
+		(len(v.PreRelease) == 0 || v.Compare(minimalPrerelease(v.Major, 0, 0)) == 0)
+	if !upper.Inclusive && atStart && v.Major > 0 {
+		return v.Major - 1
+	}
+	return v.Major
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rng      string
+		expected []string
+	}{
+		{">=1.0.0 <2.0.0", nil},
+		{"^1.2.3", nil},
+		{"~0.2.0", nil},
+		{">=1.0.0 <=2.0.0", []string{"info: multiple-majors: branch 1 admits major versions 1 through 2"}},
+		{"<3.0.0", []string{"info: multiple-majors: branch 1 admits major versions 0 through 2"}},
+		{">=1.2.0", []string{"warning: unbounded-upper: branch 1 has no upper bound"}},
+		{"^1.0.0 || >=3.0.0", []string{"warning: unbounded-upper: branch 2 has no upper bound"}},
+		{"=2.0.0-rc.1", []string{"warning: exact-prerelease: branch 1 pins pre-release =2.0.0-rc.1"}},
+		{">=2.0.0 <1.0.0", []string{"error: unsatisfiable: >=2.0.0 <1.0.0 admits no version"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, f := range Lint(MustParseRange(tt.rng), nil) {
+			got = append(got, f.String())
+		}
+		is.Equal(tt.expected, got, tt.rng)
+	}
+}
+
+func TestLintReleases(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	released := []Version{MustParse("1.0.0"), MustParse("1.1.0")}
+	is.Empty(Lint(MustParseRange("^1.0.0"), released))
+
+	findings := Lint(MustParseRange("^2.0.0"), released)
+	is.Len(findings, 1)
+	is.Equal(LintNoRelease, findings[0].Rule)
+	is.Equal(SeverityError, findings[0].Severity)
+	is.Zero(findings[0].Branch)
+
+	findings = Lint(MustParseRange("^2.0.0"), []Version{})
+	is.Len(findings, 1)
+	is.Equal(LintNoRelease, findings[0].Rule)
+}
+
+func TestLintFindingJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data, err := json.Marshal(Lint(MustParseRange(">=1.0.0"), nil))
+	is.NoError(err)
+	is.JSONEq(`[{"rule":"unbounded-upper","severity":"warning","branch":1,"message":"branch 1 has no upper bound"}]`, string(data))
+
+	is.Equal("unknown", LintRule(99).String())
+	is.Equal("unknown", LintSeverity(99).String())
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// LockFileName is the conventional name of a lock file.
+const LockFileName = "semver.lock"
+
+// LockFormatVersion is the version of the lock file format written by LockFile.Save.
+const LockFormatVersion = 1
+
+var (
+	// ErrUnsupportedLockFormat indicates that a lock file was written in a format version this package cannot read.
+	ErrUnsupportedLockFormat = errors.New("unsupported lock file format")
+
+	// ErrLockMismatch indicates that a resolved version differs from the version pinned in a lock file.
+	ErrLockMismatch = errors.New("version does not match lock")
+)
+
+// LockEntry pins a component to an exact version.
+//
+// Range records the range the version was resolved from, so that a pin can be checked against
+// a changed requirement, and Integrity is a free-form note, such as a digest of the artifact,
+// that is preserved but not interpreted.
+type LockEntry struct {
+	Version   Version `json:"version" yaml:"version"`
+	Range     string  `json:"range,omitempty" yaml:"range,omitempty"`
+	Integrity string  `json:"integrity,omitempty" yaml:"integrity,omitempty"`
+}
+
+// LockFile maps components to their pinned versions, conventionally stored as semver.lock.
+//
+// The struct carries both json and yaml tags, so a lock file can be stored in either format.
+//
+// Example:
+//
+//	lock := semver.NewLockFile()
+//	_ = lock.Pin("core", semver.MustParse("1.4.2"), "^1.4.0", "sha256:9f86d08...")
+//
+//	f, err := os.Create(semver.LockFileName)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer f.Close()
+//	_ = lock.Save(f, nil)
+type LockFile struct {
+	Format     int                  `json:"lockfileVersion" yaml:"lockfileVersion"`
+	Components map[string]LockEntry `json:"components" yaml:"components"`
+}
+
+// NewLockFile creates an empty LockFile in the current format.
+func NewLockFile() *LockFile {
+	return &LockFile{
+		Format:     LockFormatVersion,
+		Components: make(map[string]LockEntry),
+	}
+}
+
+// LoadLockFile reads a LockFile from r using unmarshal, which defaults to json.Unmarshal when
+// nil. Pass yaml.Unmarshal from a YAML package to load YAML.
+//
+// Returns ErrUnsupportedLockFormat if the file was written in a newer format.
+func LoadLockFile(r io.Reader, unmarshal UnmarshalFunc) (*LockFile, error) {
+	if unmarshal == nil {
+		unmarshal = json.Unmarshal
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	l := NewLockFile()
+	if err := unmarshal(data, l); err != nil {
+		return nil, err
+	}
+	if l.Format < 1 || l.Format > LockFormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedLockFormat, l.Format)
+	}
+	if l.Components == nil {
+		l.Components = make(map[string]LockEntry)
+	}
+	return l, nil
+}
+
+// Save writes the LockFile to w using marshal, which defaults to indented JSON when nil.
+// Pass yaml.Marshal from a YAML package to save YAML. Components are written in sorted order
+// by both encoders, so saved lock files diff cleanly.
+func (l *LockFile) Save(w io.Writer, marshal MarshalFunc) error {
+	if marshal == nil {
+		marshal = func(v interface{}) ([]byte, error) {
+			data, err := json.MarshalIndent(v, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			return append(data, '\n'), nil
+		}
+	}
+
+	data, err := marshal(l)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// Pin records the exact version of a component, the range it was resolved from, and an
+// integrity note, replacing any previous pin. An empty range is not checked.
+//
+// Returns ErrConstraintNotSatisfied if the version is not in the range.
+func (l *LockFile) Pin(component string, v Version, constraint, integrity string) error {
+	if err := checkLockRange(component, v, constraint); err != nil {
+		return err
+	}
+	l.Components[component] = LockEntry{Version: v, Range: constraint, Integrity: integrity}
+	return nil
+}
+
+// Verify checks the lock file, returning all failures joined together.
+//
+// Every pinned version must satisfy the range it records. If resolved is not nil, it must name
+// exactly the pinned components, each at its pinned version including build metadata, or
+// ErrLockMismatch is reported.
+//
+// Example:
+//
+//	err := lock.Verify(map[string]semver.Version{"core": semver.MustParse("1.4.3")})
+//	fmt.Println(errors.Is(err, semver.ErrLockMismatch)) // Output: true
+func (l *LockFile) Verify(resolved map[string]Version) error {
+	names := make([]string, 0, len(l.Components))
+	for name := range l.Components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		entry := l.Components[name]
+		if err := checkLockRange(name, entry.Version, entry.Range); err != nil {
+			errs = append(errs, err)
+		}
+		if resolved == nil {
+			continue
+		}
+		v, ok := resolved[name]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("%w: %s is pinned to %s but was not resolved", ErrLockMismatch, name, entry.Version))
+		case !v.StrictEqual(entry.Version):
+			errs = append(errs, fmt.Errorf("%w: %s resolved to %s, pinned to %s", ErrLockMismatch, name, v, entry.Version))
+		}
+	}
+
+	extra := make([]string, 0, len(resolved))
+	for name := range resolved {
+		if _, ok := l.Components[name]; !ok {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		errs = append(errs, fmt.Errorf("%w: %s resolved to %s but is not pinned", ErrLockMismatch, name, resolved[name]))
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkLockRange returns an error if the range is not empty and does not contain v.
+func checkLockRange(component string, v Version, constraint string) error {
+	if constraint == "" {
+		return nil
+	}
+	r, err := ParseRange(constraint)
+	if err != nil {
+		return fmt.Errorf("%s: %w", component, err)
+	}
+	if !r.Contains(v) {
+		return fmt.Errorf("%w: %s is pinned to %s, outside %s", ErrConstraintNotSatisfied, component, v, constraint)
+	}
+	return nil
+}
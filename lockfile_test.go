@@ -0,0 +1,84 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func newTestLockFile(t *testing.T) *LockFile {
+	t.Helper()
+
+	l := NewLockFile()
+	assert.NoError(t, l.Pin("core", MustParse("1.4.2+build.7"), "^1.4.0", "sha256:abc"))
+	assert.NoError(t, l.Pin("cli", MustParse("0.9.2"), "", ""))
+	return l
+}
+
+func TestLockFilePin(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	l := newTestLockFile(t)
+	is.Equal(LockEntry{Version: MustParse("1.4.2+build.7"), Range: "^1.4.0", Integrity: "sha256:abc"}, l.Components["core"])
+
+	is.ErrorIs(l.Pin("web", MustParse("2.0.0"), "^1.0.0", ""), ErrConstraintNotSatisfied)
+	is.Error(l.Pin("web", MustParse("2.0.0"), "invalid", ""))
+	is.NotContains(l.Components, "web")
+}
+
+func TestLockFileVerify(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	l := newTestLockFile(t)
+	is.NoError(l.Verify(nil))
+	is.NoError(l.Verify(map[string]Version{"core": MustParse("1.4.2+build.7"), "cli": MustParse("0.9.2")}))
+
+	err := l.Verify(map[string]Version{"core": MustParse("1.4.2"), "web": MustParse("1.0.0")})
+	is.ErrorIs(err, ErrLockMismatch)
+	is.Contains(err.Error(), "core resolved to 1.4.2, pinned to 1.4.2+build.7")
+	is.Contains(err.Error(), "cli is pinned to 0.9.2 but was not resolved")
+	is.Contains(err.Error(), "web resolved to 1.0.0 but is not pinned")
+
+	l.Components["core"] = LockEntry{Version: MustParse("2.0.0"), Range: "^1.4.0"}
+	is.ErrorIs(l.Verify(nil), ErrConstraintNotSatisfied)
+}
+
+func TestLockFileLoadSave(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	l := newTestLockFile(t)
+
+	var buf bytes.Buffer
+	is.NoError(l.Save(&buf, nil))
+	is.Contains(buf.String(), `"lockfileVersion": 1`)
+	is.Contains(buf.String(), `"version": "1.4.2+build.7"`)
+	is.NotContains(buf.String(), `"integrity": ""`)
+
+	loaded, err := LoadLockFile(&buf, nil)
+	is.NoError(err)
+	is.Equal(l, loaded)
+
+	buf.Reset()
+	is.NoError(l.Save(&buf, yaml.Marshal))
+	loaded, err = LoadLockFile(&buf, yaml.Unmarshal)
+	is.NoError(err)
+	is.Equal(l, loaded)
+
+	loaded, err = LoadLockFile(strings.NewReader(`{}`), nil)
+	is.NoError(err)
+	is.NotNil(loaded.Components)
+
+	_, err = LoadLockFile(strings.NewReader(`{"lockfileVersion": 2}`), nil)
+	is.ErrorIs(err, ErrUnsupportedLockFormat)
+}
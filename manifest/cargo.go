@@ -0,0 +1,78 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package manifest
+
+import (
+	"io"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+// ReadCargoTOML reads the package version and dependency requirements from a Cargo.toml file.
+//
+// Dependencies are collected from the dependencies, dev-dependencies, and build-dependencies
+// tables, in either the string form or the inline table form with a version key.
+// Dependencies declared without a version, such as path or git dependencies, are kept with
+// a nil Range.
+//
+// Example:
+//
+//	m, err := manifest.ReadCargoTOML(strings.NewReader("[package]\nversion = \"0.4.1\"\n"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(m.Version) // Output: 0.4.1
+func ReadCargoTOML(r io.Reader) (*Manifest, error) {
+	entries, err := readTOML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{
+		Ecosystem: EcosystemCargo,
+	}
+
+	for _, e := range entries {
+		switch {
+		case e.Table == "package" && e.Key == "name":
+			m.Name, _ = tomlString(e.Value)
+		case e.Table == "package" && e.Key == "version":
+			if raw, ok := tomlString(e.Value); ok {
+				m.setVersion(raw)
+			}
+		case isCargoDependencyTable(e.Table):
+			raw, ok := tomlString(e.Value)
+			if !ok {
+				raw, _ = tomlInlineField(e.Value, "version")
+			}
+			m.Dependencies = append(m.Dependencies, newDependency(e.Key, raw, normalizeCargo(raw), semver.CargoDialect.ParseRange))
+		}
+	}
+
+	return m, nil
+}
+
+// isCargoDependencyTable reports whether the table holds dependency declarations,
+// including target-specific tables such as target.'cfg(unix)'.dependencies.
+func isCargoDependencyTable(table string) bool {
+	for _, suffix := range []string{"dependencies", "dev-dependencies", "build-dependencies"} {
+		if table == suffix || strings.HasSuffix(table, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeCargo trims a Cargo version requirement for semver.CargoDialect, treating "*" as
+// no constraint.
+func normalizeCargo(raw string) string {
+	s := strings.TrimSpace(raw)
+	if s == "*" {
+		return ""
+	}
+	return s
+}
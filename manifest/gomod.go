@@ -0,0 +1,89 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package manifest
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+// ReadGoMod reads the module path and required module versions from a go.mod file.
+//
+// Each require directive, in single-line or block form, is returned as a Dependency
+// pinned to an exact version. The "v" prefix used by Go modules is removed before parsing.
+// A go.mod file does not declare its own version, so Manifest.Version is always nil.
+//
+// Example:
+//
+//	m, err := manifest.ReadGoMod(strings.NewReader("module example.com/m\n\nrequire golang.org/x/text v0.3.7\n"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(m.Dependencies[0].Version) // Output: 0.3.7
+func ReadGoMod(r io.Reader) (*Manifest, error) {
+	m := &Manifest{
+		Ecosystem: EcosystemGo,
+	}
+
+	scanner := bufio.NewScanner(r)
+	inRequire := false
+	for scanner.Scan() {
+		line := stripGoModComment(scanner.Text())
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if inRequire {
+			if fields[0] == ")" {
+				inRequire = false
+				continue
+			}
+			m.addGoRequire(fields)
+			continue
+		}
+
+		switch fields[0] {
+		case "module":
+			if len(fields) > 1 {
+				m.Name = strings.Trim(fields[1], `"`)
+			}
+		case "require":
+			if len(fields) > 1 && fields[1] == "(" {
+				inRequire = true
+				continue
+			}
+			m.addGoRequire(fields[1:])
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// addGoRequire appends a dependency from the fields of a single requirement line.
+func (m *Manifest) addGoRequire(fields []string) {
+	if len(fields) < 2 {
+		return
+	}
+	name := strings.Trim(fields[0], `"`)
+	raw := fields[1]
+	m.Dependencies = append(m.Dependencies, newDependency(name, raw, "="+strings.TrimPrefix(raw, "v"), semver.ParseRange))
+}
+
+// stripGoModComment removes a trailing "//" comment from a go.mod line.
+func stripGoModComment(line string) string {
+	if i := strings.Index(line, "//"); i >= 0 {
+		return line[:i]
+	}
+	return line
+}
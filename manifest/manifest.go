@@ -0,0 +1,129 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package manifest provides lightweight readers that extract declared versions and
+// dependency constraints from common package manifest files.
+//
+// The readers do not model manifests in full. They only pull out the package's own
+// version and the version constraints of its declared dependencies, converting them
+// into semver.Version and semver.VersionRange values where possible.
+//
+// Supported manifests:
+//   - package.json (npm)
+//   - go.mod (Go modules)
+//   - Cargo.toml (Rust)
+//   - pyproject.toml (Python, PEP 621 and Poetry)
+package manifest
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+// Ecosystem identifies the package ecosystem a manifest belongs to.
+type Ecosystem string
+
+const (
+	EcosystemNPM   Ecosystem = "npm"
+	EcosystemGo    Ecosystem = "go"
+	EcosystemCargo Ecosystem = "cargo"
+	EcosystemPyPI  Ecosystem = "pypi"
+)
+
+var (
+	// ErrUnsupportedManifest indicates that the manifest file name is not recognized.
+	ErrUnsupportedManifest = errors.New("unsupported manifest file")
+)
+
+// Manifest holds the version information extracted from a manifest file.
+//
+// Version is nil if the manifest does not declare a version or if the declared
+// version is not a valid semantic version. RawVersion always holds the declared text.
+type Manifest struct {
+	Ecosystem    Ecosystem
+	Name         string
+	RawVersion   string
+	Version      *semver.Version
+	Dependencies []Dependency
+}
+
+// Dependency represents a single declared dependency and its version constraint.
+//
+// Range is nil if the constraint cannot be expressed as a semver.VersionRange.
+// Version is set only when the constraint pins a single exact version.
+type Dependency struct {
+	Name       string
+	Constraint string
+	Range      *semver.VersionRange
+	Version    *semver.Version
+}
+
+// Read reads a manifest, selecting the reader from the base name of filename.
+//
+// Example:
+//
+//	f, err := os.Open("package.json")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer f.Close()
+//
+//	m, err := manifest.Read("package.json", f)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(m.Version)
+func Read(filename string, r io.Reader) (*Manifest, error) {
+	switch strings.ToLower(filepath.Base(filename)) {
+	case "package.json":
+		return ReadPackageJSON(r)
+	case "go.mod":
+		return ReadGoMod(r)
+	case "cargo.toml":
+		return ReadCargoTOML(r)
+	case "pyproject.toml":
+		return ReadPyProject(r)
+	default:
+		return nil, ErrUnsupportedManifest
+	}
+}
+
+// setVersion records the declared version on the manifest, parsing it if possible.
+func (m *Manifest) setVersion(raw string) {
+	m.RawVersion = raw
+	if v, err := semver.Parse(strings.TrimPrefix(raw, "v")); err == nil {
+		m.Version = &v
+	}
+}
+
+// newDependency builds a Dependency from a name and a constraint already normalized into
+// the range syntax accepted by parse. The original constraint text is preserved.
+func newDependency(name, raw, normalized string, parse func(string) (*semver.VersionRange, error)) Dependency {
+	d := Dependency{
+		Name:       name,
+		Constraint: raw,
+	}
+
+	normalized = strings.TrimSpace(normalized)
+	if normalized == "" {
+		return d
+	}
+
+	r, err := parse(normalized)
+	if err != nil {
+		return d
+	}
+	d.Range = r
+	if len(r.Requirements) == 1 && len(r.Requirements[0]) == 1 && r.Requirements[0][0].Op == semver.OpEq {
+		v := r.Requirements[0][0].Ver
+		d.Version = &v
+	}
+
+	return d
+}
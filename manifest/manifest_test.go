@@ -0,0 +1,432 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package manifest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPackageJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `{
+  "name": "example",
+  "version": "1.2.3-beta.1",
+  "dependencies": {
+    "left-pad": "1.3.0",
+    "lodash": ">=4.17.0 <5.0.0"
+  },
+  "devDependencies": {
+    "local": "file:../local"
+  }
+}`
+
+	m, err := ReadPackageJSON(strings.NewReader(input))
+	is.NoError(err)
+	is.Equal(EcosystemNPM, m.Ecosystem)
+	is.Equal("example", m.Name)
+	is.Equal("1.2.3-beta.1", m.Version.String())
+	is.Len(m.Dependencies, 3)
+
+	is.Equal("left-pad", m.Dependencies[0].Name)
+	is.Equal("1.3.0", m.Dependencies[0].Version.String())
+
+	is.Equal("lodash", m.Dependencies[1].Name)
+	is.NotNil(m.Dependencies[1].Range)
+	is.True(m.Dependencies[1].Range.Contains(semver.MustParse("4.17.21")))
+	is.Nil(m.Dependencies[1].Version)
+
+	is.Equal("local", m.Dependencies[2].Name)
+	is.Nil(m.Dependencies[2].Range)
+
+	_, err = ReadPackageJSON(strings.NewReader("{"))
+	is.Error(err)
+}
+
+func TestReadGoMod(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `module github.com/example/mod
+
+go 1.25
+
+require github.com/stretchr/testify v1.11.1
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	golang.org/x/text v0.0.0-20210101000000-abcdef123456
+)
+`
+
+	m, err := ReadGoMod(strings.NewReader(input))
+	is.NoError(err)
+	is.Equal(EcosystemGo, m.Ecosystem)
+	is.Equal("github.com/example/mod", m.Name)
+	is.Nil(m.Version)
+	is.Len(m.Dependencies, 3)
+
+	is.Equal("github.com/stretchr/testify", m.Dependencies[0].Name)
+	is.Equal("v1.11.1", m.Dependencies[0].Constraint)
+	is.Equal("1.11.1", m.Dependencies[0].Version.String())
+	is.True(m.Dependencies[0].Range.Contains(semver.MustParse("1.11.1")))
+
+	is.Equal("github.com/davecgh/go-spew", m.Dependencies[1].Name)
+	is.Equal("0.0.0-20210101000000-abcdef123456", m.Dependencies[2].Version.String())
+}
+
+func TestReadGoModRetractions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `module github.com/example/mod
+
+go 1.25
+
+// Published accidentally.
+retract v1.0.1
+
+retract [v1.1.0, v1.1.9] // Data race in the cache.
+
+// Broken builds.
+retract (
+	v0.9.0
+	// Superseded by v0.9.2.
+	v0.9.1 // Do not use.
+)
+
+require github.com/stretchr/testify v1.11.1
+`
+
+	rs, err := ReadGoModRetractions(strings.NewReader(input))
+	is.NoError(err)
+	is.Len(rs, 4)
+
+	is.Equal("=1.0.1", rs[0].Range.String())
+	is.Equal("Published accidentally.", rs[0].Rationale)
+	is.Equal(">=1.1.0 <=1.1.9", rs[1].Range.String())
+	is.Equal("Data race in the cache.", rs[1].Rationale)
+	is.Equal("Broken builds.", rs[2].Rationale)
+	is.Equal("Superseded by v0.9.2.\nDo not use.", rs[3].Rationale)
+
+	is.True(rs.IsRetracted(semver.MustParse("1.1.5-rc.1")))
+	is.False(rs.IsRetracted(semver.MustParse("1.2.0")))
+
+	_, err = ReadGoModRetractions(strings.NewReader("module m\n\nretract 1.0.0\n"))
+	is.ErrorIs(err, ErrInvalidRetract)
+	is.ErrorContains(err, "line 3")
+}
+
+func TestParseRetract(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r, err := ParseRetract(" [v1.0.0,v1.9.9] ")
+	is.NoError(err)
+	is.True(r.Range.Contains(semver.MustParse("1.9.9")))
+	is.False(r.Range.Contains(semver.MustParse("2.0.0")))
+
+	r, err = ParseRetract("v0.0.0-20210101000000-abcdef123456")
+	is.NoError(err)
+	is.True(r.Range.Contains(semver.MustParse("0.0.0-20210101000000-abcdef123456")))
+
+	for _, input := range []string{"", "1.0.0", "[v1.0.0]", "[v1.0.0, v0.9.0]", "[v1.0.0, v2.0.0", "v1.0", "v1.0.0 v1.1.0"} {
+		_, err := ParseRetract(input)
+		is.ErrorIs(err, ErrInvalidRetract, input)
+	}
+}
+
+func TestReadCargoTOML(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `[package]
+name = "example" # the crate name
+version = "0.4.1"
+
+[dependencies]
+serde = { version = ">=1.0.100, <2.0.0", features = ["derive"] }
+rand = "=0.8.5"
+local = { path = "../local" }
+
+[dev-dependencies]
+criterion = "0.5"
+`
+
+	m, err := ReadCargoTOML(strings.NewReader(input))
+	is.NoError(err)
+	is.Equal(EcosystemCargo, m.Ecosystem)
+	is.Equal("example", m.Name)
+	is.Equal("0.4.1", m.Version.String())
+	is.Len(m.Dependencies, 4)
+
+	is.Equal("serde", m.Dependencies[0].Name)
+	is.True(m.Dependencies[0].Range.Contains(semver.MustParse("1.0.150")))
+	is.False(m.Dependencies[0].Range.Contains(semver.MustParse("2.0.0")))
+
+	is.Equal("rand", m.Dependencies[1].Name)
+	is.Equal("0.8.5", m.Dependencies[1].Version.String())
+
+	is.Equal("local", m.Dependencies[2].Name)
+	is.Nil(m.Dependencies[2].Range)
+
+	is.Equal("criterion", m.Dependencies[3].Name)
+	is.Equal("0.5", m.Dependencies[3].Constraint)
+	is.True(m.Dependencies[3].Range.Contains(semver.MustParse("0.5.3")))
+	is.False(m.Dependencies[3].Range.Contains(semver.MustParse("0.6.0")))
+}
+
+func TestReadCargoTOMLPartialVersions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `[dependencies]
+serde = "1.0"
+tokio = { version = "1" }
+log = "~0.4"
+bytes = ">=1.2, <2"
+`
+
+	m, err := ReadCargoTOML(strings.NewReader(input))
+	is.NoError(err)
+	is.Len(m.Dependencies, 4)
+
+	tests := []struct {
+		version string
+		want    []bool
+	}{
+		{"1.0.0", []bool{true, true, false, false}},
+		{"1.9.3", []bool{true, true, false, true}},
+		{"2.0.0", []bool{false, false, false, false}},
+		{"2.0.0-rc.1", []bool{false, false, false, false}},
+		{"0.4.20", []bool{false, false, true, false}},
+		{"0.5.0", []bool{false, false, false, false}},
+	}
+	for _, test := range tests {
+		for i, d := range m.Dependencies {
+			if is.NotNil(d.Range, "%s = %q", d.Name, d.Constraint) {
+				is.Equal(test.want[i], d.Range.Contains(semver.MustParse(test.version)), "%s = %q with %s", d.Name, d.Constraint, test.version)
+			}
+		}
+	}
+}
+
+func TestReadPyProject(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `[project]
+name = "example"
+version = "2.0.0"
+dependencies = [
+    "requests[security]>=2.31.0,<3.0.0",
+    "attrs==23.1.0 ; python_version >= '3.8'",
+    "click",
+]
+
+[tool.poetry.dependencies]
+python = "^3.10"
+pydantic = { version = ">=2.0.0", extras = ["email"] }
+`
+
+	m, err := ReadPyProject(strings.NewReader(input))
+	is.NoError(err)
+	is.Equal(EcosystemPyPI, m.Ecosystem)
+	is.Equal("example", m.Name)
+	is.Equal("2.0.0", m.Version.String())
+	is.Len(m.Dependencies, 4)
+
+	is.Equal("requests", m.Dependencies[0].Name)
+	is.Equal(">=2.31.0,<3.0.0", m.Dependencies[0].Constraint)
+	is.True(m.Dependencies[0].Range.Contains(semver.MustParse("2.31.5")))
+
+	is.Equal("attrs", m.Dependencies[1].Name)
+	is.Equal("23.1.0", m.Dependencies[1].Version.String())
+
+	is.Equal("click", m.Dependencies[2].Name)
+	is.Nil(m.Dependencies[2].Range)
+
+	is.Equal("pydantic", m.Dependencies[3].Name)
+	is.True(m.Dependencies[3].Range.Contains(semver.MustParse("2.5.0")))
+}
+
+func TestReadPyProjectPartialVersions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `[project]
+dependencies = [
+    "numpy==1.26",
+    "attrs>1.4",
+    "click!=8.1",
+    "rich~=13.4",
+    "httpx~=0.27.2",
+    "idna==3.*",
+]
+`
+
+	m, err := ReadPyProject(strings.NewReader(input))
+	is.NoError(err)
+	is.Len(m.Dependencies, 6)
+
+	tests := []struct {
+		dependency int
+		version    string
+		want       bool
+	}{
+		{0, "1.26.0", true},
+		{0, "1.26.4", false},
+		{1, "1.4.0", false},
+		{1, "1.4.1", true},
+		{2, "8.1.0", false},
+		{2, "8.1.7", true},
+		{3, "13.4.0", true},
+		{3, "13.9.1", true},
+		{3, "14.0.0", false},
+		{4, "0.27.9", true},
+		{4, "0.28.0", false},
+		{5, "3.7.0", true},
+		{5, "4.0.0", false},
+		{5, "3.8.0-rc.1", false},
+	}
+	for _, test := range tests {
+		d := m.Dependencies[test.dependency]
+		if is.NotNil(d.Range, "%s%s", d.Name, d.Constraint) {
+			is.Equal(test.want, d.Range.Contains(semver.MustParse(test.version)), "%s%s with %s", d.Name, d.Constraint, test.version)
+		}
+	}
+}
+
+func TestReadPyProjectPoetryConstraints(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `[tool.poetry.dependencies]
+python = "^3.10"
+requests = "~2.31"
+tomli = "~1"
+httpx = "^0.27"
+anyio = "^0.0.3"
+rich = "~=13.4"
+attrs = ">= 23.1, < 24 || 25.*"
+idna = "3.6"
+click = "*"
+`
+
+	m, err := ReadPyProject(strings.NewReader(input))
+	is.NoError(err)
+	is.Len(m.Dependencies, 8)
+
+	tests := []struct {
+		dependency int
+		version    string
+		want       bool
+	}{
+		{0, "2.31.0", true},
+		{0, "2.31.9", true},
+		{0, "2.32.0", false},
+		{1, "1.9.0", true},
+		{1, "2.0.0", false},
+		{2, "0.27.5", true},
+		{2, "0.28.0", false},
+		{3, "0.0.3", true},
+		{3, "0.0.4", false},
+		{4, "13.9.0", true},
+		{4, "14.0.0", false},
+		{5, "23.5.0", true},
+		{5, "24.0.0", false},
+		{5, "25.1.0", true},
+		{5, "23.5.0-rc.1", false},
+		{6, "3.6.0", true},
+		{6, "3.6.1", false},
+	}
+	for _, test := range tests {
+		d := m.Dependencies[test.dependency]
+		if is.NotNil(d.Range, "%s = %q", d.Name, d.Constraint) {
+			is.Equal(test.want, d.Range.Contains(semver.MustParse(test.version)), "%s = %q with %s", d.Name, d.Constraint, test.version)
+		}
+	}
+
+	is.Equal("3.6.0", m.Dependencies[6].Version.String())
+	is.Nil(m.Dependencies[0].Version)
+	is.Equal("click", m.Dependencies[7].Name)
+	is.Nil(m.Dependencies[7].Range)
+}
+
+func TestReadPyProjectPEP440Operators(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	input := `[project]
+dependencies = [
+    "rich~=13.4.2",
+    "numpy===1.26.4",
+    "attrs!=23.*",
+    "click<8.1",
+    "httpx>=0.27.0-rc.1",
+    "idna~=3",
+    "pytz===2024.1",
+    "six~1.16",
+    "tomli^2.0",
+]
+`
+
+	m, err := ReadPyProject(strings.NewReader(input))
+	is.NoError(err)
+	is.Len(m.Dependencies, 9)
+
+	tests := []struct {
+		dependency int
+		version    string
+		want       bool
+	}{
+		{0, "13.4.9", true},
+		{0, "13.5.0", false},
+		{0, "13.4.1", false},
+		{1, "1.26.4", true},
+		{1, "1.26.5", false},
+		{2, "22.9.0", true},
+		{2, "23.1.0", false},
+		{2, "24.0.0", true},
+		{3, "8.0.9", true},
+		{3, "8.1.0-rc.1", false},
+		{4, "0.27.0-rc.2", true},
+		{4, "0.28.0", true},
+	}
+	for _, test := range tests {
+		d := m.Dependencies[test.dependency]
+		if is.NotNil(d.Range, "%s%s", d.Name, d.Constraint) {
+			is.Equal(test.want, d.Range.Contains(semver.MustParse(test.version)), "%s%s with %s", d.Name, d.Constraint, test.version)
+		}
+	}
+
+	// "~=" needs at least two components, "===" a complete version, and "~" and "^" are
+	// Poetry operators.
+	for _, d := range m.Dependencies[5:] {
+		is.Nil(d.Range, "%s%s", d.Name, d.Constraint)
+	}
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m, err := Read("path/to/package.json", strings.NewReader(`{"version": "1.0.0"}`))
+	is.NoError(err)
+	is.Equal(EcosystemNPM, m.Ecosystem)
+
+	m, err = Read("Cargo.toml", strings.NewReader("[package]\nversion = \"1.0.0\"\n"))
+	is.NoError(err)
+	is.Equal(EcosystemCargo, m.Ecosystem)
+
+	_, err = Read("requirements.txt", strings.NewReader(""))
+	is.ErrorIs(err, ErrUnsupportedManifest)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package manifest
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+// packageJSON models only the version-related fields of a package.json file.
+type packageJSON struct {
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+}
+
+// ReadPackageJSON reads the version and dependency constraints from an npm package.json.
+//
+// Dependencies from the dependencies, devDependencies, peerDependencies, and
+// optionalDependencies sections are returned sorted by name within each section.
+// Non-registry specifiers such as URLs, paths, and tags are kept with a nil Range.
+//
+// Example:
+//
+//	m, err := manifest.ReadPackageJSON(strings.NewReader(`{"version": "1.2.3"}`))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(m.Version) // Output: 1.2.3
+func ReadPackageJSON(r io.Reader) (*Manifest, error) {
+	var pkg packageJSON
+	if err := json.NewDecoder(r).Decode(&pkg); err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{
+		Ecosystem: EcosystemNPM,
+		Name:      pkg.Name,
+	}
+	if pkg.Version != "" {
+		m.setVersion(pkg.Version)
+	}
+
+	for _, section := range []map[string]string{
+		pkg.Dependencies,
+		pkg.DevDependencies,
+		pkg.PeerDependencies,
+		pkg.OptionalDependencies,
+	} {
+		names := make([]string, 0, len(section))
+		for name := range section {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			raw := section[name]
+			m.Dependencies = append(m.Dependencies, newDependency(name, raw, normalizeNPM(raw), semver.ParseRange))
+		}
+	}
+
+	return m, nil
+}
+
+// normalizeNPM converts an npm range into semver range syntax.
+func normalizeNPM(raw string) string {
+	s := strings.TrimSpace(raw)
+	if s == "" || s == "*" || s == "latest" {
+		return ""
+	}
+
+	fields := strings.Fields(s)
+	for i, f := range fields {
+		fields[i] = trimVersionPrefix(f)
+	}
+	return strings.Join(fields, " ")
+}
+
+// trimVersionPrefix removes a "v" prefix that follows any comparison operator in a range token.
+func trimVersionPrefix(token string) string {
+	i := 0
+	for i < len(token) && strings.IndexByte("<>=!~^", token[i]) >= 0 {
+		i++
+	}
+	if i < len(token) && (token[i] == 'v' || token[i] == 'V') {
+		return token[:i] + token[i+1:]
+	}
+	return token
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package manifest
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+// ReadPyProject reads the project version and dependency specifiers from a pyproject.toml file.
+//
+// Both the PEP 621 [project] table and the Poetry [tool.poetry] table are supported.
+// PEP 508 requirement strings are split into a name and a specifier; extras and environment
+// markers are dropped. PEP 440 specifiers and Poetry constraints are evaluated with their own
+// semantics: partial versions are padded with zeros, so "==1.4" is exactly 1.4.0, "~=1.4" is
+// ">=1.4.0 <2.0.0", and Poetry's "~1.4" is ">=1.4.0 <1.5.0". Specifiers that cannot be mapped
+// onto semantic versions, such as "1.4rc1", are kept with a nil Range.
+//
+// Example:
+//
+//	m, err := manifest.ReadPyProject(strings.NewReader("[project]\nversion = \"2.0.0\"\n"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(m.Version) // Output: 2.0.0
+func ReadPyProject(r io.Reader) (*Manifest, error) {
+	entries, err := readTOML(r)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manifest{
+		Ecosystem: EcosystemPyPI,
+	}
+
+	for _, e := range entries {
+		switch {
+		case (e.Table == "project" || e.Table == "tool.poetry") && e.Key == "name":
+			m.Name, _ = tomlString(e.Value)
+		case (e.Table == "project" || e.Table == "tool.poetry") && e.Key == "version":
+			if raw, ok := tomlString(e.Value); ok {
+				m.setVersion(raw)
+			}
+		case e.Table == "project" && e.Key == "dependencies",
+			e.Table == "project.optional-dependencies":
+			for _, req := range tomlStrings(e.Value) {
+				name, spec := splitPEP508(req)
+				m.Dependencies = append(m.Dependencies, newDependency(name, spec, spec, parsePEP440))
+			}
+		case e.Table == "tool.poetry.dependencies" || strings.HasPrefix(e.Table, "tool.poetry.group."):
+			if e.Key == "python" {
+				continue
+			}
+			raw, ok := tomlString(e.Value)
+			if !ok {
+				raw, _ = tomlInlineField(e.Value, "version")
+			}
+			m.Dependencies = append(m.Dependencies, newDependency(e.Key, raw, normalizePoetry(raw), parsePoetry))
+		}
+	}
+
+	return m, nil
+}
+
+// splitPEP508 splits a PEP 508 requirement into its distribution name and version specifier.
+func splitPEP508(req string) (string, string) {
+	if i := strings.IndexByte(req, ';'); i >= 0 {
+		req = req[:i]
+	}
+	req = strings.TrimSpace(req)
+
+	end := strings.IndexAny(req, "[<>=!~( ")
+	if end < 0 {
+		return req, ""
+	}
+	name := req[:end]
+	spec := req[end:]
+	if strings.HasPrefix(spec, "[") {
+		if j := strings.IndexByte(spec, ']'); j >= 0 {
+			spec = spec[j+1:]
+		}
+	}
+	spec = strings.Trim(strings.TrimSpace(spec), "()")
+	return name, strings.TrimSpace(spec)
+}
+
+// pep440Operators lists the PEP 440 comparison operators, longest first so that prefixes
+// match greedily.
+var pep440Operators = []string{"===", "~=", "==", "!=", "<=", ">=", "<", ">"}
+
+// poetryOperators lists the Poetry operators, which add caret, tilde, and "=" to PEP 440.
+var poetryOperators = []string{"===", "~=", "==", "!=", "<=", ">=", "<", ">", "=", "^", "~"}
+
+// normalizePoetry trims a Poetry constraint, treating "*" as no constraint.
+func normalizePoetry(raw string) string {
+	s := strings.TrimSpace(raw)
+	if s == "*" {
+		return ""
+	}
+	return s
+}
+
+// parsePEP440 parses a comma-separated PEP 440 version specifier, such as ">=1.4,!=1.5.*".
+//
+// Pre-releases are excluded unless a clause names one, as PEP 440 requires.
+func parsePEP440(spec string) (*semver.VersionRange, error) {
+	var group [][]semver.Requirement
+	for _, clause := range strings.Split(spec, ",") {
+		reqs, err := parsePythonClause(strings.ReplaceAll(clause, " ", ""), pep440Operators)
+		if err != nil {
+			return nil, err
+		}
+		group = andRequirements(group, reqs)
+	}
+	return pythonRange(group), nil
+}
+
+// parsePoetry parses a Poetry version constraint, which extends PEP 440 with caret and tilde
+// requirements, bare exact versions, AND with spaces, and OR with "||" or "|".
+func parsePoetry(spec string) (*semver.VersionRange, error) {
+	var requirements [][]semver.Requirement
+	for _, part := range strings.Split(strings.ReplaceAll(spec, "||", "|"), "|") {
+		tokens := strings.Fields(strings.ReplaceAll(part, ",", " "))
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("%w: %q", semver.ErrInvalidRangeToken, spec)
+		}
+
+		var group [][]semver.Requirement
+		for i := 0; i < len(tokens); i++ {
+			token := tokens[i]
+			// Join an operator written apart from its version, as in ">= 1.2".
+			if strings.Trim(token, "=<>!~^") == "" && i+1 < len(tokens) {
+				i++
+				token += tokens[i]
+			}
+			reqs, err := parsePythonClause(token, poetryOperators)
+			if err != nil {
+				return nil, err
+			}
+			group = andRequirements(group, reqs)
+		}
+		requirements = append(requirements, group...)
+	}
+	return pythonRange(requirements), nil
+}
+
+// pythonRange builds a range from requirements, excluding pre-releases unless one of the
+// requirements names a pre-release other than the "-0" sentinel of an upper bound.
+func pythonRange(requirements [][]semver.Requirement) *semver.VersionRange {
+	vr := &semver.VersionRange{
+		Requirements: requirements,
+		Prerelease:   semver.PrereleaseExcluded,
+	}
+	for _, reqs := range requirements {
+		for _, req := range reqs {
+			if len(req.Ver.PreRelease) > 0 && !req.Ver.IsMinimalPrerelease() {
+				vr.Prerelease = semver.PrereleaseInclusive
+			}
+		}
+	}
+	return vr
+}
+
+// parsePythonClause parses a single PEP 440 or Poetry clause into requirements in disjunctive
+// normal form. Partial versions are padded with zeros.
+func parsePythonClause(clause string, operators []string) ([][]semver.Requirement, error) {
+	op := ""
+	for _, candidate := range operators {
+		if strings.HasPrefix(clause, candidate) {
+			op = candidate
+			break
+		}
+	}
+	text := strings.TrimPrefix(clause[len(op):], "v")
+
+	if op == "===" {
+		// Arbitrary equality compares strings, so only a complete version can match one.
+		v, err := semver.Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", semver.ErrInvalidRangeToken, clause)
+		}
+		return [][]semver.Requirement{{{Op: semver.OpEq, Ver: v}}}, nil
+	}
+
+	core, wildcard := strings.CutSuffix(text, ".*")
+	if core == "*" && op == "" {
+		return [][]semver.Requirement{{{Op: semver.OpGte, Ver: semver.Version{}}}}, nil
+	}
+	v, parts, err := parsePythonVersion(core)
+	if err != nil || (wildcard && len(v.PreRelease) > 0) {
+		return nil, fmt.Errorf("%w: %q", semver.ErrInvalidRangeToken, clause)
+	}
+
+	// A prefix match such as "==1.4.*" covers the pre-releases of the versions it matches.
+	switch {
+	case wildcard && (op == "" || op == "=" || op == "=="):
+		return [][]semver.Requirement{{
+			{Op: semver.OpGte, Ver: sentinel(v.Major, v.Minor, v.Patch)},
+			{Op: semver.OpLt, Ver: nextPrefix(v, parts)},
+		}}, nil
+	case wildcard && op == "!=":
+		return [][]semver.Requirement{
+			{{Op: semver.OpLt, Ver: sentinel(v.Major, v.Minor, v.Patch)}},
+			{{Op: semver.OpGte, Ver: nextPrefix(v, parts)}},
+		}, nil
+	case wildcard:
+		return nil, fmt.Errorf("%w: %q", semver.ErrInvalidRangeToken, clause)
+	}
+
+	switch op {
+	case "", "=", "==":
+		return [][]semver.Requirement{{{Op: semver.OpEq, Ver: v}}}, nil
+	case "!=":
+		return [][]semver.Requirement{{{Op: semver.OpNeq, Ver: v}}}, nil
+	case "<":
+		// "<1.4" excludes the pre-releases of 1.4.0 unless it names a pre-release itself.
+		if len(v.PreRelease) == 0 {
+			v = sentinel(v.Major, v.Minor, v.Patch)
+		}
+		return [][]semver.Requirement{{{Op: semver.OpLt, Ver: v}}}, nil
+	case "<=", ">", ">=":
+		return [][]semver.Requirement{{{Op: semver.Operator(op), Ver: v}}}, nil
+	case "~=":
+		// "~=1.4.2" is ">=1.4.2, ==1.4.*": the last given component may increase.
+		if parts < 2 {
+			return nil, fmt.Errorf("%w: %q", semver.ErrInvalidRangeToken, clause)
+		}
+		return [][]semver.Requirement{{
+			{Op: semver.OpGte, Ver: v},
+			{Op: semver.OpLt, Ver: nextPrefix(v, parts-1)},
+		}}, nil
+	case "~":
+		// "~1.4.2" and "~1.4" allow patch releases, "~1" allows minor releases.
+		return [][]semver.Requirement{{
+			{Op: semver.OpGte, Ver: v},
+			{Op: semver.OpLt, Ver: nextPrefix(v, min(parts, 2))},
+		}}, nil
+	default:
+		// "^" allows changes that do not modify the left-most non-zero component.
+		prefix := 3
+		switch {
+		case v.Major > 0 || parts == 1:
+			prefix = 1
+		case v.Minor > 0 || parts == 2:
+			prefix = 2
+		}
+		return [][]semver.Requirement{{
+			{Op: semver.OpGte, Ver: v},
+			{Op: semver.OpLt, Ver: nextPrefix(v, prefix)},
+		}}, nil
+	}
+}
+
+// parsePythonVersion parses a version with one to three numeric components, padding missing
+// components with zeros, and returns the number of components given. A pre-release or build
+// suffix in semantic versioning syntax is accepted after the components.
+func parsePythonVersion(s string) (semver.Version, int, error) {
+	core, suffix := s, ""
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		core, suffix = s[:i], s[i:]
+	}
+
+	parts := strings.Count(core, ".") + 1
+	if core == "" || parts > 3 {
+		return semver.Version{}, 0, semver.ErrInvalidNumericIdentifier
+	}
+	v, err := semver.Parse(core + strings.Repeat(".0", 3-parts) + suffix)
+	return v, parts, err
+}
+
+// nextPrefix returns the lowest version above every version that shares the first prefix
+// components of v, with a "-0" pre-release so that its pre-releases are excluded as well.
+func nextPrefix(v semver.Version, prefix int) semver.Version {
+	switch prefix {
+	case 1:
+		return sentinel(v.Major+1, 0, 0)
+	case 2:
+		return sentinel(v.Major, v.Minor+1, 0)
+	default:
+		return sentinel(v.Major, v.Minor, v.Patch+1)
+	}
+}
+
+// sentinel returns major.minor.patch-0, the lowest version with that core.
+func sentinel(major, minor, patch uint64) semver.Version {
+	v := semver.Version{Major: major, Minor: minor, Patch: patch}
+	v.PreRelease = semver.MustParse("0.0.0-0").PreRelease
+	return v
+}
+
+// andRequirements combines two sets of requirements in disjunctive normal form using logical AND.
+func andRequirements(a, b [][]semver.Requirement) [][]semver.Requirement {
+	if a == nil {
+		return b
+	}
+
+	combined := make([][]semver.Requirement, 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			reqs := make([]semver.Requirement, 0, len(x)+len(y))
+			reqs = append(reqs, x...)
+			reqs = append(reqs, y...)
+			combined = append(combined, reqs)
+		}
+	}
+	return combined
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package manifest
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+// ErrInvalidRetract indicates that a go.mod retract directive is malformed.
+var ErrInvalidRetract = errors.New("invalid retract directive")
+
+// ReadGoModRetractions reads the retract directives of a go.mod file, in single-line or block
+// form, as semver.Retractions.
+//
+// The rationale of a retraction is taken from the comment lines directly above the directive
+// and the comment at the end of its line, joined with newlines, as the go command does. A
+// directive without comments in a block inherits the comments above the block.
+//
+// Returns an error wrapping ErrInvalidRetract, with its line number, if a directive is malformed.
+//
+// Example:
+//
+//	rs, err := manifest.ReadGoModRetractions(strings.NewReader("module example.com/m\n\nretract v1.0.1 // Broken build.\n"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(rs[0].Range, rs[0].Rationale) // Output: =1.0.1 Broken build.
+func ReadGoModRetractions(r io.Reader) (semver.Retractions, error) {
+	var rs semver.Retractions
+	var comments, blockComments []string
+	inRetract := false
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(text, "//") {
+			comments = append(comments, strings.TrimSpace(strings.TrimPrefix(text, "//")))
+			continue
+		}
+
+		directive := strings.TrimSpace(stripGoModComment(text))
+		if directive == "" {
+			comments = nil
+			continue
+		}
+		if suffix, ok := strings.CutPrefix(strings.TrimSpace(text[len(directive):]), "//"); ok {
+			comments = append(comments, strings.TrimSpace(suffix))
+		}
+
+		args, isRetract := "", inRetract
+		if inRetract {
+			if directive == ")" {
+				inRetract, blockComments = false, nil
+				comments = nil
+				continue
+			}
+			args = directive
+			if len(comments) == 0 {
+				comments = blockComments
+			}
+		} else if fields := strings.Fields(directive); fields[0] == "retract" {
+			isRetract = true
+			args = strings.TrimSpace(strings.TrimPrefix(directive, "retract"))
+			if args == "(" {
+				inRetract, blockComments = true, comments
+				comments = nil
+				continue
+			}
+		}
+
+		if isRetract {
+			retraction, err := ParseRetract(args)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			retraction.Rationale = strings.Join(comments, "\n")
+			rs = append(rs, retraction)
+		}
+		comments = nil
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rs, nil
+}
+
+// ParseRetract parses the argument of a go.mod retract directive: a single version such as
+// "v1.0.1", or a closed interval such as "[v1.0.0, v1.9.9]". Versions must carry the "v" prefix
+// required by Go modules. The rationale of the returned retraction is empty.
+//
+// Returns an error wrapping ErrInvalidRetract if the argument is malformed, or if the interval's
+// lower version is above its upper version.
+//
+// Example:
+//
+//	r, err := manifest.ParseRetract("[v1.0.0, v1.9.9]")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Range.Contains(semver.MustParse("1.5.0"))) // Output: true
+func ParseRetract(s string) (semver.Retraction, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "[") {
+		v, err := parseRetractVersion(s)
+		if err != nil {
+			return semver.Retraction{}, err
+		}
+		return semver.RetractVersion(v, ""), nil
+	}
+
+	inner, ok := strings.CutSuffix(s[1:], "]")
+	low, high, hasComma := strings.Cut(inner, ",")
+	if !ok || !hasComma {
+		return semver.Retraction{}, fmt.Errorf("%w: %q is not a closed interval", ErrInvalidRetract, s)
+	}
+
+	lv, err := parseRetractVersion(low)
+	if err != nil {
+		return semver.Retraction{}, err
+	}
+	hv, err := parseRetractVersion(high)
+	if err != nil {
+		return semver.Retraction{}, err
+	}
+	if lv.Compare(hv) > 0 {
+		return semver.Retraction{}, fmt.Errorf("%w: %s is above %s", ErrInvalidRetract, strings.TrimSpace(low), strings.TrimSpace(high))
+	}
+	return semver.RetractRange(lv, hv, ""), nil
+}
+
+// parseRetractVersion parses a module version, which must carry the "v" prefix.
+func parseRetractVersion(s string) (semver.Version, error) {
+	s = strings.Trim(strings.TrimSpace(s), `"`)
+	raw, ok := strings.CutPrefix(s, "v")
+	if !ok {
+		return semver.Version{}, fmt.Errorf("%w: version %q must start with \"v\"", ErrInvalidRetract, s)
+	}
+	v, err := semver.Parse(raw)
+	if err != nil {
+		return semver.Version{}, fmt.Errorf("%w: version %q: %w", ErrInvalidRetract, s, err)
+	}
+	return v, nil
+}
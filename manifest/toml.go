@@ -0,0 +1,186 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package manifest
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// tomlEntry is a single key/value pair found in a TOML document.
+// Value holds the raw, unparsed value text.
+type tomlEntry struct {
+	Table string
+	Key   string
+	Value string
+}
+
+// readTOML performs a minimal scan of a TOML document, returning its top-level key/value
+// pairs along with the table they belong to. Multi-line arrays and inline tables are
+// joined into a single value. It is not a general-purpose TOML parser.
+func readTOML(r io.Reader) ([]tomlEntry, error) {
+	var entries []tomlEntry
+	var table string
+	var pending *tomlEntry
+	depth := 0
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(stripTOMLComment(scanner.Text()))
+		if line == "" {
+			continue
+		}
+
+		if pending != nil {
+			pending.Value += " " + line
+			depth += bracketDepth(line)
+			if depth <= 0 {
+				entries = append(entries, *pending)
+				pending = nil
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && !strings.Contains(line, "=") {
+			table = strings.TrimSpace(strings.Trim(line, "[]"))
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		entry := tomlEntry{
+			Table: table,
+			Key:   strings.Trim(strings.TrimSpace(key), `"'`),
+			Value: strings.TrimSpace(value),
+		}
+
+		depth = bracketDepth(entry.Value)
+		if depth > 0 {
+			pending = &entry
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// bracketDepth returns the net count of opening brackets and braces outside of strings.
+func bracketDepth(s string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'':
+			quote = ch
+		case ch == '[' || ch == '{':
+			depth++
+		case ch == ']' || ch == '}':
+			depth--
+		}
+	}
+	return depth
+}
+
+// stripTOMLComment removes a trailing "#" comment that is not inside a string.
+func stripTOMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'':
+			quote = ch
+		case ch == '#':
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// tomlString returns the contents of a quoted TOML string value.
+func tomlString(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if len(value) < 2 {
+		return "", false
+	}
+	if (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1], true
+	}
+	return "", false
+}
+
+// tomlStrings returns every quoted string found in a TOML array value.
+func tomlStrings(value string) []string {
+	var out []string
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		if ch != '"' && ch != '\'' {
+			continue
+		}
+		end := strings.IndexByte(value[i+1:], ch)
+		if end < 0 {
+			break
+		}
+		out = append(out, value[i+1:i+1+end])
+		i += end + 1
+	}
+	return out
+}
+
+// tomlInlineField returns the string value of a key inside an inline table value.
+func tomlInlineField(value, key string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "{") {
+		return "", false
+	}
+	for _, part := range splitOutsideQuotes(strings.Trim(value, "{}"), ',') {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok || strings.TrimSpace(k) != key {
+			continue
+		}
+		return tomlString(v)
+	}
+	return "", false
+}
+
+// splitOutsideQuotes splits s around each instance of sep that is not inside a string.
+func splitOutsideQuotes(s string, sep byte) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'':
+			quote = ch
+		case ch == sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
@@ -22,11 +22,27 @@ import (
 //	}
 //	fmt.Println(string(text)) // Output: 1.2.3-alpha+build.456
 func (v Version) MarshalText() ([]byte, error) {
-	return []byte(v.String()), nil
+	return v.AppendFormat(nil), nil
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the string representation of the Version to b.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3-alpha+build.456")
+//	text, err := v.AppendText([]byte("v"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(string(text)) // Output: v1.2.3-alpha+build.456
+func (v Version) AppendText(b []byte) ([]byte, error) {
+	return v.AppendFormat(b), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It parses the given text into a Version.
+// It parses the given text into a Version, including any epoch or revision written by
+// MarshalText.
 //
 // Example:
 //
@@ -37,7 +53,7 @@ func (v Version) MarshalText() ([]byte, error) {
 //	}
 //	fmt.Println(v) // Output: 1.2.3-alpha+build.456
 func (v *Version) UnmarshalText(text []byte) error {
-	parsed, err := Parse(string(text))
+	parsed, err := parseCanonicalVersion(string(text))
 	if err != nil {
 		return err
 	}
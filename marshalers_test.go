@@ -102,3 +102,74 @@ func TestVersionScan(t *testing.T) {
 	is.Error(err)
 	is.EqualError(err, "unsupported type for Version")
 }
+
+func TestVersionMarshalersEpoch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, v := range []Version{
+		{Epoch: 2, Major: 1},
+		{Epoch: 1, Major: 1, Minor: 2, Patch: 3, PreRelease: MustParse("1.2.3-rc.1").PreRelease},
+	} {
+		text, err := v.MarshalText()
+		is.NoError(err)
+		var fromText Version
+		is.NoError(fromText.UnmarshalText(text))
+		is.True(v.StrictEqual(fromText), v.String())
+
+		data, err := v.MarshalBinary()
+		is.NoError(err)
+		var fromBinary Version
+		is.NoError(fromBinary.UnmarshalBinary(data))
+		is.True(v.StrictEqual(fromBinary), v.String())
+
+		jsonData, err := json.Marshal(v)
+		is.NoError(err)
+		var fromJSON Version
+		is.NoError(json.Unmarshal(jsonData, &fromJSON))
+		is.True(v.StrictEqual(fromJSON), v.String())
+
+		value, err := v.Value()
+		is.NoError(err)
+		var fromSQL Version
+		is.NoError(fromSQL.Scan(value))
+		is.True(v.StrictEqual(fromSQL), v.String())
+	}
+}
+
+func TestVersionMarshalersRevision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, v := range []Version{
+		{Major: 1, Minor: 2, Patch: 3, Revision: 4},
+		{Epoch: 3, Major: 1, Revision: 9, PreRelease: MustParse("1.0.0-beta.2").PreRelease},
+	} {
+		var fromText Version
+		text, err := v.MarshalText()
+		is.NoError(err)
+		is.NoError(fromText.UnmarshalText(text))
+		is.True(v.StrictEqual(fromText), v.String())
+
+		var fromBinary Version
+		data, err := v.MarshalBinary()
+		is.NoError(err)
+		is.NoError(fromBinary.UnmarshalBinary(data))
+		is.True(v.StrictEqual(fromBinary), v.String())
+
+		var fromJSON Version
+		jsonData, err := json.Marshal(v)
+		is.NoError(err)
+		is.NoError(json.Unmarshal(jsonData, &fromJSON))
+		is.True(v.StrictEqual(fromJSON), v.String())
+
+		var fromSQL Version
+		value, err := v.Value()
+		is.NoError(err)
+		is.NoError(fromSQL.Scan(value))
+		is.True(v.StrictEqual(fromSQL), v.String())
+	}
+
+	var v Version
+	is.Error(v.UnmarshalText([]byte("1.2.3.4.5")))
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Mask returns the version with the components at and below the given level replaced by "x",
+// such as "1.2.x" for BumpPatch, "1.x.x" for BumpMinor, or "x.x.x" for BumpMajor. Pre-release
+// identifiers, build metadata, and any revision are always dropped, while an epoch is kept.
+//
+// Masked versions let telemetry pipelines report coarse versions without leaking exact,
+// patch-level fingerprints. Use ParseMask to turn a masked version back into a range.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3-rc.1+build.5")
+//	fmt.Println(v.Mask(semver.BumpPatch)) // Output: 1.2.x
+//	fmt.Println(v.Mask(semver.BumpMinor)) // Output: 1.x.x
+func (v Version) Mask(level BumpLevel) string {
+	b := make([]byte, 0, 16)
+	if v.Epoch != 0 {
+		b = strconv.AppendUint(b, v.Epoch, 10)
+		b = append(b, '!')
+	}
+
+	kept := maskedSegments(level)
+	for i, n := range [3]uint64{v.Major, v.Minor, v.Patch} {
+		if i > 0 {
+			b = append(b, '.')
+		}
+		if i < kept {
+			b = strconv.AppendUint(b, n, 10)
+		} else {
+			b = append(b, 'x')
+		}
+	}
+	return string(b)
+}
+
+// Bucket returns the lowest release sharing the components above the given level with the
+// version, which identifies the bucket the version falls into when grouped at that level.
+// Every version with the same Mask has the same Bucket.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3-rc.1")
+//	fmt.Println(v.Bucket(semver.BumpPatch)) // Output: 1.2.0
+//	fmt.Println(v.Bucket(semver.BumpMinor)) // Output: 1.0.0
+func (v Version) Bucket(level BumpLevel) Version {
+	bucket := Version{Epoch: v.Epoch}
+	kept := maskedSegments(level)
+	if kept > SegmentMajor {
+		bucket.Major = v.Major
+	}
+	if kept > SegmentMinor {
+		bucket.Minor = v.Minor
+	}
+	return bucket
+}
+
+// ParseMask parses a masked version, as produced by Mask, into the range of versions that
+// mask to it, including their pre-releases. Any of "x", "X", and "*" is accepted as the
+// masked component, and all three components must be present.
+//
+// Example:
+//
+//	r, err := semver.ParseMask("1.2.x")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.2.9-rc.1"))) // Output: true
+//	fmt.Println(r.Contains(semver.MustParse("1.3.0")))      // Output: false
+func ParseMask(s string) (*VersionRange, error) {
+	var epoch uint64
+	body := s
+	if prefix, rest, ok := strings.Cut(s, "!"); ok {
+		n, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil || !isNumeric(prefix) || (len(prefix) > 1 && prefix[0] == '0') {
+			return nil, invalidRangeToken(s)
+		}
+		epoch, body = n, rest
+	}
+
+	if strings.Count(body, ".") != 2 || strings.ContainsAny(body, "-+vV") {
+		return nil, invalidRangeToken(s)
+	}
+	p, err := parsePartialVersion(body)
+	if err != nil || p.parts == 3 {
+		return nil, invalidRangeToken(s)
+	}
+
+	vr := &VersionRange{Prerelease: PrereleaseInclusive}
+	if p.parts == 0 && epoch == 0 {
+		vr.Requirements = [][]Requirement{{{Op: OpGte, Ver: minimalPrerelease(0, 0, 0)}}}
+		return vr, nil
+	}
+
+	lower := minimalPrerelease(p.Major, p.Minor, 0)
+	lower.Epoch = epoch
+	reqs := []Requirement{{Op: OpGte, Ver: lower}}
+	if p.parts == 0 {
+		reqs = append(reqs, Requirement{Op: OpLt, Ver: Version{Epoch: epoch + 1, PreRelease: lower.PreRelease}})
+	} else {
+		upper := p.ceiling()
+		upper.Epoch = epoch
+		reqs = append(reqs, Requirement{Op: OpLt, Ver: upper})
+	}
+	vr.Requirements = [][]Requirement{reqs}
+	return vr, nil
+}
+
+// maskedSegments returns the number of leading numeric segments kept at the given mask level.
+func maskedSegments(level BumpLevel) int {
+	switch level {
+	case BumpMajor:
+		return 0
+	case BumpMinor:
+		return 1
+	default:
+		return 2
+	}
+}
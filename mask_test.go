@@ -0,0 +1,93 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionMask(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		version string
+		level   BumpLevel
+		mask    string
+		bucket  string
+	}{
+		{"1.2.3", BumpPatch, "1.2.x", "1.2.0"},
+		{"1.2.3-rc.1+build.5", BumpPatch, "1.2.x", "1.2.0"},
+		{"1.2.3", BumpMinor, "1.x.x", "1.0.0"},
+		{"1.2.3", BumpMajor, "x.x.x", "0.0.0"},
+		{"0.0.1-alpha", BumpPatch, "0.0.x", "0.0.0"},
+		{"1.2.3", BumpLevel(42), "1.2.x", "1.2.0"},
+	}
+
+	for _, test := range tests {
+		v := MustParse(test.version)
+		is.Equal(test.mask, v.Mask(test.level), "Version %s at %s", test.version, test.level)
+		is.Equal(test.bucket, v.Bucket(test.level).String(), "Version %s at %s", test.version, test.level)
+	}
+
+	withEpoch := Version{Epoch: 2, Major: 1, Minor: 4, Patch: 7, Revision: 9}
+	is.Equal("2!1.4.x", withEpoch.Mask(BumpPatch))
+	is.Equal("2!0.0.0", withEpoch.Bucket(BumpMajor).String())
+}
+
+func TestParseMask(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		mask     string
+		included []string
+		excluded []string
+	}{
+		{"1.2.x", []string{"1.2.0-0", "1.2.0", "1.2.9-rc.1", "1.2.99"}, []string{"1.1.9", "1.3.0-0", "1.3.0"}},
+		{"1.X.*", []string{"1.0.0-alpha", "1.99.0"}, []string{"0.9.9", "2.0.0-rc.1"}},
+		{"x.x.x", []string{"0.0.0-0", "1.2.3", "99.0.0"}, nil},
+	}
+
+	for _, test := range tests {
+		r, err := ParseMask(test.mask)
+		is.NoError(err, "Mask %s", test.mask)
+		for _, s := range test.included {
+			is.True(r.Contains(MustParse(s)), "Mask %s should contain %s", test.mask, s)
+		}
+		for _, s := range test.excluded {
+			is.False(r.Contains(MustParse(s)), "Mask %s should not contain %s", test.mask, s)
+		}
+	}
+
+	for _, s := range []string{"1.2.3", "1.4.7-rc.1", "0.0.0"} {
+		v := MustParse(s)
+		for _, level := range []BumpLevel{BumpPatch, BumpMinor, BumpMajor} {
+			r, err := ParseMask(v.Mask(level))
+			is.NoError(err)
+			is.True(r.Contains(v), "Version %s should be in its own mask at %s", s, level)
+			is.True(r.Contains(v.Bucket(level)), "Version %s bucket should be in its mask at %s", s, level)
+		}
+	}
+
+	r, err := ParseMask("2!1.x.x")
+	is.NoError(err)
+	is.True(r.Contains(Version{Epoch: 2, Major: 1, Minor: 5}))
+	is.False(r.Contains(MustParse("1.5.0")))
+
+	r, err = ParseMask("1!x.x.x")
+	is.NoError(err)
+	is.True(r.Contains(Version{Epoch: 1, Major: 7}))
+	is.False(r.Contains(Version{Epoch: 2}))
+	is.False(r.Contains(MustParse("7.0.0")))
+
+	for _, s := range []string{"", "1.2.3", "1.2", "1.x", "x.2.x", "1.2.x-rc", "v1.2.x", "1.2.x+build", "01!1.x.x", "a!1.x.x", "1.2.x.x"} {
+		_, err := ParseMask(s)
+		is.ErrorIs(err, ErrInvalidRangeToken, "Mask %q", s)
+	}
+}
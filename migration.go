@@ -0,0 +1,130 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	// ErrDuplicateMigration indicates that a migration is already registered for a version of the same precedence.
+	ErrDuplicateMigration = errors.New("duplicate migration")
+
+	// ErrUnorderedMigration indicates that a migration was registered below a previously registered one in strict order mode.
+	ErrUnorderedMigration = errors.New("migration registered out of order")
+
+	// ErrMigrationDowngrade indicates that a migration plan was requested to a lower version.
+	ErrMigrationDowngrade = errors.New("cannot plan a migration to a lower version")
+)
+
+// Migration is a step of a MigrationSet that brings a system up to Version.
+type Migration[T any] struct {
+	Version Version
+	Step    T
+}
+
+// migrationOptions holds the settings applied by MigrationOption functions.
+type migrationOptions struct {
+	strictOrder bool
+}
+
+// MigrationOption configures a MigrationSet.
+type MigrationOption func(*migrationOptions)
+
+// WithStrictOrder makes Register reject migrations whose version is not above every
+// previously registered one, for frameworks that expect migrations to be declared in order.
+func WithStrictOrder() MigrationOption {
+	return func(o *migrationOptions) {
+		o.strictOrder = true
+	}
+}
+
+// MigrationSet holds steps, such as database schema migrations, each registered under the
+// version it migrates to, and plans which of them to run between two versions.
+//
+// Versions are ordered by precedence, so a step registered at a pre-release such as
+// 2.0.0-rc.1 runs before one at 2.0.0, and build metadata is ignored.
+//
+// Example:
+//
+//	set := semver.NewMigrationSet[string]()
+//	_ = set.Register(semver.MustParse("1.1.0"), "add users.email")
+//	_ = set.Register(semver.MustParse("2.0.0-rc.1"), "split accounts table")
+//	_ = set.Register(semver.MustParse("2.0.0"), "drop legacy columns")
+//
+//	plan, err := set.Plan(semver.MustParse("1.1.0"), semver.MustParse("2.0.0"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, m := range plan {
+//	    fmt.Println(m.Version, m.Step)
+//	}
+//	// Output:
+//	// 2.0.0-rc.1 split accounts table
+//	// 2.0.0 drop legacy columns
+type MigrationSet[T any] struct {
+	migrations []Migration[T]
+	opts       migrationOptions
+}
+
+// NewMigrationSet creates an empty MigrationSet.
+func NewMigrationSet[T any](opts ...MigrationOption) *MigrationSet[T] {
+	s := &MigrationSet[T]{}
+	for _, opt := range opts {
+		opt(&s.opts)
+	}
+	return s
+}
+
+// Register adds a step that migrates to version v.
+//
+// Returns ErrDuplicateMigration if a step is already registered for a version of the same
+// precedence, or, with WithStrictOrder, ErrUnorderedMigration if v is below the highest
+// registered version.
+func (s *MigrationSet[T]) Register(v Version, step T) error {
+	i := sort.Search(len(s.migrations), func(i int) bool {
+		return s.migrations[i].Version.Compare(v) >= 0
+	})
+	if i < len(s.migrations) && s.migrations[i].Version.Compare(v) == 0 {
+		return fmt.Errorf("%w: %s (already registered as %s)", ErrDuplicateMigration, v, s.migrations[i].Version)
+	}
+	if s.opts.strictOrder && i < len(s.migrations) {
+		return fmt.Errorf("%w: %s after %s", ErrUnorderedMigration, v, s.migrations[len(s.migrations)-1].Version)
+	}
+
+	s.migrations = append(s.migrations, Migration[T]{})
+	copy(s.migrations[i+1:], s.migrations[i:])
+	s.migrations[i] = Migration[T]{Version: v, Step: step}
+	return nil
+}
+
+// Migrations returns every registered migration in increasing order of version.
+func (s *MigrationSet[T]) Migrations() []Migration[T] {
+	return append([]Migration[T](nil), s.migrations...)
+}
+
+// Plan returns the migrations to run, in order, to move a system from version from to version
+// to: those whose version is above from and at most to.
+//
+// Pre-release steps are included only when they lie on the path, so a plan to 2.0.0-beta.1
+// does not run a step registered at 2.0.0-rc.1, while a plan to 2.0.0 runs both.
+//
+// Returns ErrMigrationDowngrade if to is below from.
+func (s *MigrationSet[T]) Plan(from, to Version) ([]Migration[T], error) {
+	if to.Compare(from) < 0 {
+		return nil, fmt.Errorf("%w: %s to %s", ErrMigrationDowngrade, from, to)
+	}
+
+	lo := sort.Search(len(s.migrations), func(i int) bool {
+		return s.migrations[i].Version.Compare(from) > 0
+	})
+	hi := sort.Search(len(s.migrations), func(i int) bool {
+		return s.migrations[i].Version.Compare(to) > 0
+	})
+	return append([]Migration[T](nil), s.migrations[lo:hi]...), nil
+}
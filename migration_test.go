@@ -0,0 +1,70 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func steps(plan []Migration[string]) []string {
+	names := make([]string, len(plan))
+	for i, m := range plan {
+		names[i] = m.Step
+	}
+	return names
+}
+
+func TestMigrationSetPlan(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	set := NewMigrationSet[string]()
+	is.NoError(set.Register(MustParse("2.0.0"), "c"))
+	is.NoError(set.Register(MustParse("1.1.0"), "a"))
+	is.NoError(set.Register(MustParse("2.0.0-rc.1"), "b2"))
+	is.NoError(set.Register(MustParse("2.0.0-beta.1"), "b1"))
+	is.NoError(set.Register(MustParse("2.1.0"), "d"))
+
+	is.Equal([]string{"a", "b1", "b2", "c", "d"}, steps(set.Migrations()))
+
+	plan, err := set.Plan(MustParse("1.0.0"), MustParse("2.0.0"))
+	is.NoError(err)
+	is.Equal([]string{"a", "b1", "b2", "c"}, steps(plan))
+
+	plan, err = set.Plan(MustParse("1.1.0"), MustParse("2.0.0-beta.1"))
+	is.NoError(err)
+	is.Equal([]string{"b1"}, steps(plan), "Pre-release steps beyond the target should not run")
+
+	plan, err = set.Plan(MustParse("2.0.0-beta.1"), MustParse("2.0.5"))
+	is.NoError(err)
+	is.Equal([]string{"b2", "c"}, steps(plan))
+
+	plan, err = set.Plan(MustParse("2.1.0"), MustParse("2.1.0+build.2"))
+	is.NoError(err)
+	is.Empty(plan)
+
+	_, err = set.Plan(MustParse("2.0.0"), MustParse("1.0.0"))
+	is.ErrorIs(err, ErrMigrationDowngrade)
+}
+
+func TestMigrationSetRegister(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	set := NewMigrationSet[string]()
+	is.NoError(set.Register(MustParse("1.0.0"), "a"))
+	is.ErrorIs(set.Register(MustParse("1.0.0+build"), "b"), ErrDuplicateMigration)
+	is.NoError(set.Register(MustParse("0.9.0"), "c"))
+
+	strict := NewMigrationSet[string](WithStrictOrder())
+	is.NoError(strict.Register(MustParse("1.0.0"), "a"))
+	is.NoError(strict.Register(MustParse("1.1.0"), "b"))
+	is.ErrorIs(strict.Register(MustParse("1.0.5"), "c"), ErrUnorderedMigration)
+	is.ErrorIs(strict.Register(MustParse("1.1.0"), "d"), ErrDuplicateMigration)
+	is.Equal([]string{"a", "b"}, steps(strict.Migrations()))
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidModulePath indicates that a Go module path has a malformed major version suffix,
+	// such as "/v1" or "/v02".
+	ErrInvalidModulePath = errors.New("invalid module path major version suffix")
+
+	// ErrPathMajorMismatch indicates that a Go module version does not agree with the major
+	// version suffix of its module path.
+	ErrPathMajorMismatch = errors.New("module path and version disagree")
+)
+
+// incompatibleBuild is the build metadata the go command adds to versions v2 and above of
+// modules that have no go.mod file and therefore no major version suffix.
+const incompatibleBuild = "incompatible"
+
+// SplitModulePath splits a Go module path into its prefix and its major version suffix, or
+// "path major", as the go command does: "example.com/m/v2" splits into "example.com/m" and
+// "/v2", while "example.com/m" has an empty suffix. Paths on gopkg.in always have a suffix,
+// written with a dot, so "gopkg.in/yaml.v3" splits into "gopkg.in/yaml" and ".v3".
+//
+// The boolean result is false if the path ends in a malformed suffix: "/v0", "/v1", a suffix
+// with leading zeros, or one with a dot, such as "/v2.1", or if a gopkg.in path has no suffix.
+//
+// Example:
+//
+//	prefix, major, ok := semver.SplitModulePath("github.com/x/y/v3")
+//	fmt.Println(prefix, major, ok) // Output: github.com/x/y /v3 true
+func SplitModulePath(path string) (string, string, bool) {
+	if strings.HasPrefix(path, gopkgInHost+"/") {
+		return splitGopkgIn(path)
+	}
+
+	i := len(path)
+	dot := false
+	for i > 0 && (path[i-1] >= '0' && path[i-1] <= '9' || path[i-1] == '.') {
+		if path[i-1] == '.' {
+			dot = true
+		}
+		i--
+	}
+	if i <= 1 || i == len(path) || path[i-1] != 'v' || path[i-2] != '/' {
+		return path, "", true
+	}
+
+	prefix, pathMajor := path[:i-2], path[i-2:]
+	if dot || pathMajor[2] == '0' || pathMajor == "/v1" {
+		return path, "", false
+	}
+	return prefix, pathMajor, true
+}
+
+// PathMajor returns the major version suffix a Go module path must have to hold v: empty for
+// major versions 0 and 1 and for "+incompatible" versions, and "/vN" otherwise.
+//
+// Example:
+//
+//	fmt.Println(semver.PathMajor(semver.MustParse("2.1.0"))) // Output: /v2
+func PathMajor(v Version) string {
+	if v.Major < 2 || isIncompatible(v) {
+		return ""
+	}
+	return "/v" + strconv.FormatUint(v.Major, 10)
+}
+
+// CheckPathMajor returns an error wrapping ErrPathMajorMismatch unless v may be a version of a
+// module whose path has the major version suffix pathMajor, as returned by SplitModulePath.
+//
+// An empty suffix allows major versions 0 and 1, and "+incompatible" versions of major 2 and
+// above. A "/vN" suffix allows only major version N, without "+incompatible". A gopkg.in ".vN"
+// suffix allows major version N, a ".vN-unstable" suffix allows any version, and ".v1" also
+// allows untagged pseudo-versions, as the go command does.
+//
+// Example:
+//
+//	err := semver.CheckPathMajor(semver.MustParse("3.0.0"), "/v2")
+//	fmt.Println(err) // Output: module path and version disagree: major version should be v2, not v3
+func CheckPathMajor(v Version, pathMajor string) error {
+	if strings.HasPrefix(pathMajor, ".v") {
+		if strings.HasSuffix(pathMajor, gopkgInUnstable) ||
+			(pathMajor == ".v1" && ClassifyPseudoVersion(v) == PseudoUntagged && v.Major == 0) {
+			return nil
+		}
+	}
+
+	incompatible := isIncompatible(v)
+	switch {
+	case pathMajor == "" && incompatible && v.Major < 2:
+		return fmt.Errorf("%w: +incompatible requires major version v2 or later, not v%d", ErrPathMajorMismatch, v.Major)
+	case pathMajor == "" && (v.Major < 2 || incompatible):
+		return nil
+	case pathMajor == "":
+		return fmt.Errorf("%w: major version should be v0 or v1, not v%d", ErrPathMajorMismatch, v.Major)
+	case incompatible:
+		return fmt.Errorf("%w: +incompatible is not allowed with path suffix %s", ErrPathMajorMismatch, pathMajor)
+	}
+
+	want := pathMajor[1:]
+	if want != "v"+strconv.FormatUint(v.Major, 10) {
+		return fmt.Errorf("%w: major version should be %s, not v%d", ErrPathMajorMismatch, want, v.Major)
+	}
+	return nil
+}
+
+// CheckModulePath returns an error unless v may be a version of the Go module with the given
+// path, combining SplitModulePath and CheckPathMajor.
+//
+// Returns an error wrapping ErrInvalidModulePath if the path's suffix is malformed, or
+// ErrPathMajorMismatch if the version does not agree with it.
+//
+// Example:
+//
+//	fmt.Println(semver.CheckModulePath("github.com/x/y/v2", semver.MustParse("2.4.0"))) // Output: <nil>
+func CheckModulePath(path string, v Version) error {
+	_, pathMajor, ok := SplitModulePath(path)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidModulePath, path)
+	}
+	if err := CheckPathMajor(v, pathMajor); err != nil {
+		return fmt.Errorf("%s@v%s: %w", path, v, err)
+	}
+	return nil
+}
+
+// isIncompatible reports whether v carries the "+incompatible" build metadata.
+func isIncompatible(v Version) bool {
+	return slices.Contains(v.BuildMetadata, incompatibleBuild)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitModulePath(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		path      string
+		prefix    string
+		pathMajor string
+		ok        bool
+	}{
+		{"github.com/x/y", "github.com/x/y", "", true},
+		{"github.com/x/y/v2", "github.com/x/y", "/v2", true},
+		{"github.com/x/y/v10", "github.com/x/y", "/v10", true},
+		{"github.com/x/v2y", "github.com/x/v2y", "", true},
+		{"github.com/x/y/v1", "github.com/x/y/v1", "", false},
+		{"github.com/x/y/v0", "github.com/x/y/v0", "", false},
+		{"github.com/x/y/v02", "github.com/x/y/v02", "", false},
+		{"github.com/x/y/v2.1", "github.com/x/y/v2.1", "", false},
+		{"v2", "v2", "", true},
+	}
+	for _, tt := range tests {
+		prefix, pathMajor, ok := SplitModulePath(tt.path)
+		is.Equal(tt.prefix, prefix, tt.path)
+		is.Equal(tt.pathMajor, pathMajor, tt.path)
+		is.Equal(tt.ok, ok, tt.path)
+	}
+}
+
+func TestPathMajor(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("", PathMajor(MustParse("0.9.0")))
+	is.Equal("", PathMajor(MustParse("1.2.3")))
+	is.Equal("/v2", PathMajor(MustParse("2.0.0-rc.1")))
+	is.Equal("", PathMajor(MustParse("3.1.0+incompatible")))
+}
+
+func TestCheckPathMajor(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		version   string
+		pathMajor string
+		ok        bool
+	}{
+		{"0.1.0", "", true},
+		{"1.9.0", "", true},
+		{"2.0.0", "", false},
+		{"2.0.0+incompatible", "", true},
+		{"1.0.0+incompatible", "", false},
+		{"2.0.0", "/v2", true},
+		{"3.0.0", "/v2", false},
+		{"1.0.0", "/v2", false},
+		{"2.0.0+incompatible", "/v2", false},
+	}
+	for _, tt := range tests {
+		err := CheckPathMajor(MustParse(tt.version), tt.pathMajor)
+		if tt.ok {
+			is.NoError(err, tt.version)
+		} else {
+			is.ErrorIs(err, ErrPathMajorMismatch, tt.version)
+		}
+	}
+
+	is.EqualError(CheckPathMajor(MustParse("3.0.0"), "/v2"), "module path and version disagree: major version should be v2, not v3")
+}
+
+func TestCheckModulePath(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NoError(CheckModulePath("github.com/x/y/v2", MustParse("2.4.0")))
+	is.ErrorIs(CheckModulePath("github.com/x/y/v1", MustParse("1.0.0")), ErrInvalidModulePath)
+
+	err := CheckModulePath("github.com/x/y", MustParse("2.0.0"))
+	is.ErrorIs(err, ErrPathMajorMismatch)
+	is.ErrorContains(err, "github.com/x/y@v2.0.0")
+}
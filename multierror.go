@@ -0,0 +1,122 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PositionedError is the failure of one item of a batch operation, such as one entry of a
+// manifest. It records where the item was in the batch, so callers can point users at it.
+type PositionedError struct {
+	// Index is the 0-based position of the item in the batch.
+	Index int
+
+	// Line is the 1-based line number of the item in its source text, or 0 if the batch was
+	// not read from text.
+	Line int
+
+	// Input is the item that failed, or empty if there is no single input to show.
+	Input string
+
+	// Err is the error the item failed with.
+	Err error
+}
+
+// Error returns the position and input of the item followed by its error.
+//
+// Example:
+//
+//	e := &semver.PositionedError{Index: 1, Line: 2, Input: "bogus", Err: semver.ErrInvalidRangeToken}
+//	fmt.Println(e) // Output: line 2: "bogus": invalid range token
+func (e *PositionedError) Error() string {
+	var sb strings.Builder
+	if e.Line > 0 {
+		fmt.Fprintf(&sb, "line %d", e.Line)
+	} else {
+		fmt.Fprintf(&sb, "item %d", e.Index)
+	}
+	if e.Input != "" {
+		fmt.Fprintf(&sb, ": %q", e.Input)
+	}
+	sb.WriteString(": ")
+	sb.WriteString(e.Err.Error())
+	return sb.String()
+}
+
+// Unwrap returns the error the item failed with.
+func (e *PositionedError) Unwrap() error {
+	return e.Err
+}
+
+// Errors collects the failures of a batch operation, in the order of their items, so that
+// callers see every failure rather than only the first.
+//
+// Errors implements Unwrap() []error, so errors.Is and errors.As search every failure, and a
+// single failure can be extracted with errors.As into a *PositionedError. Functions in this
+// package return a nil error rather than an empty Errors; use Err to do the same.
+type Errors []*PositionedError
+
+// Error returns the failures, one per line, in the style of errors.Join.
+//
+// Example:
+//
+//	_, err := semver.ParseAll([]string{"1.0.0", "1.2", "v2"})
+//	fmt.Println(err)
+//	// Output:
+//	// item 1: "1.2": missing major, minor, or patch elements
+//	// item 2: "v2": invalid numeric identifier
+func (e Errors) Error() string {
+	lines := make([]string, len(e))
+	for i, err := range e {
+		lines[i] = err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap returns the failures as a slice of errors.
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// Err returns e as an error, or nil if e holds no failures.
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// ParseAll parses every input, returning one Version per input and an Errors holding every
+// input that failed to parse, in order. Failed inputs leave a zero Version in their slot so
+// that results stay aligned with inputs.
+//
+// Example:
+//
+//	versions, err := semver.ParseAll([]string{"1.0.0", "1.2", "2.0.0"})
+//	var errs semver.Errors
+//	if errors.As(err, &errs) {
+//	    fmt.Println(len(errs), errs[0].Index) // Output: 1 1
+//	}
+//	fmt.Println(versions[2]) // Output: 2.0.0
+func ParseAll(inputs []string) ([]Version, error) {
+	versions := make([]Version, len(inputs))
+	var errs Errors
+	for i, input := range inputs {
+		v, err := Parse(input)
+		if err != nil {
+			errs = append(errs, &PositionedError{Index: i, Input: input, Err: err})
+			continue
+		}
+		versions[i] = v
+	}
+	return versions, errs.Err()
+}
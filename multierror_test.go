@@ -0,0 +1,76 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositionedError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	e := &PositionedError{Index: 1, Line: 2, Input: "bogus", Err: ErrInvalidRangeToken}
+	is.Equal(`line 2: "bogus": invalid range token`, e.Error())
+	is.ErrorIs(e, ErrInvalidRangeToken)
+
+	e = &PositionedError{Index: 3, Err: ErrConstraintNotSatisfied}
+	is.Equal("item 3: constraint not satisfied", e.Error())
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NoError(Errors(nil).Err())
+	is.NoError(Errors{}.Err())
+
+	errs := Errors{
+		{Index: 0, Input: "1.2", Err: ErrMissingVersionElements},
+		{Index: 2, Input: "01.0.0", Err: ErrLeadingZeroInNumericIdentifier},
+	}
+	err := errs.Err()
+	is.Error(err)
+	is.Equal("item 0: \"1.2\": missing major, minor, or patch elements\nitem 2: \"01.0.0\": leading zeros are not allowed in numeric identifiers", err.Error())
+	is.ErrorIs(err, ErrMissingVersionElements)
+	is.ErrorIs(err, ErrLeadingZeroInNumericIdentifier)
+	is.Len(errs.Unwrap(), 2)
+
+	var pe *PositionedError
+	is.True(errors.As(err, &pe))
+	is.Equal(0, pe.Index)
+}
+
+func TestParseAll(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	versions, err := ParseAll([]string{"1.0.0", "2.0.0-rc.1"})
+	is.NoError(err)
+	is.Equal([]Version{MustParse("1.0.0"), MustParse("2.0.0-rc.1")}, versions)
+
+	versions, err = ParseAll([]string{"1.0.0", "1.2", "2.0.0", "v2"})
+	is.Len(versions, 4)
+	is.Equal(Version{}, versions[1])
+	is.Equal("2.0.0", versions[2].String())
+
+	var errs Errors
+	if is.True(errors.As(err, &errs)) {
+		is.Len(errs, 2)
+		is.Equal(1, errs[0].Index)
+		is.Equal("1.2", errs[0].Input)
+		is.Equal(3, errs[1].Index)
+	}
+	is.ErrorIs(err, ErrMissingVersionElements)
+	is.ErrorIs(err, ErrInvalidNumericIdentifier)
+
+	versions, err = ParseAll(nil)
+	is.NoError(err)
+	is.Empty(versions)
+}
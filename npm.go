@@ -0,0 +1,126 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// NpmDialect parses version ranges the way npm does (via node-semver), for tooling that reads or
+// writes the dependencies of JavaScript packages.
+//
+// Supported syntax:
+//   - Comparisons: "=", ">", ">=", "<", "<="
+//   - Caret and tilde: "^1.2.3", "~1.2", "~>1.2"
+//   - X-ranges and partial versions: "1.2.x", "1.*", "1.2", "*"
+//   - Hyphen ranges: "1.2 - 1.4.5"
+//   - AND with spaces, OR with "||"; an empty range is "*"
+//   - An optional "v" prefix on every version
+//
+// Ranges use PrereleaseOptIn: a pre-release version only matches a comparator set with a
+// pre-release of its own. npm additionally requires that pre-release to share the version's
+// major, minor, and patch numbers, which a VersionRange cannot express.
+//
+// Example:
+//
+//	r, err := semver.NpmDialect.ParseRange("^1.2.0 || 2.x")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("2.4.0"))) // Output: true
+var NpmDialect Dialect = npmDialect{}
+
+type npmDialect struct{}
+
+// npmOperators lists the npm operators, longest first so that prefixes match greedily.
+var npmOperators = []string{">=", "<=", "~>", ">", "<", "=", "~", "^"}
+
+// Name returns the name of the dialect.
+func (npmDialect) Name() string {
+	return "npm"
+}
+
+// ParseVersion parses an npm version, which must be a complete Semantic Versioning version
+// with an optional "v" prefix.
+func (npmDialect) ParseVersion(v string) (Version, error) {
+	return Parse(strings.TrimPrefix(strings.TrimSpace(v), "v"))
+}
+
+// FormatRange formats a range as an npm range.
+func (npmDialect) FormatRange(r *VersionRange) (string, error) {
+	return formatComparisons(r, Requirement.String), nil
+}
+
+// ParseRange parses an npm range into a VersionRange.
+func (npmDialect) ParseRange(r string) (*VersionRange, error) {
+	vr := &VersionRange{
+		Prerelease: PrereleaseOptIn,
+	}
+
+	for _, part := range strings.Split(r, "||") {
+		tokens := joinOperatorTokens(strings.Fields(part), npmOperators)
+		if len(tokens) == 0 {
+			vr.Requirements = append(vr.Requirements, matchAll()...)
+			continue
+		}
+
+		var group [][]Requirement
+		for i := 0; i < len(tokens); i++ {
+			var reqs [][]Requirement
+			var err error
+
+			if i+2 < len(tokens) && tokens[i+1] == "-" {
+				reqs, err = parseHyphenTokens(tokens[i], tokens[i+2])
+				i += 2
+			} else {
+				reqs, err = parseNpmToken(tokens[i])
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			group = andRequirements(group, reqs)
+		}
+
+		vr.Requirements = append(vr.Requirements, group...)
+	}
+
+	return vr, nil
+}
+
+// parseNpmToken parses a single npm comparator.
+func parseNpmToken(token string) ([][]Requirement, error) {
+	op, rest := splitOperator(token, npmOperators)
+
+	p, err := parsePartialVersion(rest)
+	if err != nil {
+		return nil, invalidRangeToken(token)
+	}
+
+	switch op {
+	case "^":
+		return expandCaret(p), nil
+	case "~", "~>":
+		return expandTilde(p), nil
+	case "", "=":
+		return expandComparison(OpEq, p), nil
+	default:
+		return expandComparison(Operator(op), p), nil
+	}
+}
+
+// ParseNpmRange parses an npm range into a VersionRange.
+//
+// Example:
+//
+//	r, err := semver.ParseNpmRange("1.2.3 - 2.3")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("2.3.9"))) // Output: true
+func ParseNpmRange(r string) (*VersionRange, error) {
+	return NpmDialect.ParseRange(r)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNpmDialectContains(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rangeStr    string
+		version     string
+		shouldMatch bool
+	}{
+		{"^1.2.3", "1.9.9", true},
+		{"^1.2.3", "2.0.0", false},
+		{"~1.2", "1.2.9", true},
+		{"~>1.2", "1.3.0", false},
+		{"1.2.x", "1.2.7", true},
+		{"1.*", "2.0.0", false},
+		{"1.2", "1.2.5", true},
+		{">1.2", "1.3.0", true},
+		{"<=1.2", "1.2.9", true},
+		{"1.2.3 - 2.3", "2.3.9", true},
+		{"1.2.3 - 2.3", "2.4.0", false},
+		{">= 1.2.0 < 2", "1.9.0", true},
+		{"<1.0.0 || >=2.0.0", "1.5.0", false},
+		{"v1.2.3", "1.2.3", true},
+		{"", "3.4.5", true},
+		{"1.0.0 || ", "3.4.5", true},
+
+		// Pre-releases are only matched by comparator sets that opt in.
+		{"^1.2.3", "1.5.0-rc.1", false},
+		{">=1.2.3-0", "1.3.0-beta.1", true},
+	}
+
+	for _, test := range tests {
+		r, err := NpmDialect.ParseRange(test.rangeStr)
+		is.NoError(err, "Range %s should parse", test.rangeStr)
+		if err != nil {
+			continue
+		}
+		is.Equal(test.shouldMatch, r.Contains(MustParse(test.version)), "Version %s against range %s", test.version, test.rangeStr)
+	}
+}
+
+func TestNpmDialectInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, input := range []string{">=1.0, <2.0", "!=1.0.0", "==1.0.0", "1.x.3", "blerg"} {
+		_, err := ParseNpmRange(input)
+		is.ErrorIs(err, ErrInvalidRangeToken, "Expected error for input: %q", input)
+	}
+	is.Equal("npm", NpmDialect.Name())
+
+	v, err := NpmDialect.(VersionDialect).ParseVersion("v1.2.3")
+	is.NoError(err)
+	is.Equal("1.2.3", v.String())
+	_, err = NpmDialect.(VersionDialect).ParseVersion("1.2")
+	is.Error(err)
+}
+
+func TestNpmDialectFormatRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rng      string
+		expected string
+	}{
+		{"^1.2.3", ">=1.2.3 <2.0.0-0"},
+		{"~1.2.0 || >=3.0.0", ">=1.2.0 <1.3.0-0 || >=3.0.0"},
+		{">=1.0.0 !=1.5.0", ">=1.0.0 <1.5.0 || >1.5.0"},
+		{">=0.0.0-0", ">=0.0.0-0"},
+	}
+
+	for _, tt := range tests {
+		r := MustParseRange(tt.rng)
+		r.Prerelease = PrereleaseOptIn
+		s, err := Translate(r, NpmDialect)
+		is.NoError(err, tt.rng)
+		is.Equal(tt.expected, s, tt.rng)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// NuGetDialect parses NuGet version ranges, including floating versions.
+//
+// Supported syntax:
+//   - Minimum versions: "1.0" is ">=1.0.0"
+//   - Exact versions: "[1.0]"
+//   - Intervals with inclusive "[" "]" and exclusive "(" ")" bounds: "[1.0,2.0)", "(,1.0]", "[1.0,)"
+//   - Floating versions: "*", "1.*", "1.2.*", "1.2.3-beta*", "1.*-*", "*-*"
+//
+// Missing minor and patch components are treated as zero, as NuGet does when normalizing.
+// Ranges use PrereleaseExcluded unless a bound or floating version includes a pre-release,
+// in which case they use PrereleaseInclusive.
+//
+// Example:
+//
+//	r, err := semver.NuGetDialect.ParseRange("[1.0,2.0)")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.5.0"))) // Output: true
+var NuGetDialect Dialect = nugetDialect{}
+
+type nugetDialect struct{}
+
+// Name returns the name of the dialect.
+func (nugetDialect) Name() string {
+	return "nuget"
+}
+
+// ParseVersion parses a NuGet version, tolerating a "v" prefix and coercing missing minor
+// and patch components to zero.
+func (nugetDialect) ParseVersion(v string) (Version, error) {
+	return parseCoercedVersion(strings.TrimSpace(v))
+}
+
+// FormatRange formats a range as a NuGet interval, which can hold a single interval only.
+func (nugetDialect) FormatRange(r *VersionRange) (string, error) {
+	return formatBracketInterval(r, "nuget", "*", Version.String)
+}
+
+// ParseRange parses a NuGet version range into a VersionRange.
+func (nugetDialect) ParseRange(r string) (*VersionRange, error) {
+	s := strings.TrimSpace(r)
+	if s == "" {
+		return nil, invalidRangeToken(r)
+	}
+
+	var reqs []Requirement
+	var err error
+	switch s[0] {
+	case '[', '(':
+		reqs, err = parseInterval(s, "(", ")")
+	default:
+		reqs, err = parseNuGetFloating(s)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vr := &VersionRange{
+		Requirements: [][]Requirement{reqs},
+		Prerelease:   PrereleaseExcluded,
+	}
+	for _, req := range reqs {
+		if len(req.Ver.PreRelease) > 0 && (req.Op != OpLt || !req.Ver.IsMinimalPrerelease()) {
+			vr.Prerelease = PrereleaseInclusive
+		}
+	}
+
+	return vr, nil
+}
+
+// ParseNuGetRange parses a range expression using NuGetDialect.
+//
+// Example:
+//
+//	r, err := semver.ParseNuGetRange("1.2.*")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.2.9"))) // Output: true
+func ParseNuGetRange(r string) (*VersionRange, error) {
+	return NuGetDialect.ParseRange(r)
+}
+
+// parseNuGetFloating parses a minimum version, which may float with "*" in its numeric
+// components, its pre-release label, or both.
+func parseNuGetFloating(s string) ([]Requirement, error) {
+	core, label, hasLabel := strings.Cut(s, "-")
+
+	if !strings.Contains(s, "*") {
+		v, err := parseCoercedVersion(s)
+		if err != nil {
+			return nil, invalidRangeToken(s)
+		}
+		return []Requirement{{Op: OpGte, Ver: v}}, nil
+	}
+
+	p, err := parsePartialVersion(core)
+	if err != nil || (p.parts < 3 && !strings.HasSuffix(core, "*")) {
+		return nil, invalidRangeToken(s)
+	}
+
+	var lower Version
+	var upper *Version
+	switch p.parts {
+	case 0:
+	case 3:
+		lower = p.Version
+	default:
+		lower = p.floor()
+		ceiling := p.ceiling()
+		upper = &ceiling
+	}
+
+	if hasLabel {
+		if p.parts < 3 && label != "*" {
+			return nil, invalidRangeToken(s)
+		}
+		if !strings.HasSuffix(label, "*") {
+			return nil, invalidRangeToken(s)
+		}
+
+		prefix := strings.TrimSuffix(label, "*")
+		if p.parts == 3 {
+			lower, upper, err = nugetPrereleaseBounds(lower, prefix)
+			if err != nil {
+				return nil, invalidRangeToken(s)
+			}
+		} else {
+			lower = minimalPrerelease(lower.Major, lower.Minor, lower.Patch)
+		}
+	} else if p.parts == 3 {
+		return nil, invalidRangeToken(s)
+	}
+
+	reqs := []Requirement{{Op: OpGte, Ver: lower}}
+	if upper != nil {
+		reqs = append(reqs, Requirement{Op: OpLt, Ver: *upper})
+	}
+	return reqs, nil
+}
+
+// nugetPrereleaseBounds returns the bounds covering every pre-release of release that starts
+// with prefix, such as "beta" for "1.2.3-beta*".
+func nugetPrereleaseBounds(release Version, prefix string) (Version, *Version, error) {
+	release.PreRelease = nil
+	if prefix == "" {
+		return minimalPrerelease(release.Major, release.Minor, release.Patch), &release, nil
+	}
+
+	trimmed := strings.TrimSuffix(prefix, ".")
+	lower, err := Parse(release.String() + "-" + trimmed)
+	if err != nil {
+		return Version{}, nil, err
+	}
+
+	next, ok := nextIdentifierPrefix(trimmed)
+	if !ok {
+		return lower, &release, nil
+	}
+	upper, err := Parse(release.String() + "-" + next)
+	if err != nil {
+		return lower, &release, nil
+	}
+	return lower, &upper, nil
+}
+
+// nextIdentifierPrefix returns the lowest pre-release string that sorts after every string
+// starting with prefix, by incrementing its last character within the identifier alphabet.
+func nextIdentifierPrefix(prefix string) (string, bool) {
+	b := []byte(prefix)
+	for i := len(b) - 1; i >= 0; i-- {
+		switch ch := b[i]; {
+		case ch == '-':
+			b[i] = '0'
+		case ch == '9':
+			b[i] = 'A'
+		case ch == 'Z':
+			b[i] = 'a'
+		case ch == 'z' || ch == '.':
+			continue
+		default:
+			b[i] = ch + 1
+		}
+		return string(b[:i+1]), true
+	}
+	return "", false
+}
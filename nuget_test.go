@@ -0,0 +1,99 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNuGetDialectContains(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rangeStr    string
+		version     string
+		shouldMatch bool
+	}{
+		{"1.0", "1.0.0", true},
+		{"1.0", "5.0.0", true},
+		{"1.0", "0.9.0", false},
+		{"[1.0]", "1.0.0", true},
+		{"[1.0]", "1.0.1", false},
+		{"[1.0,2.0]", "2.0.0", true},
+		{"[1.0,2.0)", "2.0.0", false},
+		{"(1.0,2.0)", "1.0.0", false},
+		{"(1.0,2.0)", "1.0.1", true},
+		{"(,1.0]", "1.0.0", true},
+		{"(,1.0]", "1.0.1", false},
+		{"[1.0,)", "9.0.0", true},
+		{"[1.0, 2.0)", "1.5.0-beta", false},
+		{"[1.0-beta, 2.0)", "1.5.0-beta", true},
+		{"*", "3.2.1", true},
+		{"*", "3.2.1-beta", false},
+		{"*-*", "3.2.1-beta", true},
+		{"1.*", "1.9.9", true},
+		{"1.*", "2.0.0", false},
+		{"1.2.*", "1.2.7", true},
+		{"1.2.*", "1.3.0", false},
+		{"1.*-*", "1.4.0-rc.1", true},
+		{"1.*-*", "2.0.0-rc.1", false},
+		{"1.2.3-beta*", "1.2.3-beta", true},
+		{"1.2.3-beta*", "1.2.3-beta.5", true},
+		{"1.2.3-beta*", "1.2.3-beta2", true},
+		{"1.2.3-beta*", "1.2.3-rc.1", false},
+		{"1.2.3-beta*", "1.2.3", false},
+		{"1.2.3-rc.*", "1.2.3-rc.4", true},
+		{"1.2.3-*", "1.2.3-alpha", true},
+		{"1.2.3-*", "1.2.3", false},
+	}
+
+	for _, test := range tests {
+		r, err := ParseNuGetRange(test.rangeStr)
+		is.NoError(err, "Range %s should parse", test.rangeStr)
+		if err != nil {
+			continue
+		}
+		is.Equal(test.shouldMatch, r.Contains(MustParse(test.version)), "Version %s against range %s", test.version, test.rangeStr)
+	}
+}
+
+func TestNuGetDialectInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, input := range []string{"", "[]", "(1.0)", "[1.0", "[,]", "1.2.3*", "1.*.3", "1.*-beta", "abc", "[a,b]"} {
+		_, err := NuGetDialect.ParseRange(input)
+		is.ErrorIs(err, ErrInvalidRangeToken, "Expected error for input: %q", input)
+	}
+	is.Equal("nuget", NuGetDialect.Name())
+}
+
+func TestNextIdentifierPrefix(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+		ok       bool
+	}{
+		{"beta", "betb", true},
+		{"rc9", "rcA", true},
+		{"rcZ", "rca", true},
+		{"az", "b", true},
+		{"a-", "a0", true},
+		{"zz", "", false},
+	}
+
+	for _, test := range tests {
+		next, ok := nextIdentifierPrefix(test.input)
+		is.Equal(test.ok, ok, "Input %s", test.input)
+		is.Equal(test.expected, next, "Input %s", test.input)
+	}
+}
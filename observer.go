@@ -0,0 +1,196 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"time"
+)
+
+// Observer receives telemetry about parsing and range evaluation, so that services can record
+// metrics such as parse counts, error rates by code, and evaluation latencies in one place.
+//
+// Observers are called synchronously on the goroutine doing the work, so they must be safe for
+// concurrent use and should return quickly.
+type Observer interface {
+	// ObserveParse is called once for every version parsed.
+	ObserveParse(e ParseEvent)
+
+	// ObserveEvaluation is called once for every call to VersionRange.Contains.
+	ObserveEvaluation(e EvaluationEvent)
+}
+
+// ParseEvent describes one version parse.
+//
+// The input is deliberately not included, since it may be untrusted and of unbounded
+// cardinality; Code is suitable as a metric label instead.
+type ParseEvent struct {
+	// Duration is the time spent parsing.
+	Duration time.Duration
+
+	// Err is the error returned by the parser, or nil on success.
+	Err error
+
+	// Code is the ErrorCode of Err: empty on success and a short, stable identifier otherwise.
+	Code string
+}
+
+// EvaluationEvent describes one evaluation of a VersionRange against a version.
+type EvaluationEvent struct {
+	// Range is the range that was evaluated.
+	Range *VersionRange
+
+	// Version is the version that was tested.
+	Version Version
+
+	// Matched reports whether the version satisfied the range.
+	Matched bool
+
+	// Duration is the time spent evaluating.
+	Duration time.Duration
+}
+
+// ObserverFuncs adapts a pair of functions to the Observer interface. Either may be nil, in
+// which case the corresponding events are ignored.
+//
+// Example:
+//
+//	o := semver.ObserverFuncs{
+//	    Parse: func(e semver.ParseEvent) {
+//	        parses.WithLabelValues(e.Code).Inc()
+//	    },
+//	}
+//	parser, _ := semver.NewParser(semver.WithObserver(o))
+type ObserverFuncs struct {
+	Parse      func(ParseEvent)
+	Evaluation func(EvaluationEvent)
+}
+
+// ObserveParse calls f.Parse if it is set.
+func (f ObserverFuncs) ObserveParse(e ParseEvent) {
+	if f.Parse != nil {
+		f.Parse(e)
+	}
+}
+
+// ObserveEvaluation calls f.Evaluation if it is set.
+func (f ObserverFuncs) ObserveEvaluation(e EvaluationEvent) {
+	if f.Evaluation != nil {
+		f.Evaluation(e)
+	}
+}
+
+// errorCodes maps the sentinel errors returned by the parser to their codes.
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{ErrEmptyVersionString, "empty-version"},
+	{ErrMissingVersionElements, "missing-elements"},
+	{ErrInvalidNumericIdentifier, "invalid-numeric"},
+	{ErrLeadingZeroInNumericIdentifier, "leading-zero"},
+	{ErrInvalidCharacterInIdentifier, "invalid-character"},
+	{ErrInvalidPrereleaseIdentifier, "invalid-prerelease"},
+	{ErrEmptyPrereleaseIdentifier, "empty-prerelease"},
+	{ErrEmptyBuildMetadata, "empty-build"},
+	{ErrInvalidBuildMetadataIdentifier, "invalid-build"},
+	{ErrUnexpectedCharacter, "unexpected-character"},
+	{ErrUnexpectedEndOfInput, "unexpected-end"},
+	{ErrInvalidRangeToken, "invalid-range-token"},
+}
+
+// ErrorCode returns a short, stable identifier for a parse error, suitable as a metric label.
+//
+// It returns an empty string for nil, the code of the first parser sentinel error that err
+// wraps, such as "leading-zero" for ErrLeadingZeroInNumericIdentifier, and "other" for any
+// other error.
+//
+// Example:
+//
+//	_, err := semver.Parse("01.2.3")
+//	fmt.Println(semver.ErrorCode(err)) // Output: leading-zero
+func ErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return "other"
+}
+
+// WithObserver sets an Observer that is notified of every version the parser parses.
+//
+// Ranges parsed with ParseRange report their evaluations to the observer of DefaultParser, so
+// installing an observing parser as DefaultParser instruments a service without changing its
+// call sites. Use VersionRange.Observed to instrument other ranges.
+//
+// Parameters:
+// - o: The Observer to notify, or nil to disable telemetry.
+//
+// Returns:
+// - Option: A functional option that can be passed to a configuration function to modify behavior.
+//
+// Example usage:
+//
+//	parser, err := NewParser(WithObserver(metrics))
+//	if err != nil {
+//	    log.Fatalf("Failed to create parser: %v", err)
+//	}
+//	DefaultParser = parser
+func WithObserver(o Observer) Option {
+	return func(c *ConfigOptions) {
+		c.Observer = o
+	}
+}
+
+// Observed returns a copy of the range that reports every evaluation by Contains to o.
+// Passing nil returns a copy that reports nothing.
+//
+// Example:
+//
+//	r := semver.MustParseRange("^1.2.0").Observed(metrics)
+//	r.Contains(semver.MustParse("1.4.0")) // reported to metrics
+func (vr *VersionRange) Observed(o Observer) *VersionRange {
+	observed := *vr
+	observed.observer = o
+	return &observed
+}
+
+// observeParse parses version as Parse does and reports the outcome to the parser's observer.
+func (p *parser) observeParse(version string) (Version, error) {
+	start := time.Now()
+	v, err := p.parse(version, nil, nil)
+	p.config.observer.ObserveParse(ParseEvent{
+		Duration: time.Since(start),
+		Err:      err,
+		Code:     ErrorCode(err),
+	})
+	return v, err
+}
+
+// observeContains evaluates the range as Contains does and reports the outcome to its observer.
+func (vr *VersionRange) observeContains(v Version) bool {
+	start := time.Now()
+	matched := vr.contains(v)
+	vr.observer.ObserveEvaluation(EvaluationEvent{
+		Range:    vr,
+		Version:  v,
+		Matched:  matched,
+		Duration: time.Since(start),
+	})
+	return matched
+}
+
+// defaultObserver returns the observer of DefaultParser, if any.
+func defaultObserver() Observer {
+	if p, ok := DefaultParser.(*parser); ok {
+		return p.config.observer
+	}
+	return nil
+}
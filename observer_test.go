@@ -0,0 +1,167 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingObserver records the events it receives.
+type recordingObserver struct {
+	mu          sync.Mutex
+	parses      []ParseEvent
+	evaluations []EvaluationEvent
+}
+
+func (o *recordingObserver) ObserveParse(e ParseEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.parses = append(o.parses, e)
+}
+
+func (o *recordingObserver) ObserveEvaluation(e EvaluationEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.evaluations = append(o.evaluations, e)
+}
+
+func TestErrorCode(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("", ErrorCode(nil))
+	is.Equal("other", ErrorCode(errors.New("boom")))
+	is.Equal("invalid-range-token", ErrorCode(fmt.Errorf("%w: x", ErrInvalidRangeToken)))
+
+	tests := map[string]string{
+		"":          "empty-version",
+		"1.2":       "missing-elements",
+		"01.2.3":    "leading-zero",
+		"1.2.3-":    "empty-prerelease",
+		"1.2.3+":    "empty-build",
+		"1.2.3-a_b": "invalid-character",
+	}
+	for input, code := range tests {
+		_, err := Parse(input)
+		is.Equal(code, ErrorCode(err), input)
+	}
+}
+
+func TestWithObserverParse(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	o := &recordingObserver{}
+	p, err := NewParser(WithObserver(o))
+	is.NoError(err)
+
+	_, err = p.Parse("1.2.3")
+	is.NoError(err)
+	_, err = p.Parse("1.2.3-rc.1")
+	is.NoError(err)
+	_, err = p.Parse("01.2.3")
+	is.Error(err)
+
+	is.Len(o.parses, 3)
+	is.Equal("", o.parses[0].Code)
+	is.Equal("", o.parses[1].Code)
+	is.ErrorIs(o.parses[2].Err, ErrLeadingZeroInNumericIdentifier)
+	is.Equal("leading-zero", o.parses[2].Code)
+	is.Empty(o.evaluations)
+}
+
+func TestVersionRangeObserved(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	o := &recordingObserver{}
+	base := MustParseRange("^1.2.0")
+	r := base.Observed(o)
+
+	is.True(r.Contains(MustParse("1.4.0")))
+	is.False(r.Contains(MustParse("2.0.0")))
+	is.True(base.Contains(MustParse("1.4.0")))
+
+	is.Len(o.evaluations, 2)
+	is.Same(r, o.evaluations[0].Range)
+	is.Equal("1.4.0", o.evaluations[0].Version.String())
+	is.True(o.evaluations[0].Matched)
+	is.False(o.evaluations[1].Matched)
+
+	is.True(r.Observed(nil).Contains(MustParse("1.4.0")))
+	is.Len(o.evaluations, 2)
+}
+
+func TestVersionRangeObservedContainsString(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	o := &recordingObserver{}
+	r := MustParseRange("^1.2.0").Observed(o)
+
+	ok, err := r.ContainsString("1.4.7+build.9")
+	is.NoError(err)
+	is.True(ok)
+	ok, err = r.ContainsString("2.0.0")
+	is.NoError(err)
+	is.False(ok)
+	_, err = r.ContainsString("1.4")
+	is.Error(err)
+
+	is.Len(o.evaluations, 2)
+	is.Same(r, o.evaluations[0].Range)
+	is.Equal("1.4.7+build.9", o.evaluations[0].Version.String())
+	is.True(o.evaluations[0].Matched)
+	is.Equal("2.0.0", o.evaluations[1].Version.String())
+	is.False(o.evaluations[1].Matched)
+}
+
+func TestVersionRangeObservedCombined(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	o := &recordingObserver{}
+	r := MustParseRange("^1.2.0").Observed(o)
+
+	or := r.OR(MustParseRange("^3.0.0"))
+	is.True(or.Contains(MustParse("3.1.0")))
+
+	and := r.AND(MustParseRange("<1.5.0"))
+	is.False(and.Contains(MustParse("1.6.0")))
+
+	is.Len(o.evaluations, 2)
+	is.Same(or, o.evaluations[0].Range)
+	is.True(o.evaluations[0].Matched)
+	is.Same(and, o.evaluations[1].Range)
+	is.False(o.evaluations[1].Matched)
+}
+
+// TestDefaultParserObserver is not parallel because it replaces DefaultParser.
+func TestDefaultParserObserver(t *testing.T) {
+	is := assert.New(t)
+
+	o := &recordingObserver{}
+	saved := DefaultParser
+	defer func() { DefaultParser = saved }()
+
+	var err error
+	DefaultParser, err = NewParser(WithObserver(o))
+	is.NoError(err)
+
+	r, err := ParseRange(">=1.0.0 <2.0.0")
+	is.NoError(err)
+	is.Len(o.parses, 2)
+
+	is.True(r.Contains(MustParse("1.5.0")))
+	is.Len(o.parses, 3)
+	is.Len(o.evaluations, 1)
+	is.True(o.evaluations[0].Matched)
+}
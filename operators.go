@@ -0,0 +1,83 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// OperatorInfo describes an Operator for display, so that user interfaces such as dropdowns of
+// supported operators can be built from the package rather than from a hard-coded list.
+type OperatorInfo struct {
+	// Op is the operator.
+	Op Operator `json:"op"`
+
+	// Symbol is the operator as written in a range, such as ">=".
+	Symbol string `json:"symbol"`
+
+	// Arity is the number of versions the operator takes in a requirement. Every current
+	// operator compares against a single version.
+	Arity int `json:"arity"`
+
+	// Name is a short lowercase name, such as "greater than or equal".
+	Name string `json:"name"`
+
+	// Description explains which versions the operator matches.
+	Description string `json:"description"`
+}
+
+// operatorInfos lists the operators in the order Operators returns them.
+var operatorInfos = []OperatorInfo{
+	{OpEq, string(OpEq), 1, "equal", "Matches exactly the given version."},
+	{OpNeq, string(OpNeq), 1, "not equal", "Matches every version except the given one."},
+	{OpGt, string(OpGt), 1, "greater than", "Matches versions with higher precedence than the given version."},
+	{OpGte, string(OpGte), 1, "greater than or equal", "Matches the given version and versions with higher precedence."},
+	{OpLt, string(OpLt), 1, "less than", "Matches versions with lower precedence than the given version."},
+	{OpLte, string(OpLte), 1, "less than or equal", "Matches the given version and versions with lower precedence."},
+	{OpCaret, string(OpCaret), 1, "compatible with", "Matches the given version and later versions that do not change its left-most non-zero component, e.g. ^1.2.3 is >=1.2.3 <2.0.0-0."},
+	{OpTilde, string(OpTilde), 1, "approximately", "Matches the given version and later patch versions, e.g. ~1.2.3 is >=1.2.3 <1.3.0-0."},
+}
+
+// Operators returns every defined Operator, in a stable order suitable for display.
+//
+// The returned slice is a copy and may be modified.
+//
+// Example:
+//
+//	fmt.Println(semver.Operators()) // Output: [= != > >= < <= ^ ~]
+func Operators() []Operator {
+	ops := make([]Operator, len(operatorInfos))
+	for i, info := range operatorInfos {
+		ops[i] = info.Op
+	}
+	return ops
+}
+
+// OperatorInfos returns the description of every defined Operator, in the order of Operators.
+//
+// The returned slice is a copy and may be modified.
+//
+// Example:
+//
+//	for _, info := range semver.OperatorInfos() {
+//	    fmt.Printf("%-2s %s\n", info.Symbol, info.Name)
+//	}
+func OperatorInfos() []OperatorInfo {
+	infos := make([]OperatorInfo, len(operatorInfos))
+	copy(infos, operatorInfos)
+	return infos
+}
+
+// Info returns the description of the operator, or false if it is not a defined operator.
+//
+// Example:
+//
+//	info, ok := semver.OpTilde.Info()
+//	fmt.Println(info.Name, ok) // Output: approximately true
+func (op Operator) Info() (OperatorInfo, bool) {
+	for _, info := range operatorInfos {
+		if info.Op == op {
+			return info, true
+		}
+	}
+	return OperatorInfo{}, false
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOperators(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ops := Operators()
+	is.Equal([]Operator{OpEq, OpNeq, OpGt, OpGte, OpLt, OpLte, OpCaret, OpTilde}, ops)
+
+	// Every listed operator is valid and parses in a range.
+	for _, op := range ops {
+		is.True(op.IsValid(), op)
+		r, err := ParseRange(string(op) + "1.2.3")
+		if is.NoError(err, op) {
+			is.Equal(op, r.Requirements[0][0].Op)
+		}
+	}
+
+	// The result is a copy.
+	ops[0] = "?"
+	is.Equal(OpEq, Operators()[0])
+}
+
+func TestOperatorInfos(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	infos := OperatorInfos()
+	is.Len(infos, len(Operators()))
+	for i, info := range infos {
+		is.Equal(Operators()[i], info.Op)
+		is.Equal(string(info.Op), info.Symbol)
+		is.Equal(1, info.Arity)
+		is.NotEmpty(info.Name)
+		is.NotEmpty(info.Description)
+	}
+
+	infos[0].Name = "changed"
+	is.Equal("equal", OperatorInfos()[0].Name)
+
+	data, err := json.Marshal(infos[3])
+	is.NoError(err)
+	is.JSONEq(`{"op":">=","symbol":">=","arity":1,"name":"greater than or equal","description":"Matches the given version and versions with higher precedence."}`, string(data))
+}
+
+func TestOperatorInfo(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	info, ok := OpCaret.Info()
+	is.True(ok)
+	is.Equal("compatible with", info.Name)
+
+	_, ok = Operator("=>").Info()
+	is.False(ok)
+}
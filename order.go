@@ -0,0 +1,100 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// Compare returns -1 if a has lower precedence than b, +1 if it has higher precedence, and 0
+// otherwise. It has the signature of cmp.Compare, so it can be passed directly to
+// slices.SortFunc, slices.BinarySearchFunc, and ordered containers that take a comparator.
+//
+// Example:
+//
+//	versions := []semver.Version{semver.MustParse("2.0.0"), semver.MustParse("1.0.0")}
+//	slices.SortFunc(versions, semver.Compare)
+//	fmt.Println(versions) // Output: [1.0.0 2.0.0]
+func Compare(a, b Version) int {
+	return a.Compare(b)
+}
+
+// Less reports whether a has lower precedence than b. It can be passed directly to ordered
+// containers that take a less function, such as the generic B-trees and skip lists found in
+// common container libraries.
+//
+// Example:
+//
+//	tree := btree.NewG(32, semver.Less)
+//	tree.ReplaceOrInsert(semver.MustParse("1.2.3"))
+func Less(a, b Version) bool {
+	return a.Compare(b) < 0
+}
+
+// Less reports whether v has lower precedence than other. It is equivalent to LessThan, and
+// lets Version satisfy ordered-collection contracts that require a Less method.
+//
+// Example:
+//
+//	v1 := semver.MustParse("1.2.3")
+//	v2 := semver.MustParse("1.2.4")
+//	fmt.Println(v1.Less(v2)) // Output: true
+func (v Version) Less(other Version) bool {
+	return v.Compare(other) < 0
+}
+
+// ComparePointers is like Compare for pointers to versions, as held in Versions. A nil
+// pointer has lower precedence than any version, and two nil pointers are equal.
+//
+// Example:
+//
+//	a, b := semver.MustParse("2.0.0"), semver.MustParse("1.0.0")
+//	versions := semver.Versions{&a, nil, &b}
+//	slices.SortFunc(versions, semver.ComparePointers)
+//	fmt.Println(versions) // Output: [<nil> 1.0.0 2.0.0]
+func ComparePointers(a, b *Version) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	default:
+		return a.Compare(*b)
+	}
+}
+
+// LessPointers is like Less for pointers to versions, ordering nil pointers as ComparePointers does.
+func LessPointers(a, b *Version) bool {
+	return ComparePointers(a, b) < 0
+}
+
+// CompareBy returns a comparator, as described by Compare, that orders values of any type by
+// the version that key extracts from them. It adapts ordered containers of records, such as
+// releases or artifacts, to version precedence.
+//
+// Example:
+//
+//	type Release struct {
+//	    Name    string
+//	    Version semver.Version
+//	}
+//	byVersion := semver.CompareBy(func(r Release) semver.Version { return r.Version })
+//	slices.SortFunc(releases, byVersion)
+func CompareBy[T any](key func(T) Version) func(a, b T) int {
+	return func(a, b T) int {
+		return key(a).Compare(key(b))
+	}
+}
+
+// LessBy returns a less function, as described by Less, that orders values of any type by the
+// version that key extracts from them.
+//
+// Example:
+//
+//	tree := btree.NewG(32, semver.LessBy(func(r Release) semver.Version { return r.Version }))
+func LessBy[T any](key func(T) Version) func(a, b T) bool {
+	return func(a, b T) bool {
+		return key(a).Compare(key(b)) < 0
+	}
+}
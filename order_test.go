@@ -0,0 +1,75 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareAndLess(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	versions := []Version{MustParse("2.0.0"), MustParse("1.0.0-rc.1"), MustParse("1.0.0"), MustParse("1.0.0+build")}
+	slices.SortStableFunc(versions, Compare)
+	is.Equal("[1.0.0-rc.1 1.0.0 1.0.0+build 2.0.0]", fmt.Sprint(versions))
+
+	i, found := slices.BinarySearchFunc(versions, MustParse("1.0.0"), Compare)
+	is.True(found)
+	is.Equal(1, i)
+
+	is.True(Less(MustParse("1.0.0-rc.1"), MustParse("1.0.0")))
+	is.False(Less(MustParse("1.0.0"), MustParse("1.0.0+build")))
+	is.True(MustParse("1.2.3").Less(MustParse("1.2.4")))
+	is.False(MustParse("1.2.4").Less(MustParse("1.2.3")))
+
+	var less func(a, b Version) bool = Less
+	sort.Slice(versions, func(i, j int) bool { return less(versions[j], versions[i]) })
+	is.Equal("2.0.0", versions[0].String())
+}
+
+func TestComparePointers(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	a, b := MustParse("2.0.0"), MustParse("1.0.0")
+	versions := Versions{&a, nil, &b, nil}
+	slices.SortFunc(versions, ComparePointers)
+	is.Equal("[<nil> <nil> 1.0.0 2.0.0]", fmt.Sprint(versions))
+
+	is.Equal(0, ComparePointers(nil, nil))
+	is.True(LessPointers(nil, &b))
+	is.False(LessPointers(&b, nil))
+	is.True(LessPointers(&b, &a))
+}
+
+func TestCompareByAndLessBy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	type release struct {
+		name    string
+		version Version
+	}
+	key := func(r release) Version { return r.version }
+
+	releases := []release{
+		{"gamma", MustParse("3.0.0")},
+		{"alpha", MustParse("1.0.0")},
+		{"beta", MustParse("2.0.0-rc.1")},
+	}
+	slices.SortFunc(releases, CompareBy(key))
+	is.Equal([]string{"alpha", "beta", "gamma"}, []string{releases[0].name, releases[1].name, releases[2].name})
+
+	less := LessBy(key)
+	is.True(less(releases[0], releases[1]))
+	is.False(less(releases[2], releases[1]))
+}
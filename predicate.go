@@ -0,0 +1,39 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// Predicate returns a function reporting whether a version satisfies the range, so the range
+// can be passed to generic filter utilities, slices.IndexFunc, and APIs that expect a
+// func(Version) bool rather than a *VersionRange.
+//
+// The predicate shares the range; later changes to the range are visible through it.
+//
+// Example:
+//
+//	versions := []semver.Version{semver.MustParse("1.2.0"), semver.MustParse("2.1.0")}
+//	inRange := semver.MustParseRange(">=2.0.0").Predicate()
+//	versions = slices.DeleteFunc(versions, inRange)
+//	fmt.Println(versions) // Output: [1.2.0]
+func (vr *VersionRange) Predicate() func(Version) bool {
+	return vr.Contains
+}
+
+// ParsePredicate parses a range with ParseRange and returns its Predicate.
+//
+// Example:
+//
+//	stable, err := semver.ParsePredicate(">=1.0.0 <2.0.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(stable(semver.MustParse("1.4.0"))) // Output: true
+func ParsePredicate(r string) (func(Version) bool, error) {
+	vr, err := ParseRange(r)
+	if err != nil {
+		return nil, err
+	}
+	return vr.Predicate(), nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionRangePredicate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	versions := []Version{MustParse("1.2.0"), MustParse("2.1.0"), MustParse("2.3.0-rc.1"), MustParse("3.0.0")}
+	pred := MustParseRange(">=2.0.0 <3.0.0").Predicate()
+
+	is.Equal(1, slices.IndexFunc(versions, pred))
+	is.Equal([]Version{MustParse("1.2.0"), MustParse("3.0.0")}, slices.DeleteFunc(slices.Clone(versions), pred))
+
+	r := MustParseRange(">=2.0.0 <3.0.0")
+	pred = r.Predicate()
+	r.Prerelease = PrereleaseExcluded
+	is.False(pred(MustParse("2.3.0-rc.1")))
+}
+
+func TestParsePredicate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	pred, err := ParsePredicate(">=1.0.0 <2.0.0 || >=3.0.0")
+	is.NoError(err)
+	is.True(pred(MustParse("1.4.0")))
+	is.False(pred(MustParse("2.4.0")))
+	is.True(pred(MustParse("3.0.0")))
+
+	pred, err = ParsePredicate(">=bogus")
+	is.Error(err)
+	is.Nil(pred)
+}
@@ -72,6 +72,18 @@ func (v PrereleaseVersion) IsNumeric() bool {
 	return v.isNumeric
 }
 
+// Numeric returns the value of a numeric prerelease version. The boolean is false, and the
+// value zero, if the prerelease version is alphanumeric.
+//
+// Example:
+//
+//	v, _ := semver.NewPrereleaseVersion("42")
+//	n, ok := v.Numeric()
+//	fmt.Println(n, ok) // Output: 42 true
+func (v PrereleaseVersion) Numeric() (uint64, bool) {
+	return v.partNumeric, v.isNumeric
+}
+
 // Compare compares two PrereleaseVersion instances.
 //
 // Returns:
@@ -131,3 +143,46 @@ func (v PrereleaseVersion) String() string {
 
 	return v.partString
 }
+
+// PrereleaseLen returns the number of pre-release identifiers of the version, which is zero
+// for a release.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3-rc.2")
+//	fmt.Println(v.PrereleaseLen()) // Output: 2
+func (v Version) PrereleaseLen() int {
+	return len(v.PreRelease)
+}
+
+// PrereleaseAt returns the pre-release identifier at index i. The boolean result is false if i
+// is out of range.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3-rc.2")
+//	id, ok := v.PrereleaseAt(0)
+//	fmt.Println(id, ok) // Output: rc true
+func (v Version) PrereleaseAt(i int) (PrereleaseVersion, bool) {
+	if i < 0 || i >= len(v.PreRelease) {
+		return PrereleaseVersion{}, false
+	}
+	return v.PreRelease[i], true
+}
+
+// PrereleaseNumericAt returns the value of the numeric pre-release identifier at index i, such
+// as the release candidate number of "1.2.3-rc.2". The boolean result is false if i is out of
+// range or the identifier is alphanumeric.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3-rc.2")
+//	n, ok := v.PrereleaseNumericAt(1)
+//	fmt.Println(n, ok) // Output: 2 true
+func (v Version) PrereleaseNumericAt(i int) (uint64, bool) {
+	id, ok := v.PrereleaseAt(i)
+	if !ok || !id.isNumeric {
+		return 0, false
+	}
+	return id.partNumeric, true
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrereleaseVersionNumeric(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v, err := NewPrereleaseVersion("42")
+	is.NoError(err)
+	n, ok := v.Numeric()
+	is.True(ok)
+	is.Equal(uint64(42), n)
+
+	v, err = NewPrereleaseVersion("18446744073709551615")
+	is.NoError(err)
+	n, ok = v.Numeric()
+	is.True(ok)
+	is.Equal(uint64(math.MaxUint64), n)
+
+	v, err = NewPrereleaseVersion("rc1")
+	is.NoError(err)
+	n, ok = v.Numeric()
+	is.False(ok)
+	is.Zero(n)
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrInvalidPURL indicates that a string is not a valid package URL.
+	ErrInvalidPURL = errors.New("invalid package URL")
+
+	// ErrMissingPURLVersion indicates that a package URL has no version.
+	ErrMissingPURLVersion = errors.New("package URL has no version")
+)
+
+// purlScheme is the scheme of a package URL.
+const purlScheme = "pkg:"
+
+// purlDialects maps package URL types to the dialects whose version rules they follow.
+var purlDialects = map[string]Dialect{
+	"composer": ComposerDialect,
+	"maven":    GradleDialect,
+	"nuget":    NuGetDialect,
+}
+
+// purlStrictTypes lists the package URL types whose versions must be strict semantic versions.
+var purlStrictTypes = map[string]bool{
+	"cargo":  true,
+	"golang": true,
+	"hex":    true,
+	"npm":    true,
+	"pub":    true,
+}
+
+// PURLDialect returns the Dialect whose version and range rules apply to a package URL type,
+// such as NuGetDialect for "nuget" and GradleDialect for "maven". The type is case-insensitive.
+//
+// Example:
+//
+//	d, ok := semver.PURLDialect("nuget")
+//	fmt.Println(d.Name(), ok) // Output: nuget true
+func PURLDialect(typ string) (Dialect, bool) {
+	d, ok := purlDialects[strings.ToLower(typ)]
+	return d, ok
+}
+
+// PURLVersion extracts and parses the version of a package URL, such as
+// "pkg:golang/github.com/x/y@v1.2.3", following the version rules of its package type.
+//
+// The version is percent-decoded and then parsed as follows:
+//   - golang: a strict semantic version with the required "v" prefix.
+//   - cargo, hex, npm, and pub: a strict semantic version.
+//   - Types with a PURLDialect: the dialect's ParseVersion, so "pkg:nuget/Foo@1.2" is 1.2.0.
+//   - Other types: ParseLenient.
+//
+// Returns an error wrapping ErrInvalidPURL if the package URL is malformed,
+// ErrMissingPURLVersion if it has no version, or the parse error if the version does not follow
+// the rules of its type.
+//
+// Example:
+//
+//	v, err := semver.PURLVersion("pkg:golang/github.com/x/y@v1.2.3")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.3
+func PURLVersion(purl string) (Version, error) {
+	p, err := splitPURL(purl)
+	if err != nil {
+		return Version{}, err
+	}
+	if !p.hasVersion {
+		return Version{}, fmt.Errorf("%w: %s", ErrMissingPURLVersion, purl)
+	}
+
+	raw, err := url.PathUnescape(p.version)
+	if err != nil || raw == "" {
+		return Version{}, fmt.Errorf("%w: invalid version %q", ErrInvalidPURL, p.version)
+	}
+	v, err := parsePURLVersion(p.typ, raw)
+	if err != nil {
+		return Version{}, fmt.Errorf("%s version %q: %w", p.typ, raw, err)
+	}
+	return v, nil
+}
+
+// WithPURLVersion returns the package URL with its version set to v, adding the version if
+// there is none and preserving the qualifiers and subpath.
+//
+// The version is written the way its package type expects, with a "v" prefix for golang, and
+// is percent-encoded, so build metadata becomes "%2B".
+//
+// Returns an error wrapping ErrInvalidPURL if the package URL is malformed, or the parse error
+// if the written version does not follow the rules of its type, as checked by PURLVersion.
+//
+// Example:
+//
+//	s, err := semver.WithPURLVersion("pkg:golang/github.com/x/y@v1.2.3?goos=linux", semver.MustParse("1.3.0"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(s) // Output: pkg:golang/github.com/x/y@v1.3.0?goos=linux
+func WithPURLVersion(purl string, v Version) (string, error) {
+	p, err := splitPURL(purl)
+	if err != nil {
+		return "", err
+	}
+
+	raw := v.String()
+	if p.typ == "golang" {
+		raw = "v" + raw
+	}
+	if _, err := parsePURLVersion(p.typ, raw); err != nil {
+		return "", fmt.Errorf("%s version %q: %w", p.typ, raw, err)
+	}
+
+	escaped := strings.ReplaceAll(url.PathEscape(raw), "+", "%2B")
+	return p.name + "@" + escaped + p.suffix, nil
+}
+
+// parsePURLVersion parses a decoded package URL version by the rules of its lowercased type.
+func parsePURLVersion(typ, raw string) (Version, error) {
+	if typ == "golang" {
+		if !strings.HasPrefix(raw, "v") {
+			return Version{}, fmt.Errorf("%w: missing \"v\" prefix", ErrUnexpectedCharacter)
+		}
+		raw = raw[1:]
+	}
+	if purlStrictTypes[typ] {
+		return Parse(raw)
+	}
+	if d, ok := purlDialects[typ].(VersionDialect); ok {
+		return d.ParseVersion(raw)
+	}
+	v, _, err := ParseLenient(raw)
+	return v, err
+}
+
+// purlParts is a package URL split around its version.
+type purlParts struct {
+	// typ is the lowercased package type, such as "npm".
+	typ string
+
+	// name is everything before the version, such as "pkg:npm/%40scope/name".
+	name string
+
+	// version is the percent-encoded version, if hasVersion is true.
+	version    string
+	hasVersion bool
+
+	// suffix is the qualifiers and subpath, including their "?" and "#" separators.
+	suffix string
+}
+
+// splitPURL splits a package URL of the form "pkg:type/namespace/name@version?qualifiers#subpath".
+func splitPURL(purl string) (purlParts, error) {
+	if len(purl) < len(purlScheme) || !strings.EqualFold(purl[:len(purlScheme)], purlScheme) {
+		return purlParts{}, fmt.Errorf("%w: missing %q scheme: %s", ErrInvalidPURL, purlScheme, purl)
+	}
+
+	var p purlParts
+	rest := purl
+	if i := strings.IndexAny(rest, "?#"); i >= 0 {
+		rest, p.suffix = rest[:i], rest[i:]
+	}
+
+	// The version follows the last "@" after the last "/", so that an unencoded npm scope such
+	// as "@angular" is not mistaken for one.
+	if i := strings.LastIndexByte(rest, '@'); i > strings.LastIndexByte(rest, '/') {
+		rest, p.version, p.hasVersion = rest[:i], rest[i+1:], true
+	}
+	p.name = rest
+
+	typ, name, ok := strings.Cut(strings.TrimLeft(rest[len(purlScheme):], "/"), "/")
+	if !ok || typ == "" || strings.Trim(name, "/") == "" {
+		return purlParts{}, fmt.Errorf("%w: missing type or name: %s", ErrInvalidPURL, purl)
+	}
+	p.typ = strings.ToLower(typ)
+	return p, nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPURLVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		purl     string
+		expected string
+	}{
+		{"pkg:golang/github.com/x/y@v1.2.3", "1.2.3"},
+		{"pkg:golang/github.com/x/y@v2.0.0%2Bincompatible?goos=linux#sub", "2.0.0+incompatible"},
+		{"pkg:npm/%40angular/core@16.0.0-rc.1", "16.0.0-rc.1"},
+		{"pkg:npm/@angular/core@16.0.0", "16.0.0"},
+		{"PKG:NuGet/Newtonsoft.Json@13.0", "13.0.0"},
+		{"pkg:maven/org.apache/commons@v3", "3.0.0"},
+		{"pkg:composer/laravel/framework@v10.1.2", "10.1.2"},
+		{"pkg:gem/rails@7.1", "7.1.0"},
+	}
+	for _, tt := range tests {
+		v, err := PURLVersion(tt.purl)
+		is.NoError(err, tt.purl)
+		is.Equal(tt.expected, v.String(), tt.purl)
+	}
+
+	_, err := PURLVersion("pkg:npm/@angular/core")
+	is.ErrorIs(err, ErrMissingPURLVersion)
+
+	for _, purl := range []string{"npm/left-pad@1.0.0", "pkg:npm", "pkg:npm/@1.0.0", "pkg:npm/x@%zz"} {
+		_, err = PURLVersion(purl)
+		is.ErrorIs(err, ErrInvalidPURL, purl)
+	}
+
+	for _, purl := range []string{"pkg:golang/github.com/x/y@1.2.3", "pkg:npm/left-pad@1.0", "pkg:nuget/Foo@1.*"} {
+		_, err = PURLVersion(purl)
+		is.Error(err, purl)
+		is.NotErrorIs(err, ErrInvalidPURL, purl)
+	}
+}
+
+func TestWithPURLVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		purl     string
+		version  string
+		expected string
+	}{
+		{"pkg:golang/github.com/x/y@v1.2.3?goos=linux", "1.3.0", "pkg:golang/github.com/x/y@v1.3.0?goos=linux"},
+		{"pkg:golang/github.com/x/y", "2.0.0+incompatible", "pkg:golang/github.com/x/y@v2.0.0%2Bincompatible"},
+		{"pkg:npm/@angular/core@15.0.0#dist", "16.0.0-rc.1", "pkg:npm/@angular/core@16.0.0-rc.1#dist"},
+		{"pkg:nuget/Foo@1.0", "1.2.0", "pkg:nuget/Foo@1.2.0"},
+	}
+	for _, tt := range tests {
+		got, err := WithPURLVersion(tt.purl, MustParse(tt.version))
+		is.NoError(err, tt.purl)
+		is.Equal(tt.expected, got, tt.purl)
+
+		v, err := PURLVersion(got)
+		is.NoError(err, got)
+		is.True(v.StrictEqual(MustParse(tt.version)), got)
+	}
+
+	_, err := WithPURLVersion("npm/left-pad", MustParse("1.0.0"))
+	is.ErrorIs(err, ErrInvalidPURL)
+}
+
+func TestPURLDialect(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	d, ok := PURLDialect("Maven")
+	is.True(ok)
+	is.Equal("gradle", d.Name())
+
+	_, ok = PURLDialect("npm")
+	is.False(ok)
+
+	for _, d := range []Dialect{HelmDialect, ComposerDialect, GradleDialect, NuGetDialect} {
+		vd, ok := d.(VersionDialect)
+		is.True(ok, d.Name())
+		v, err := vd.ParseVersion(" v1.2 ")
+		is.NoError(err, d.Name())
+		is.Equal("1.2.0", v.String(), d.Name())
+	}
+}
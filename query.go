@@ -0,0 +1,246 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// QuerySelector chooses which of the versions matched by a Query are returned.
+//
+// Supported Selectors:
+//   - SelectAll: Every matching version, in increasing order.
+//   - SelectLatest: The highest matching version.
+//   - SelectOldest: The lowest matching version.
+type QuerySelector int
+
+const (
+	SelectAll QuerySelector = iota
+	SelectLatest
+	SelectOldest
+)
+
+// String returns the query keyword of the QuerySelector.
+//
+// Example:
+//
+//	fmt.Println(semver.SelectLatest.String()) // Output: latest
+func (s QuerySelector) String() string {
+	switch s {
+	case SelectAll:
+		return "all"
+	case SelectLatest:
+		return "latest"
+	case SelectOldest:
+		return "oldest"
+	default:
+		return "unknown"
+	}
+}
+
+// Query is the parsed form of a version query, such as
+// "latest stable satisfying ^1.4 excluding 1.4.7".
+//
+// A query is a sequence of optional clauses, in this order:
+//   - A selector: "all" (the default), "latest", or "oldest".
+//   - A channel: "stable" (the default), "rc", or "prerelease", as defined by Channel.
+//   - "satisfying" followed by a range in HelmDialect syntax, such as "^1.4" or ">=1.2, <2".
+//   - "excluding" followed by one or more versions, separated by spaces or commas.
+//
+// Keywords are case-insensitive. Pre-release eligibility is decided by the channel alone, so the
+// constraint is evaluated with PrereleaseInclusive.
+type Query struct {
+	Selector   QuerySelector
+	Channel    Channel
+	Constraint string
+	Range      *VersionRange
+	Excluding  []Version
+}
+
+// ParseQuery parses a version query into a Query.
+//
+// Example:
+//
+//	q, err := semver.ParseQuery("latest stable satisfying ^1.4 excluding 1.4.7")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(q.Selector, q.Channel, q.Range.Contains(semver.MustParse("1.5.0"))) // Output: latest stable true
+func ParseQuery(q string) (*Query, error) {
+	query := &Query{Selector: SelectAll, Channel: ChannelStable}
+	fields := strings.Fields(q)
+	i := 0
+
+	if i < len(fields) {
+		if s, ok := querySelector(fields[i]); ok {
+			query.Selector = s
+			i++
+		}
+	}
+	if i < len(fields) {
+		if c, ok := queryChannel(fields[i]); ok {
+			query.Channel = c
+			i++
+		}
+	}
+
+	if i < len(fields) && strings.EqualFold(fields[i], "satisfying") {
+		end := i + 1
+		for end < len(fields) && !strings.EqualFold(fields[end], "excluding") {
+			end++
+		}
+		if end == i+1 {
+			return nil, fmt.Errorf("%w: missing range after %q", ErrInvalidQuery, fields[i])
+		}
+
+		query.Constraint = strings.Join(fields[i+1:end], " ")
+		r, err := HelmDialect.ParseRange(query.Constraint)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidQuery, err)
+		}
+		r.Prerelease = PrereleaseInclusive
+		query.Range = r
+		i = end
+	}
+
+	if i < len(fields) && strings.EqualFold(fields[i], "excluding") {
+		for _, field := range fields[i+1:] {
+			for _, s := range strings.Split(field, ",") {
+				if s == "" {
+					continue
+				}
+				v, err := Parse(s)
+				if err != nil {
+					return nil, fmt.Errorf("%w: excluded version %q: %w", ErrInvalidQuery, s, err)
+				}
+				query.Excluding = append(query.Excluding, v)
+			}
+		}
+		if len(query.Excluding) == 0 {
+			return nil, fmt.Errorf("%w: missing versions after %q", ErrInvalidQuery, fields[i])
+		}
+		i = len(fields)
+	}
+
+	if i < len(fields) {
+		return nil, fmt.Errorf("%w: unexpected %q", ErrInvalidQuery, fields[i])
+	}
+	return query, nil
+}
+
+// String returns the query in its canonical form, with every default clause spelled out.
+//
+// Example:
+//
+//	q, _ := semver.ParseQuery("LATEST satisfying ^1.4")
+//	fmt.Println(q) // Output: latest stable satisfying ^1.4
+func (q *Query) String() string {
+	var sb strings.Builder
+	sb.WriteString(q.Selector.String())
+	sb.WriteByte(' ')
+	sb.WriteString(q.Channel.String())
+	if q.Constraint != "" {
+		sb.WriteString(" satisfying ")
+		sb.WriteString(q.Constraint)
+	}
+	for i, v := range q.Excluding {
+		if i == 0 {
+			sb.WriteString(" excluding ")
+		} else {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(v.String())
+	}
+	return sb.String()
+}
+
+// Matches reports whether v passes the query's channel, constraint, and exclusions.
+//
+// Example:
+//
+//	q, _ := semver.ParseQuery("stable satisfying ^1.4 excluding 1.4.7")
+//	fmt.Println(q.Matches(semver.MustParse("1.4.7"))) // Output: false
+func (q *Query) Matches(v Version) bool {
+	if !q.Channel.Allows(v) {
+		return false
+	}
+	if q.Range != nil && !q.Range.Contains(v) {
+		return false
+	}
+	return !slices.ContainsFunc(q.Excluding, v.Equal)
+}
+
+// Eval returns the versions that match the query, in increasing order, narrowed down by the
+// query's selector. Nil entries in versions are ignored, and versions is not modified.
+//
+// Example:
+//
+//	q, _ := semver.ParseQuery("oldest rc")
+//	a, b := semver.MustParse("1.0.0-rc.1"), semver.MustParse("1.0.0-beta.1")
+//	fmt.Println(q.Eval(semver.Versions{&a, &b})) // Output: [1.0.0-rc.1]
+func (q *Query) Eval(versions Versions) Versions {
+	var matched Versions
+	for _, v := range versions {
+		if v != nil && q.Matches(*v) {
+			matched = append(matched, v)
+		}
+	}
+	Sort(matched)
+
+	if len(matched) == 0 {
+		return matched
+	}
+	switch q.Selector {
+	case SelectLatest:
+		return matched[len(matched)-1:]
+	case SelectOldest:
+		return matched[:1]
+	default:
+		return matched
+	}
+}
+
+// Query parses and evaluates a version query over the collection, as described by Query.
+//
+// Example:
+//
+//	a, b, c := semver.MustParse("1.4.6"), semver.MustParse("1.4.7"), semver.MustParse("2.0.0")
+//	versions := semver.Versions{&a, &b, &c}
+//
+//	result, err := versions.Query("latest stable satisfying ^1.4 excluding 1.4.7")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(result) // Output: [1.4.6]
+func (s Versions) Query(q string) (Versions, error) {
+	query, err := ParseQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	return query.Eval(s), nil
+}
+
+// querySelector returns the QuerySelector named by a query keyword.
+func querySelector(word string) (QuerySelector, bool) {
+	for _, s := range []QuerySelector{SelectAll, SelectLatest, SelectOldest} {
+		if strings.EqualFold(word, s.String()) {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// queryChannel returns the Channel named by a query keyword.
+func queryChannel(word string) (Channel, bool) {
+	for _, c := range []Channel{ChannelStable, ChannelRC, ChannelPrerelease} {
+		if strings.EqualFold(word, c.String()) {
+			return c, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	q, err := ParseQuery("latest stable satisfying ^1.4 excluding 1.4.7")
+	is.NoError(err)
+	is.Equal(SelectLatest, q.Selector)
+	is.Equal(ChannelStable, q.Channel)
+	is.Equal("^1.4", q.Constraint)
+	is.Equal([]Version{MustParse("1.4.7")}, q.Excluding)
+	is.Equal("latest stable satisfying ^1.4 excluding 1.4.7", q.String())
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", "all stable"},
+		{"latest", "latest stable"},
+		{"RC", "all rc"},
+		{"Oldest Prerelease satisfying >=1.2, <2", "oldest prerelease satisfying >=1.2, <2"},
+		{"satisfying 1.2 - 1.4 excluding 1.3.0,1.3.1 1.3.2", "all stable satisfying 1.2 - 1.4 excluding 1.3.0, 1.3.1, 1.3.2"},
+		{"excluding 1.0.0", "all stable excluding 1.0.0"},
+	}
+
+	for _, test := range tests {
+		q, err := ParseQuery(test.input)
+		is.NoError(err, "Query %q", test.input)
+		is.Equal(test.expected, q.String(), "Query %q", test.input)
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []string{
+		"newest",
+		"latest satisfying",
+		"latest satisfying ^x.y",
+		"latest excluding",
+		"latest excluding 1.4",
+		"stable latest",
+		"satisfying ^1.4 excluding 1.4.7 stable extra.",
+	}
+
+	for _, test := range tests {
+		_, err := ParseQuery(test)
+		is.ErrorIs(err, ErrInvalidQuery, "Query %q", test)
+	}
+}
+
+func TestVersionsQuery(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var versions Versions
+	for _, s := range []string{"2.0.0", "1.4.7", "1.3.9", "1.5.0-rc.1", "1.4.6", "1.5.0-beta.2", "1.4.0"} {
+		v := MustParse(s)
+		versions = append(versions, &v)
+	}
+	versions = append(versions, nil)
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"latest stable satisfying ^1.4 excluding 1.4.7", "[1.4.6]"},
+		{"latest satisfying ^1.4", "[1.4.7]"},
+		{"latest rc satisfying ^1.4", "[1.5.0-rc.1]"},
+		{"latest prerelease satisfying ~1.5.0-0", "[1.5.0-rc.1]"},
+		{"oldest prerelease satisfying ~1.5.0-0", "[1.5.0-beta.2]"},
+		{"satisfying >=1.4, <2", "[1.4.0 1.4.6 1.4.7]"},
+		{"all excluding 1.3.9, 2.0.0", "[1.4.0 1.4.6 1.4.7]"},
+		{"latest", "[2.0.0]"},
+		{"latest satisfying ^3", "[]"},
+	}
+
+	for _, test := range tests {
+		result, err := versions.Query(test.query)
+		is.NoError(err, "Query %q", test.query)
+		is.Equal(test.expected, fmt.Sprint(result), "Query %q", test.query)
+	}
+
+	is.Equal("2.0.0", versions[0].String(), "Query must not reorder the collection")
+
+	_, err := versions.Query("newest")
+	is.ErrorIs(err, ErrInvalidQuery)
+}
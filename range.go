@@ -6,6 +6,7 @@
 package semver
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -20,6 +21,8 @@ import (
 //   - OpLt ("<"): Less than
 //   - OpLte ("<="): Less than or equal
 //   - OpNeq ("!="): Not equal
+//   - OpCaret ("^"): Compatible with, allowing changes that do not modify the left-most non-zero component
+//   - OpTilde ("~"): Approximately, allowing patch-level changes
 type Operator string
 
 const (
@@ -29,8 +32,26 @@ const (
 	OpLt  Operator = "<"
 	OpLte Operator = "<="
 	OpNeq Operator = "!="
+
+	OpCaret Operator = "^"
+	OpTilde Operator = "~"
 )
 
+// IsValid reports whether the operator is one of the defined Operator constants.
+//
+// Example:
+//
+//	fmt.Println(semver.OpCaret.IsValid())        // Output: true
+//	fmt.Println(semver.Operator("=>").IsValid()) // Output: false
+func (op Operator) IsValid() bool {
+	switch op {
+	case OpEq, OpGt, OpGte, OpLt, OpLte, OpNeq, OpCaret, OpTilde:
+		return true
+	default:
+		return false
+	}
+}
+
 // Requirement represents a single version requirement.
 // It consists of a comparison operator and a target version.
 //
@@ -44,6 +65,22 @@ type Requirement struct {
 	Ver Version
 }
 
+// NewRequirement creates a Requirement, returning ErrUnknownOperator if op is not a defined operator.
+//
+// Example:
+//
+//	req, err := semver.NewRequirement(semver.OpGte, semver.MustParse("1.2.0"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(req) // Output: >=1.2.0
+func NewRequirement(op Operator, v Version) (Requirement, error) {
+	if !op.IsValid() {
+		return Requirement{}, fmt.Errorf("%w: %q", ErrUnknownOperator, op)
+	}
+	return Requirement{Op: op, Ver: v}, nil
+}
+
 // VersionRange represents a set of requirements separated by AND (space) and OR (||).
 // A VersionRange can be used to check if a Version satisfies it.
 //
@@ -57,10 +94,54 @@ type Requirement struct {
 //	fmt.Println(r.Contains(v)) // Output: true
 type VersionRange struct {
 	Requirements [][]Requirement
+
+	// Prerelease controls how versions with pre-release identifiers are matched.
+	// The zero value, PrereleaseInclusive, applies plain precedence rules.
+	Prerelease PrereleasePolicy
+
+	// observer, if set, is notified of every evaluation by Contains.
+	observer Observer
 }
 
-// rangeRegex helps to parse individual range tokens.
-var rangeRegex = regexp.MustCompile(`^(>=|<=|>|<|=|!=)?\s*([0-9A-Za-z.\-+]+)$`)
+// PrereleasePolicy controls whether versions with pre-release identifiers can satisfy a VersionRange.
+//
+// Supported Policies:
+//   - PrereleaseInclusive: Pre-release versions are compared using plain precedence rules.
+//   - PrereleaseExcluded: Pre-release versions never satisfy the range.
+//   - PrereleaseOptIn: A pre-release version satisfies a requirement only if the requirement's
+//     own version has pre-release identifiers, as in Masterminds/semver and Helm. An exclusive
+//     upper bound on a "-0" sentinel, such as "<2.0.0-0", defers to the other requirements in
+//     its branch, so the expansions of "^1.2.3-beta" and "<=1.2" treat pre-releases the way
+//     their unexpanded forms would.
+type PrereleasePolicy int
+
+const (
+	PrereleaseInclusive PrereleasePolicy = iota
+	PrereleaseExcluded
+	PrereleaseOptIn
+)
+
+// String returns the string representation of the PrereleasePolicy.
+//
+// Example:
+//
+//	fmt.Println(semver.PrereleaseOptIn.String()) // Output: opt-in
+func (p PrereleasePolicy) String() string {
+	switch p {
+	case PrereleaseInclusive:
+		return "inclusive"
+	case PrereleaseExcluded:
+		return "excluded"
+	case PrereleaseOptIn:
+		return "opt-in"
+	default:
+		return "unknown"
+	}
+}
+
+// rangeRegex helps to parse individual range tokens. Versions may carry the epoch prefix and
+// fourth revision segment that Version.String writes.
+var rangeRegex = regexp.MustCompile(`^(>=|<=|>|<|=|!=|\^|~)?\s*([0-9A-Za-z.\-+!]+)$`)
 
 // ParseRange parses a range string into a VersionRange struct.
 //
@@ -71,6 +152,8 @@ var rangeRegex = regexp.MustCompile(`^(>=|<=|>|<|=|!=)?\s*([0-9A-Za-z.\-+]+)$`)
 //   - ">=1.0.0"
 //   - "1.0.0", "=1.0.0", "==1.0.0"
 //   - "!1.0.0", "!=1.0.0"
+//   - "^1.2.3" (">=1.2.3 <2.0.0-0")
+//   - "~1.2.3" (">=1.2.3 <1.3.0-0")
 //
 // Ranges can be combined with logical AND (space-separated) and logical OR (||):
 //   - ">1.0.0 <2.0.0" matches between both versions.
@@ -86,6 +169,12 @@ var rangeRegex = regexp.MustCompile(`^(>=|<=|>|<|=|!=)?\s*([0-9A-Za-z.\-+]+)$`)
 //	v := semver.MustParse("1.5.0")
 //	fmt.Println(r.Contains(v)) // Output: true
 func ParseRange(r string) (*VersionRange, error) {
+	return parseRange(context.Background(), r)
+}
+
+// parseRange parses a range string as described by ParseRange, returning the context's error
+// as soon as it is done, checked before each token.
+func parseRange(ctx context.Context, r string) (*VersionRange, error) {
 	orParts := strings.Split(r, "||")
 	var requirements [][]Requirement
 
@@ -97,6 +186,9 @@ func ParseRange(r string) (*VersionRange, error) {
 		tokens := strings.Fields(part)
 		var reqs []Requirement
 		for _, token := range tokens {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
 			matches := rangeRegex.FindStringSubmatch(token)
 			if matches == nil {
 				return nil, fmt.Errorf("invalid range token: %s", token)
@@ -109,7 +201,7 @@ func ParseRange(r string) (*VersionRange, error) {
 			} else {
 				op = Operator(opStr)
 			}
-			ver, err := Parse(verStr)
+			ver, err := parseCanonicalVersion(verStr)
 			if err != nil {
 				return nil, fmt.Errorf("invalid version in range: %s", verStr)
 			}
@@ -123,6 +215,7 @@ func ParseRange(r string) (*VersionRange, error) {
 
 	return &VersionRange{
 		Requirements: requirements,
+		observer:     defaultObserver(),
 	}, nil
 }
 
@@ -147,13 +240,31 @@ func MustParseRange(s string) *VersionRange {
 
 // Contains checks if a version satisfies the range.
 //
+// Versions with pre-release identifiers are additionally subject to the range's Prerelease policy.
+//
 // Example:
 //
 //	r, _ := semver.ParseRange(">1.0.0 <2.0.0")
 //	v := semver.MustParse("1.5.0")
 //	fmt.Println(r.Contains(v)) // Output: true
 func (vr *VersionRange) Contains(v Version) bool {
+	if vr.observer != nil {
+		return vr.observeContains(v)
+	}
+	return vr.contains(v)
+}
+
+// contains checks if a version satisfies the range, as described by Contains.
+func (vr *VersionRange) contains(v Version) bool {
+	isPrerelease := len(v.PreRelease) > 0
+	if isPrerelease && vr.Prerelease == PrereleaseExcluded {
+		return false
+	}
+
 	for _, andReqs := range vr.Requirements {
+		if isPrerelease && vr.Prerelease == PrereleaseOptIn && !prereleaseOptedIn(andReqs) {
+			continue
+		}
 		matchesAll := true
 		for _, req := range andReqs {
 			if !req.Contains(v) {
@@ -168,6 +279,64 @@ func (vr *VersionRange) Contains(v Version) bool {
 	return false
 }
 
+// prereleaseOptedIn reports whether a branch lets pre-release versions through under
+// PrereleaseOptIn. Requirements on releases keep them out. Upper bounds on the "-0" sentinel,
+// which range expansion generates from constraints with and without pre-releases alike, are
+// neutral, so a branch made up only of them keeps pre-releases out as well.
+func prereleaseOptedIn(andReqs []Requirement) bool {
+	sentinels := 0
+	for _, req := range andReqs {
+		switch {
+		case req.isSentinelUpper():
+			sentinels++
+		case len(req.Ver.PreRelease) == 0:
+			return false
+		}
+	}
+	return len(andReqs) == 0 || sentinels < len(andReqs)
+}
+
+// isSentinelUpper reports whether the requirement is an exclusive upper bound on a "-0"
+// sentinel, such as "<2.0.0-0".
+func (r Requirement) isSentinelUpper() bool {
+	return r.Op == OpLt && r.Ver.IsMinimalPrerelease()
+}
+
+// Validate checks that every requirement in the range uses a defined operator.
+//
+// Ranges returned by ParseRange and the dialects are always valid; Validate is intended for
+// ranges constructed or modified programmatically.
+//
+// Example:
+//
+//	r := &semver.VersionRange{Requirements: [][]semver.Requirement{{{Op: "=>", Ver: semver.MustParse("1.0.0")}}}}
+//	fmt.Println(errors.Is(r.Validate(), semver.ErrUnknownOperator)) // Output: true
+func (vr *VersionRange) Validate() error {
+	for i, andReqs := range vr.Requirements {
+		for _, req := range andReqs {
+			if !req.Op.IsValid() {
+				return fmt.Errorf("%w: %q in branch %d", ErrUnknownOperator, req.Op, i+1)
+			}
+		}
+	}
+	return nil
+}
+
+// Check is like Contains but returns ErrUnknownOperator instead of silently not matching
+// if the range contains a requirement with an undefined operator.
+//
+// Example:
+//
+//	r := semver.MustParseRange(">=1.0.0")
+//	ok, err := r.Check(semver.MustParse("1.2.0"))
+//	fmt.Println(ok, err) // Output: true <nil>
+func (vr *VersionRange) Check(v Version) (bool, error) {
+	if err := vr.Validate(); err != nil {
+		return false, err
+	}
+	return vr.Contains(v), nil
+}
+
 // Contains checks if a version satisfies the requirement.
 //
 // Example:
@@ -189,12 +358,85 @@ func (r *Requirement) Contains(v Version) bool {
 		return v.LessThanOrEqual(r.Ver)
 	case OpNeq:
 		return !v.Equal(r.Ver)
+	case OpCaret, OpTilde:
+		lower, upper := r.shorthandBounds()
+		return v.GreaterThanOrEqual(lower) && v.LessThan(upper)
 	default:
 		return false
 	}
 }
 
+// Check is like Contains but returns ErrUnknownOperator instead of false if the
+// requirement's operator is not defined.
+//
+// Example:
+//
+//	req := semver.Requirement{Op: "=>", Ver: semver.MustParse("1.0.0")}
+//	_, err := req.Check(semver.MustParse("1.1.0"))
+//	fmt.Println(errors.Is(err, semver.ErrUnknownOperator)) // Output: true
+func (r *Requirement) Check(v Version) (bool, error) {
+	if !r.Op.IsValid() {
+		return false, fmt.Errorf("%w: %q", ErrUnknownOperator, r.Op)
+	}
+	return r.Contains(v), nil
+}
+
+// String returns the string representation of the requirement, such as ">=1.2.3" or "^1.2.3".
+//
+// Example:
+//
+//	req := semver.Requirement{Op: semver.OpCaret, Ver: semver.MustParse("1.2.3")}
+//	fmt.Println(req.String()) // Output: ^1.2.3
+func (r Requirement) String() string {
+	return string(r.Op) + r.Ver.String()
+}
+
+// shorthandBounds returns the inclusive lower bound and exclusive upper bound of a caret or
+// tilde requirement. The upper bound carries a "-0" pre-release so that pre-releases of the
+// next incompatible version are excluded. Both bounds share the requirement's epoch.
+func (r *Requirement) shorthandBounds() (Version, Version) {
+	upper := minimalPrerelease(r.shorthandCeiling())
+	upper.Epoch = r.Ver.Epoch
+	return r.Ver, upper
+}
+
+// shorthandCeiling returns the major, minor, and patch numbers of the first release that a caret
+// or tilde requirement no longer accepts (e.g., 2.0.0 for "^1.2.3" and 1.3.0 for "~1.2.3").
+func (r *Requirement) shorthandCeiling() (uint64, uint64, uint64) {
+	v := r.Ver
+	switch {
+	case r.Op == OpTilde:
+		return v.Major, v.Minor + 1, 0
+	case v.Major > 0:
+		return v.Major + 1, 0, 0
+	case v.Minor > 0:
+		return 0, v.Minor + 1, 0
+	default:
+		return 0, 0, v.Patch + 1
+	}
+}
+
+// String returns the string representation of the range in ParseRange syntax, with requirements
+// separated by spaces and OR branches separated by " || ".
+//
+// Example:
+//
+//	r := semver.MustParseRange(">=1.0.0  <2.0.0||^3.1.0")
+//	fmt.Println(r.String()) // Output: >=1.0.0 <2.0.0 || ^3.1.0
+func (vr *VersionRange) String() string {
+	branches := make([]string, len(vr.Requirements))
+	for i, andReqs := range vr.Requirements {
+		reqs := make([]string, len(andReqs))
+		for j, req := range andReqs {
+			reqs[j] = req.String()
+		}
+		branches[i] = strings.Join(reqs, " ")
+	}
+	return strings.Join(branches, " || ")
+}
+
 // OR combines the current VersionRange with another VersionRange using logical OR.
+// The combined range keeps the current range's Prerelease policy and Observer.
 //
 // Example:
 //
@@ -206,6 +448,8 @@ func (r *Requirement) Contains(v Version) bool {
 func (vr *VersionRange) OR(other *VersionRange) *VersionRange {
 	combined := &VersionRange{
 		Requirements: append(vr.Requirements, other.Requirements...),
+		Prerelease:   vr.Prerelease,
+		observer:     vr.observer,
 	}
 	return combined
 }
@@ -214,6 +458,7 @@ func (vr *VersionRange) OR(other *VersionRange) *VersionRange {
 //
 // This function returns a new VersionRange that represents the intersection of the two ranges.
 // It effectively creates a range that only matches versions satisfying both original ranges.
+// The combined range keeps the current range's Prerelease policy and Observer.
 //
 // Example:
 //
@@ -236,5 +481,7 @@ func (vr *VersionRange) AND(other *VersionRange) *VersionRange {
 	}
 	return &VersionRange{
 		Requirements: combinedRequirements,
+		Prerelease:   vr.Prerelease,
+		observer:     vr.observer,
 	}
 }
@@ -0,0 +1,173 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"sort"
+)
+
+// RangeChangeKind classifies how a dependency's range changed between two manifests.
+//
+// Supported Kinds:
+//   - RangeAdded: The dependency only exists in the new manifest.
+//   - RangeRemoved: The dependency only exists in the old manifest.
+//   - RangeUnchanged: The range text is identical.
+//   - RangeEquivalent: The range text differs but matches the same versions.
+//   - RangeWidened: The new range matches every version the old one did, and more.
+//   - RangeNarrowed: The new range matches a strict subset of the old one.
+//   - RangeShifted: Each range matches versions the other does not.
+//   - RangeInvalid: One of the ranges could not be parsed.
+type RangeChangeKind string
+
+const (
+	RangeAdded      RangeChangeKind = "added"
+	RangeRemoved    RangeChangeKind = "removed"
+	RangeUnchanged  RangeChangeKind = "unchanged"
+	RangeEquivalent RangeChangeKind = "equivalent"
+	RangeWidened    RangeChangeKind = "widened"
+	RangeNarrowed   RangeChangeKind = "narrowed"
+	RangeShifted    RangeChangeKind = "shifted"
+	RangeInvalid    RangeChangeKind = "invalid"
+)
+
+// RangeChange describes the change to a single dependency's range.
+//
+// FloorBumped is true if the lowest version matched by the new range is higher than that of
+// the old range, and MajorSwitched is true if the two floors have different major versions.
+type RangeChange struct {
+	Name          string          `json:"name"`
+	Old           string          `json:"old,omitempty"`
+	New           string          `json:"new,omitempty"`
+	Kind          RangeChangeKind `json:"kind"`
+	FloorBumped   bool            `json:"floor_bumped,omitempty"`
+	MajorSwitched bool            `json:"major_switched,omitempty"`
+	Error         string          `json:"error,omitempty"`
+}
+
+// RangeDiff is a machine-readable report of the range changes between two dependency manifests.
+// Changes are sorted by dependency name and include unchanged dependencies.
+type RangeDiff struct {
+	Changes []RangeChange `json:"changes"`
+}
+
+// DiffRanges compares two maps of dependency name to range expression, parsed with ParseRange,
+// and classifies the change to each dependency.
+//
+// Ranges that use "!=" are compared as if the excluded versions were included.
+//
+// Example:
+//
+//	diff := semver.DiffRanges(
+//	    map[string]string{"a": ">=1.0.0 <2.0.0", "b": ">=1.2.0"},
+//	    map[string]string{"a": ">=1.0.0 <3.0.0", "b": ">=2.0.0"},
+//	)
+//	for _, c := range diff.Changes {
+//	    fmt.Println(c.Name, c.Kind, c.MajorSwitched)
+//	}
+//	// Output:
+//	// a widened false
+//	// b narrowed true
+func DiffRanges(oldRanges, newRanges map[string]string) *RangeDiff {
+	return DiffRangesWithDialect(nil, oldRanges, newRanges)
+}
+
+// DiffRangesWithDialect is like DiffRanges but parses the ranges with the given Dialect.
+// A nil Dialect uses ParseRange.
+func DiffRangesWithDialect(d Dialect, oldRanges, newRanges map[string]string) *RangeDiff {
+	parse := ParseRange
+	if d != nil {
+		parse = d.ParseRange
+	}
+
+	names := make([]string, 0, len(oldRanges)+len(newRanges))
+	for name := range oldRanges {
+		names = append(names, name)
+	}
+	for name := range newRanges {
+		if _, ok := oldRanges[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	diff := &RangeDiff{Changes: make([]RangeChange, 0, len(names))}
+	for _, name := range names {
+		oldRange, inOld := oldRanges[name]
+		newRange, inNew := newRanges[name]
+
+		change := RangeChange{Name: name, Old: oldRange, New: newRange}
+		switch {
+		case !inOld:
+			change.Kind = RangeAdded
+		case !inNew:
+			change.Kind = RangeRemoved
+		case oldRange == newRange:
+			change.Kind = RangeUnchanged
+		default:
+			classifyRangeChange(&change, parse)
+		}
+		diff.Changes = append(diff.Changes, change)
+	}
+
+	return diff
+}
+
+// Changed returns only the changes whose kind is not RangeUnchanged.
+func (d *RangeDiff) Changed() []RangeChange {
+	var changed []RangeChange
+	for _, c := range d.Changes {
+		if c.Kind != RangeUnchanged {
+			changed = append(changed, c)
+		}
+	}
+	return changed
+}
+
+// classifyRangeChange compares the parsed old and new ranges of a change.
+func classifyRangeChange(change *RangeChange, parse func(string) (*VersionRange, error)) {
+	oldRange, err := parse(change.Old)
+	if err == nil {
+		var newRange *VersionRange
+		if newRange, err = parse(change.New); err == nil {
+			classifyIntervals(change, oldRange.Intervals(), newRange.Intervals())
+			return
+		}
+	}
+	change.Kind = RangeInvalid
+	change.Error = err.Error()
+}
+
+// classifyIntervals sets the kind and floor flags of a change from the intervals of both ranges.
+func classifyIntervals(change *RangeChange, oldIvs, newIvs []Interval) {
+	widens := intervalsContain(newIvs, oldIvs)
+	narrows := intervalsContain(oldIvs, newIvs)
+
+	switch {
+	case widens && narrows:
+		change.Kind = RangeEquivalent
+	case widens:
+		change.Kind = RangeWidened
+	case narrows:
+		change.Kind = RangeNarrowed
+	default:
+		change.Kind = RangeShifted
+	}
+
+	if len(oldIvs) == 0 || len(newIvs) == 0 {
+		return
+	}
+	oldFloor, newFloor := oldIvs[0].Lower, newIvs[0].Lower
+	change.FloorBumped = compareLower(newFloor, oldFloor) > 0
+	change.MajorSwitched = floorMajor(oldFloor) != floorMajor(newFloor)
+}
+
+// floorMajor returns the major version of a lower bound, treating an unbounded floor as major 0.
+func floorMajor(b Bound) uint64 {
+	if b.Unbounded {
+		return 0
+	}
+	return b.Version.Major
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffRanges(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	oldRanges := map[string]string{
+		"same":       ">=1.0.0",
+		"equivalent": ">=1.0.0 <2.0.0",
+		"widened":    ">=1.0.0 <2.0.0",
+		"narrowed":   ">=1.0.0",
+		"bumped":     ">=1.2.0 <2.0.0",
+		"major":      ">=1.2.0 <2.0.0",
+		"shifted":    ">=1.0.0 <1.5.0",
+		"union":      "<1.0.0 || >=2.0.0",
+		"invalid":    ">=1.0.0",
+		"removed":    "1.0.0",
+	}
+	newRanges := map[string]string{
+		"same":       ">=1.0.0",
+		"equivalent": "<2.0.0 >=1.0.0",
+		"widened":    ">=1.0.0 <3.0.0",
+		"narrowed":   ">=1.0.0 <2.0.0",
+		"bumped":     ">=1.4.0 <2.0.0",
+		"major":      ">=2.0.0 <3.0.0",
+		"shifted":    ">=1.2.0 <1.8.0",
+		"union":      "<1.0.0 || >=1.5.0",
+		"invalid":    "bogus",
+		"added":      ">=0.1.0",
+	}
+
+	diff := DiffRanges(oldRanges, newRanges)
+	is.Len(diff.Changes, 11)
+
+	byName := make(map[string]RangeChange)
+	for _, c := range diff.Changes {
+		byName[c.Name] = c
+	}
+
+	is.Equal(RangeUnchanged, byName["same"].Kind)
+	is.Equal(RangeEquivalent, byName["equivalent"].Kind)
+	is.Equal(RangeWidened, byName["widened"].Kind)
+	is.False(byName["widened"].FloorBumped)
+	is.Equal(RangeNarrowed, byName["narrowed"].Kind)
+	is.Equal(RangeNarrowed, byName["bumped"].Kind)
+	is.True(byName["bumped"].FloorBumped)
+	is.False(byName["bumped"].MajorSwitched)
+	is.Equal(RangeShifted, byName["major"].Kind)
+	is.True(byName["major"].FloorBumped)
+	is.True(byName["major"].MajorSwitched)
+	is.Equal(RangeShifted, byName["shifted"].Kind)
+	is.Equal(RangeWidened, byName["union"].Kind)
+	is.Equal(RangeInvalid, byName["invalid"].Kind)
+	is.NotEmpty(byName["invalid"].Error)
+	is.Equal(RangeAdded, byName["added"].Kind)
+	is.Equal(RangeRemoved, byName["removed"].Kind)
+
+	is.Equal("added", diff.Changes[0].Name, "Changes should be sorted by name")
+	is.Len(diff.Changed(), 10)
+}
+
+func TestDiffRangesJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	diff := DiffRanges(
+		map[string]string{"a": ">=1.0.0 <2.0.0", "b": ">=1.2.0"},
+		map[string]string{"a": ">=1.0.0 <3.0.0", "b": ">=2.0.0"},
+	)
+
+	out, err := json.Marshal(diff)
+	is.NoError(err)
+	is.JSONEq(`{"changes":[
+		{"name":"a","old":">=1.0.0 <2.0.0","new":">=1.0.0 <3.0.0","kind":"widened"},
+		{"name":"b","old":">=1.2.0","new":">=2.0.0","kind":"narrowed","floor_bumped":true,"major_switched":true}
+	]}`, string(out))
+}
+
+func TestDiffRangesWithDialect(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	diff := DiffRangesWithDialect(HelmDialect,
+		map[string]string{"chart": "^1.2.0"},
+		map[string]string{"chart": "~1.2.0"},
+	)
+	is.Equal(RangeNarrowed, diff.Changes[0].Kind)
+}
+
+func TestVersionRangeIntervals(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Len(MustParseRange(">=1.0.0 <2.0.0 || >=1.5.0 <3.0.0").Intervals(), 1)
+	is.Len(MustParseRange("<1.0.0 || >1.0.0").Intervals(), 2)
+	is.Len(MustParseRange("<=1.0.0 || >1.0.0").Intervals(), 1)
+	is.Len(MustParseRange(">2.0.0 <1.0.0").Intervals(), 0)
+	is.Len(MustParseRange("1.0.0").Intervals(), 1)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Hash returns a 64-bit hash of the range's simplified canonical form, suitable as an in-memory
+// cache key for memoizing range evaluations.
+//
+// Ranges that differ only in requirement order, duplicated requirements or branches, build
+// metadata, redundant bounds, or caret and tilde shorthand hash equally. For example,
+// "<2.0.0 >=1.0.0 >=0.5.0" and ">=1.0.0 <2.0.0" have the same hash. Use Fingerprint where
+// collisions are unacceptable.
+//
+// Example:
+//
+//	a := semver.MustParseRange("<2.0.0 >=1.0.0")
+//	b := semver.MustParseRange(">=1.0.0 <2.0.0+build")
+//	fmt.Println(a.Hash() == b.Hash()) // Output: true
+func (vr *VersionRange) Hash() uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(vr.canonicalString()))
+	return h.Sum64()
+}
+
+// Fingerprint returns the hex-encoded SHA-256 digest of the range's simplified canonical form.
+// Equal fingerprints imply the ranges are equivalent under the same rules as Hash, and the value
+// is stable across processes and releases, so it can be used as a persistent cache key.
+//
+// Example:
+//
+//	r := semver.MustParseRange("^1.2.3")
+//	fmt.Println(len(r.Fingerprint())) // Output: 64
+func (vr *VersionRange) Fingerprint() string {
+	sum := sha256.Sum256([]byte(vr.canonicalString()))
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalString returns the simplified canonical form of the range, prefixed with its
+// pre-release policy.
+//
+// Each branch is reduced to its tightest bounds plus the "!=" requirements within them, and
+// branches are sorted and deduplicated. Under PrereleaseOptIn, where every requirement's
+// pre-release identifiers matter, requirements are only sorted and deduplicated.
+func (vr *VersionRange) canonicalString() string {
+	var branches []string
+	for _, andReqs := range vr.Requirements {
+		reqs := canonicalBranch(andReqs, vr.Prerelease != PrereleaseOptIn)
+		if reqs == nil {
+			continue
+		}
+
+		parts := make([]string, len(reqs))
+		for i, req := range reqs {
+			parts[i] = req.String()
+		}
+		branches = append(branches, strings.Join(parts, " "))
+	}
+
+	sort.Strings(branches)
+	branches = slices.Compact(branches)
+	if len(branches) == 0 {
+		branches = []string{Requirement{Op: OpLt, Ver: minimalPrerelease(0, 0, 0)}.String()}
+	}
+
+	return strconv.Itoa(int(vr.Prerelease)) + ":" + strings.Join(branches, " || ")
+}
+
+// canonicalBranch returns the sorted, deduplicated requirements of a branch without build
+// metadata. If simplify is true, the branch is first reduced to its tightest bounds, and nil is
+// returned if it cannot match any version.
+func canonicalBranch(andReqs []Requirement, simplify bool) []Requirement {
+	reqs := make([]Requirement, 0, len(andReqs))
+	for _, req := range andReqs {
+		req.Ver.BuildMetadata = nil
+		reqs = append(reqs, req)
+	}
+
+	if simplify {
+		iv := unboundedInterval()
+		var excluded []Requirement
+		for _, req := range reqs {
+			if reqIv, ok := requirementInterval(req); ok {
+				iv = iv.intersect(reqIv)
+			} else {
+				excluded = append(excluded, req)
+			}
+		}
+		if iv.IsEmpty() {
+			return nil
+		}
+
+		reqs = iv.requirements()
+		singleton := len(reqs) == 1 && reqs[0].Op == OpEq
+		for _, req := range excluded {
+			if req.Op != OpNeq {
+				// Unknown operators are kept verbatim.
+				reqs = append(reqs, req)
+				continue
+			}
+			point := Interval{Lower: Bound{Version: req.Ver, Inclusive: true}, Upper: Bound{Version: req.Ver, Inclusive: true}}
+			if !iv.includes(point) {
+				continue
+			}
+			if singleton {
+				return nil
+			}
+			reqs = append(reqs, req)
+		}
+	}
+
+	sort.SliceStable(reqs, func(i, j int) bool {
+		if c := reqs[i].Ver.Compare(reqs[j].Ver); c != 0 {
+			return c < 0
+		}
+		return reqs[i].Op < reqs[j].Op
+	})
+
+	compacted := reqs[:0]
+	for i, req := range reqs {
+		if i == 0 || req.Op != reqs[i-1].Op || req.Ver.Compare(reqs[i-1].Ver) != 0 {
+			compacted = append(compacted, req)
+		}
+	}
+	return compacted
+}
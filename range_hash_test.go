@@ -0,0 +1,78 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionRangeHash(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	equal := [][2]string{
+		{">=1.0.0 <2.0.0", "<2.0.0 >=1.0.0"},
+		{">=1.0.0 <2.0.0", ">=1.0.0 <2.0.0 >=1.0.0"},
+		{">=1.0.0 <2.0.0", ">=1.0.0 <2.0.0+build.5"},
+		{">=1.0.0 <2.0.0", ">=0.5.0 >=1.0.0 <3.0.0 <2.0.0"},
+		{"^1.2.3", ">=1.2.3 <2.0.0-0"},
+		{"~1.2.3", ">=1.2.3 <1.3.0-0"},
+		{">=1.0.0 || <0.5.0", "<0.5.0 || >=1.0.0 || >=1.0.0"},
+		{">=1.0.0 !=3.0.0", "!=3.0.0 >=1.0.0"},
+		{">=1.0.0 <2.0.0 !=3.0.0", ">=1.0.0 <2.0.0"},
+		{"1.0.0 !=1.0.0", ">2.0.0 <1.0.0"},
+		{">=2.0.0 <1.0.0 || >=1.0.0", ">=1.0.0"},
+	}
+	for _, pair := range equal {
+		a, b := MustParseRange(pair[0]), MustParseRange(pair[1])
+		is.Equal(a.Hash(), b.Hash(), "Expected equal hashes for %q and %q", pair[0], pair[1])
+		is.Equal(a.Fingerprint(), b.Fingerprint(), "Expected equal fingerprints for %q and %q", pair[0], pair[1])
+	}
+
+	different := [][2]string{
+		{">=1.0.0 <2.0.0", ">=1.0.0 <=2.0.0"},
+		{">=1.0.0 !=1.5.0", ">=1.0.0"},
+		{"1.0.0", "1.0.1"},
+		{">=1.0.0-alpha", ">=1.0.0"},
+	}
+	for _, pair := range different {
+		a, b := MustParseRange(pair[0]), MustParseRange(pair[1])
+		is.NotEqual(a.Hash(), b.Hash(), "Expected different hashes for %q and %q", pair[0], pair[1])
+		is.NotEqual(a.Fingerprint(), b.Fingerprint(), "Expected different fingerprints for %q and %q", pair[0], pair[1])
+	}
+}
+
+func TestVersionRangeHashPrereleasePolicy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	inclusive := MustParseRange(">=1.0.0")
+	excluded := &VersionRange{Requirements: inclusive.Requirements, Prerelease: PrereleaseExcluded}
+	is.NotEqual(inclusive.Hash(), excluded.Hash())
+	is.NotEqual(inclusive.Fingerprint(), excluded.Fingerprint())
+
+	// Under PrereleaseOptIn, a redundant bound without a pre-release still changes which
+	// pre-releases match, so it must not be simplified away.
+	a := &VersionRange{Requirements: MustParseRange(">=1.0.0-beta >=0.5.0").Requirements, Prerelease: PrereleaseOptIn}
+	b := &VersionRange{Requirements: MustParseRange(">=1.0.0-beta").Requirements, Prerelease: PrereleaseOptIn}
+	is.NotEqual(a.Contains(MustParse("1.0.0-rc.1")), b.Contains(MustParse("1.0.0-rc.1")))
+	is.NotEqual(a.Hash(), b.Hash())
+
+	c := &VersionRange{Requirements: MustParseRange(">=0.5.0 >=1.0.0-beta").Requirements, Prerelease: PrereleaseOptIn}
+	is.Equal(a.Fingerprint(), c.Fingerprint())
+}
+
+func TestVersionRangeFingerprintStable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := MustParseRange(">=1.0.0 <2.0.0")
+	is.Len(r.Fingerprint(), 64)
+	is.Equal("0:>=1.0.0 <2.0.0", r.canonicalString())
+	is.Equal("0:<0.0.0-0", (&VersionRange{}).canonicalString())
+}
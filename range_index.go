@@ -0,0 +1,168 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"slices"
+	"sort"
+	"sync"
+)
+
+// maxIndexedMajors is the largest number of major versions a range may span and still be
+// filed under each of them; wider ranges are checked against every version.
+const maxIndexedMajors = 16
+
+// RangeIndex stores many version ranges under string IDs and finds those that match a single
+// version, as policy engines do when evaluating advisories or routing rules.
+//
+// Ranges are grouped by the major versions their bounds span, so a lookup only considers the
+// ranges that can reach the version's major, and prunes those by their bounds before checking
+// the range itself. Ranges that span many majors, or are unbounded, are always considered.
+//
+// A RangeIndex is safe for concurrent use.
+//
+// Example:
+//
+//	idx := semver.NewRangeIndex()
+//	idx.Add("CVE-2024-0001", semver.MustParseRange(">=1.2.0 <1.4.3"))
+//	idx.Add("CVE-2024-0002", semver.MustParseRange("<2.0.0 || >=3.0.0 <3.0.5"))
+//	fmt.Println(idx.Match(semver.MustParse("1.4.0"))) // Output: [CVE-2024-0001 CVE-2024-0002]
+type RangeIndex struct {
+	mu      sync.RWMutex
+	entries map[string]*rangeEntry
+	byMajor map[uint64][]*rangeEntry
+	wide    []*rangeEntry
+}
+
+// rangeEntry is a range stored in a RangeIndex, with its intervals precomputed for pruning.
+type rangeEntry struct {
+	id  string
+	r   *VersionRange
+	ivs []Interval
+}
+
+// NewRangeIndex creates an empty RangeIndex.
+func NewRangeIndex() *RangeIndex {
+	return &RangeIndex{
+		entries: make(map[string]*rangeEntry),
+		byMajor: make(map[uint64][]*rangeEntry),
+	}
+}
+
+// Add stores the range under id, replacing any range previously stored under the same id.
+// The range must not be modified while it is stored.
+func (idx *RangeIndex) Add(id string, r *VersionRange) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(id)
+
+	e := &rangeEntry{id: id, r: r, ivs: r.Intervals()}
+	idx.entries[id] = e
+
+	lo, hi, ok := e.majors()
+	switch {
+	case len(e.ivs) == 0:
+		// The range matches no version and is never a candidate.
+	case !ok:
+		idx.wide = append(idx.wide, e)
+	default:
+		for major := lo; major <= hi; major++ {
+			idx.byMajor[major] = append(idx.byMajor[major], e)
+		}
+	}
+}
+
+// Remove deletes the range stored under id. It reports whether such a range existed.
+func (idx *RangeIndex) Remove(id string) bool {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	return idx.remove(id)
+}
+
+// Len returns the number of stored ranges.
+func (idx *RangeIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return len(idx.entries)
+}
+
+// Match returns the IDs of every stored range that contains v, in increasing order.
+//
+// Example:
+//
+//	idx := semver.NewRangeIndex()
+//	idx.Add("legacy", semver.MustParseRange("<1.0.0"))
+//	idx.Add("current", semver.MustParseRange("^1.0.0"))
+//	fmt.Println(idx.Match(semver.MustParse("1.2.0"))) // Output: [current]
+func (idx *RangeIndex) Match(v Version) []string {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var ids []string
+	for _, candidates := range [][]*rangeEntry{idx.byMajor[v.Major], idx.wide} {
+		for _, e := range candidates {
+			if e.admits(v) && e.r.Contains(v) {
+				ids = append(ids, e.id)
+			}
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// remove deletes the range stored under id. The caller must hold the write lock.
+func (idx *RangeIndex) remove(id string) bool {
+	e, ok := idx.entries[id]
+	if !ok {
+		return false
+	}
+	delete(idx.entries, id)
+
+	isEntry := func(other *rangeEntry) bool { return other == e }
+	idx.wide = slices.DeleteFunc(idx.wide, isEntry)
+	if lo, hi, bounded := e.majors(); bounded && len(e.ivs) > 0 {
+		for major := lo; major <= hi; major++ {
+			if bucket := slices.DeleteFunc(idx.byMajor[major], isEntry); len(bucket) > 0 {
+				idx.byMajor[major] = bucket
+			} else {
+				delete(idx.byMajor, major)
+			}
+		}
+	}
+	return true
+}
+
+// majors returns the lowest and highest major versions the entry's intervals can reach. The
+// boolean is false if the intervals are unbounded or span more than maxIndexedMajors majors.
+func (e *rangeEntry) majors() (uint64, uint64, bool) {
+	if len(e.ivs) == 0 {
+		return 0, 0, true
+	}
+	lower, upper := e.ivs[0].Lower, e.ivs[len(e.ivs)-1].Upper
+
+	lo := uint64(0)
+	if !lower.Unbounded {
+		lo = lower.Version.Major
+	}
+	if upper.Unbounded || upper.Version.Major-lo >= maxIndexedMajors {
+		return 0, 0, false
+	}
+	return lo, upper.Version.Major, true
+}
+
+// admits reports whether v lies within one of the entry's intervals, which is necessary for,
+// but does not imply, v being contained in the range.
+func (e *rangeEntry) admits(v Version) bool {
+	for _, iv := range e.ivs {
+		if iv.Contains(v) {
+			return true
+		}
+	}
+	return false
+}
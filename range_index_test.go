@@ -0,0 +1,78 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRangeIndex(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ranges := map[string]string{
+		"caret":     "^1.4.0",
+		"tilde":     "~1.4.2",
+		"union":     "<1.0.0 || >=3.0.0 <3.0.5",
+		"wide":      ">=1.0.0 <100.0.0",
+		"open":      ">=2.0.0",
+		"neq":       "!=1.4.3",
+		"exact":     "2.1.0-rc.1",
+		"empty":     ">2.0.0 <1.0.0",
+		"excluding": ">=1.4.0 <2.0.0 !=1.4.2",
+	}
+
+	idx := NewRangeIndex()
+	for id, r := range ranges {
+		idx.Add(id, MustParseRange(r))
+	}
+	is.Equal(len(ranges), idx.Len())
+
+	for _, s := range []string{"0.9.0", "1.4.0", "1.4.2", "1.4.3", "2.0.0", "2.1.0-rc.1", "3.0.4", "3.0.5", "150.0.0"} {
+		v := MustParse(s)
+		var expected []string
+		for id, r := range ranges {
+			if MustParseRange(r).Contains(v) {
+				expected = append(expected, id)
+			}
+		}
+		sort.Strings(expected)
+		is.Equal(fmt.Sprint(expected), fmt.Sprint(idx.Match(v)), "Version %s", s)
+	}
+
+	is.Equal([]string{"caret", "neq", "tilde", "wide"}, idx.Match(MustParse("1.4.2")))
+
+	idx.Add("tilde", MustParseRange("~1.5.0"))
+	is.Equal([]string{"caret", "neq", "wide"}, idx.Match(MustParse("1.4.2")))
+	is.Equal(len(ranges), idx.Len())
+
+	is.True(idx.Remove("neq"))
+	is.True(idx.Remove("caret"))
+	is.True(idx.Remove("empty"))
+	is.False(idx.Remove("caret"))
+	is.Equal([]string{"wide"}, idx.Match(MustParse("1.4.2")))
+	is.Equal(len(ranges)-3, idx.Len())
+}
+
+func TestRangeIndexBuckets(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	idx := NewRangeIndex()
+	idx.Add("narrow", MustParseRange(">=1.0.0 <3.0.0"))
+	idx.Add("wide", MustParseRange(">=1.0.0 <100.0.0"))
+	idx.Add("open", MustParseRange(">=1.0.0"))
+
+	is.Len(idx.byMajor, 3)
+	is.Len(idx.wide, 2)
+
+	idx.Remove("narrow")
+	is.Empty(idx.byMajor)
+}
@@ -70,6 +70,10 @@ func TestVersionRangeMatches(t *testing.T) {
 		{rangeStr: ">=1.2.3 <2.0.0", version: "2.0.0", shouldMatch: false},
 		{rangeStr: ">=1.2.3 <2.0.0 || >=3.0.0", version: "3.1.0", shouldMatch: true},
 		{rangeStr: "!=1.0.0", version: "1.0.0", shouldMatch: false},
+		{rangeStr: "^1.2.3", version: "1.9.0", shouldMatch: true},
+		{rangeStr: "^1.2.3", version: "2.0.0-alpha", shouldMatch: false},
+		{rangeStr: "~1.2.3", version: "1.2.9", shouldMatch: true},
+		{rangeStr: "~1.2.3", version: "1.3.0", shouldMatch: false},
 	}
 
 	for _, test := range tests {
@@ -172,3 +176,180 @@ func TestMustParseRange(t *testing.T) {
 		MustParseRange("invalid range")
 	})
 }
+
+func TestVersionRangePrereleasePolicy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rangeStr    string
+		policy      PrereleasePolicy
+		version     string
+		shouldMatch bool
+	}{
+		{">=1.0.0 <2.0.0", PrereleaseInclusive, "1.5.0-beta", true},
+		{">=1.0.0 <2.0.0", PrereleaseExcluded, "1.5.0-beta", false},
+		{">=1.0.0 <2.0.0", PrereleaseExcluded, "1.5.0", true},
+		{">=1.0.0 <2.0.0", PrereleaseOptIn, "1.5.0-beta", false},
+		{">=1.0.0-0 <2.0.0-0", PrereleaseOptIn, "1.5.0-beta", true},
+		{">=1.0.0-0 <2.0.0", PrereleaseOptIn, "1.5.0-beta", false},
+		{">=1.0.0 <2.0.0", PrereleaseOptIn, "1.5.0", true},
+	}
+
+	for _, test := range tests {
+		r := MustParseRange(test.rangeStr)
+		r.Prerelease = test.policy
+		is.Equal(test.shouldMatch, r.Contains(MustParse(test.version)), "Version %s against range %s with policy %d", test.version, test.rangeStr, test.policy)
+	}
+
+	r1 := MustParseRange(">=1.0.0")
+	r1.Prerelease = PrereleaseExcluded
+	is.Equal(PrereleaseExcluded, r1.OR(MustParseRange("<0.5.0")).Prerelease)
+	is.Equal(PrereleaseExcluded, r1.AND(MustParseRange("<2.0.0")).Prerelease)
+}
+
+func TestRequirementCaretTilde(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		op          Operator
+		target      string
+		version     string
+		shouldMatch bool
+	}{
+		{OpCaret, "1.2.3", "1.2.3", true},
+		{OpCaret, "1.2.3", "1.99.0", true},
+		{OpCaret, "1.2.3", "1.2.2", false},
+		{OpCaret, "1.2.3", "2.0.0", false},
+		{OpCaret, "1.2.3", "2.0.0-rc.1", false},
+		{OpCaret, "0.2.3", "0.2.9", true},
+		{OpCaret, "0.2.3", "0.3.0", false},
+		{OpCaret, "0.0.3", "0.0.3", true},
+		{OpCaret, "0.0.3", "0.0.4", false},
+		{OpCaret, "1.2.3-beta.2", "1.2.3-beta.3", true},
+		{OpCaret, "1.2.3-beta.2", "1.2.3-beta.1", false},
+		{OpTilde, "1.2.3", "1.2.9", true},
+		{OpTilde, "1.2.3", "1.3.0", false},
+		{OpTilde, "1.2.3", "1.3.0-0", false},
+		{OpTilde, "0.0.3", "0.0.9", true},
+	}
+
+	for _, test := range tests {
+		req := Requirement{Op: test.op, Ver: MustParse(test.target)}
+		is.Equal(test.shouldMatch, req.Contains(MustParse(test.version)), "Requirement %s with version %s", req, test.version)
+	}
+
+	is.Equal("^1.2.3", Requirement{Op: OpCaret, Ver: MustParse("1.2.3")}.String())
+	is.Equal("~1.2.3-beta", Requirement{Op: OpTilde, Ver: MustParse("1.2.3-beta")}.String())
+
+	r := MustParseRange("^1.2.3 || ~2.0.1")
+	is.Equal([][]Requirement{
+		{{Op: OpCaret, Ver: MustParse("1.2.3")}},
+		{{Op: OpTilde, Ver: MustParse("2.0.1")}},
+	}, r.Requirements)
+}
+
+func TestRequirementCheck(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := MustParse("1.1.0")
+
+	req, err := NewRequirement(OpGte, MustParse("1.0.0"))
+	is.NoError(err)
+	ok, err := req.Check(v)
+	is.NoError(err)
+	is.True(ok)
+
+	_, err = NewRequirement("=>", MustParse("1.0.0"))
+	is.ErrorIs(err, ErrUnknownOperator)
+
+	bad := Requirement{Op: "=>", Ver: MustParse("1.0.0")}
+	ok, err = bad.Check(v)
+	is.ErrorIs(err, ErrUnknownOperator)
+	is.False(ok)
+	is.False(bad.Contains(v))
+}
+
+func TestVersionRangeValidate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := MustParseRange(">=1.0.0 <2.0.0 || ^3.1.0 || ~4.0.0 !=4.0.1")
+	is.NoError(r.Validate())
+
+	for _, d := range []Dialect{HelmDialect, ComposerDialect, NuGetDialect, GradleDialect} {
+		parsed, err := d.ParseRange("1.2.3")
+		is.NoError(err)
+		is.NoError(parsed.Validate(), "Dialect %s", d.Name())
+	}
+
+	invalid := r.OR(&VersionRange{Requirements: [][]Requirement{{{Op: "~>", Ver: MustParse("5.0.0")}}}})
+	is.ErrorIs(invalid.Validate(), ErrUnknownOperator)
+	is.ErrorContains(invalid.Validate(), "branch 4")
+
+	ok, err := invalid.Check(MustParse("1.5.0"))
+	is.ErrorIs(err, ErrUnknownOperator)
+	is.False(ok)
+
+	ok, err = r.Check(MustParse("3.2.0"))
+	is.NoError(err)
+	is.True(ok)
+}
+
+func TestVersionRangeString(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{">=1.0.0  <2.0.0||^3.1.0", ">=1.0.0 <2.0.0 || ^3.1.0"},
+		{"1.0.0", "=1.0.0"},
+		{"!=1.0.0-alpha+build.1", "!=1.0.0-alpha+build.1"},
+	}
+
+	for _, test := range tests {
+		r := MustParseRange(test.input)
+		is.Equal(test.expected, r.String(), "String of range %s", test.input)
+		is.Equal(r.Requirements, MustParseRange(r.String()).Requirements, "Round trip of range %s", test.input)
+	}
+}
+
+func TestVersionRangeStringEpochRevision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := &VersionRange{Requirements: [][]Requirement{
+		{
+			{Op: OpGte, Ver: Version{Epoch: 2, Major: 1}},
+			{Op: OpLt, Ver: Version{Major: 1, Minor: 2, Patch: 3, Revision: 4}},
+		},
+		{{Op: OpCaret, Ver: Version{Epoch: 1, Major: 3, Revision: 7}}},
+	}}
+	is.Equal(">=2!1.0.0 <1.2.3.4 || ^1!3.0.0.7", r.String())
+
+	parsed, err := ParseRange(r.String())
+	is.NoError(err)
+	is.Equal(r.Requirements, parsed.Requirements)
+	is.True(parsed.Contains(Version{Epoch: 1, Major: 3, Minor: 1}))
+
+	_, err = ParseRange(">=!1.0.0")
+	is.Error(err)
+	_, err = ParseRange(">=1.2.3.04")
+	is.Error(err)
+}
+
+func TestVersionIsMinimalPrerelease(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.True(MustParse("2.0.0-0").IsMinimalPrerelease())
+	is.False(MustParse("2.0.0-0.0").IsMinimalPrerelease())
+	is.False(MustParse("2.0.0-1").IsMinimalPrerelease())
+	is.False(MustParse("2.0.0").IsMinimalPrerelease())
+	is.True(MustParse("2.0.0-0").LessThan(MustParse("2.0.0-0.0")))
+	is.True(MustParse("1.99.99").LessThan(MustParse("2.0.0-0")))
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+)
+
+// Common pre-release channel labels used by release trains.
+const (
+	ChannelLabelAlpha = "alpha"
+	ChannelLabelBeta  = "beta"
+	ChannelLabelRC    = "rc"
+)
+
+// Promote returns the final release of a pre-release version by dropping its pre-release
+// identifiers and build metadata. A release version is returned without build metadata.
+// The Epoch and Revision are kept.
+//
+// Example:
+//
+//	fmt.Println(semver.MustParse("1.4.0-rc.2+build.9").Promote()) // Output: 1.4.0
+func (v Version) Promote() Version {
+	return Version{Epoch: v.Epoch, Major: v.Major, Minor: v.Minor, Patch: v.Patch, Revision: v.Revision}
+}
+
+// StartPrerelease begins a release train by bumping the version at the given level and
+// appending the first pre-release of channel (e.g., "1.2.3" to "1.3.0-beta.1").
+//
+// Returns an error if channel is not a valid alphanumeric pre-release identifier.
+//
+// Example:
+//
+//	v, _ := semver.MustParse("1.2.3").StartPrerelease(semver.BumpMinor, semver.ChannelLabelAlpha)
+//	fmt.Println(v) // Output: 1.3.0-alpha.1
+func (v Version) StartPrerelease(level BumpLevel, channel string) (Version, error) {
+	label, err := channelIdentifier(channel)
+	if err != nil {
+		return Version{}, err
+	}
+
+	next := v.Promote().Bump(level)
+	next.PreRelease = []PrereleaseVersion{label, {partNumeric: 1, isNumeric: true}}
+	return next, nil
+}
+
+// NextPrerelease returns the next pre-release of the same version in the given channel.
+//
+// Within the same channel the trailing numeric identifier is incremented ("rc.2" to "rc.3").
+// Moving to a later channel starts it at 1 ("beta.4" to "rc.1"). Moving to an earlier channel,
+// such as from "rc.2" to "alpha", returns ErrIllegalTransition, as does calling NextPrerelease
+// on a release version; use StartPrerelease to begin a new train.
+//
+// Channels are ordered by Stability: dev, alpha, beta, RC.
+//
+// Example:
+//
+//	v, _ := semver.MustParse("2.0.0-beta.4").NextPrerelease(semver.ChannelLabelRC)
+//	fmt.Println(v) // Output: 2.0.0-rc.1
+func (v Version) NextPrerelease(channel string) (Version, error) {
+	label, err := channelIdentifier(channel)
+	if err != nil {
+		return Version{}, err
+	}
+	if len(v.PreRelease) == 0 {
+		return Version{}, fmt.Errorf("%w: %s is already released", ErrIllegalTransition, v)
+	}
+
+	current, target := StabilityOf(v), stabilityOfLabel(label.partString)
+	if target < current {
+		return Version{}, fmt.Errorf("%w: %s to %s", ErrIllegalTransition, v, channel)
+	}
+
+	next := v.Promote()
+	if v.PreRelease[0].Compare(label) != 0 {
+		next.PreRelease = []PrereleaseVersion{label, {partNumeric: 1, isNumeric: true}}
+		return next, nil
+	}
+
+	next.PreRelease = append([]PrereleaseVersion(nil), v.PreRelease...)
+	last := &next.PreRelease[len(next.PreRelease)-1]
+	if len(next.PreRelease) > 1 && last.isNumeric {
+		last.partNumeric++
+	} else {
+		next.PreRelease = append(next.PreRelease, PrereleaseVersion{partNumeric: 1, isNumeric: true})
+	}
+	return next, nil
+}
+
+// NextRC returns the next release candidate of the version, as NextPrerelease("rc").
+//
+// Example:
+//
+//	v, _ := semver.MustParse("1.4.0-rc.2").NextRC()
+//	fmt.Println(v) // Output: 1.4.0-rc.3
+func (v Version) NextRC() (Version, error) {
+	return v.NextPrerelease(ChannelLabelRC)
+}
+
+// ValidateTransition checks that moving from one version to another is a legal step in a
+// release train. The target must have higher precedence, and for the same epoch, major, minor,
+// patch, and revision, its Stability must not decrease (alpha, beta, rc, then final).
+//
+// Example:
+//
+//	err := semver.ValidateTransition(semver.MustParse("1.0.0-rc.2"), semver.MustParse("1.0.0-alpha.3"))
+//	fmt.Println(errors.Is(err, semver.ErrIllegalTransition)) // Output: true
+func ValidateTransition(from, to Version) error {
+	if !to.GreaterThan(from) {
+		return fmt.Errorf("%w: %s to %s does not increase precedence", ErrIllegalTransition, from, to)
+	}
+
+	sameCore := from.Epoch == to.Epoch && from.Major == to.Major && from.Minor == to.Minor &&
+		from.Patch == to.Patch && from.Revision == to.Revision
+	if sameCore && StabilityOf(to) < StabilityOf(from) {
+		return fmt.Errorf("%w: %s to %s decreases stability", ErrIllegalTransition, from, to)
+	}
+
+	return nil
+}
+
+// channelIdentifier validates a channel label as a single alphanumeric pre-release identifier.
+func channelIdentifier(channel string) (PrereleaseVersion, error) {
+	if channel == "" || isNumeric(channel) {
+		return PrereleaseVersion{}, ErrInvalidPrereleaseIdentifier
+	}
+	for i := 0; i < len(channel); i++ {
+		ch := channel[i]
+		if !(ch >= '0' && ch <= '9') && !(ch >= 'A' && ch <= 'Z') && !(ch >= 'a' && ch <= 'z') && ch != '-' {
+			return PrereleaseVersion{}, ErrInvalidCharacterInIdentifier
+		}
+	}
+	return PrereleaseVersion{partString: channel}, nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"slices"
+	"sort"
+)
+
+// ReleaseNote pairs a version with the notes written for it.
+type ReleaseNote struct {
+	Version Version
+	Notes   []string
+}
+
+// ReleaseGroup is a release and its notes, classified by the significance of the release.
+//
+// Level is BumpMajor, BumpMinor, or BumpPatch depending on the most significant component that
+// changed since the previous release. Notes holds the notes of the pre-releases leading up to the
+// release, in version order, followed by the notes of the release itself. Prereleases lists the
+// folded pre-releases.
+type ReleaseGroup struct {
+	Version     Version
+	Level       BumpLevel
+	Notes       []string
+	Prereleases []Version
+}
+
+// GroupedReleaseNotes holds release notes grouped by semantic version significance, as produced
+// by GroupReleaseNotes. Each group is ordered newest first, as changelogs are.
+type GroupedReleaseNotes struct {
+	// Breaking holds the major releases.
+	Breaking []ReleaseGroup
+
+	// Features holds the minor releases.
+	Features []ReleaseGroup
+
+	// Fixes holds the patch releases.
+	Fixes []ReleaseGroup
+
+	// Unreleased holds the notes of pre-releases that have no final release yet.
+	Unreleased []ReleaseNote
+}
+
+// GroupReleaseNotes groups the notes of the releases after from by semantic version significance,
+// for changelog generators.
+//
+// Each release is compared with the release before it, starting with from, and classified with
+// Distance: a new major version is a breaking change, a new minor version a feature, and anything
+// else a fix. Pre-releases are folded into the next final release, which is usually the release
+// they preview, or reported as Unreleased if there is none. Entries at or before from are ignored,
+// and entries of equal precedence are merged.
+//
+// Example:
+//
+//	notes := semver.GroupReleaseNotes(semver.MustParse("1.2.0"), []semver.ReleaseNote{
+//	    {Version: semver.MustParse("1.2.1"), Notes: []string{"Fix panic on empty input"}},
+//	    {Version: semver.MustParse("1.3.0-rc.1"), Notes: []string{"Add Sort"}},
+//	    {Version: semver.MustParse("1.3.0"), Notes: []string{"Add Reverse"}},
+//	    {Version: semver.MustParse("2.0.0"), Notes: []string{"Remove Legacy"}},
+//	})
+//	fmt.Println(notes.Features[0].Version, notes.Features[0].Notes) // Output: 1.3.0 [Add Sort Add Reverse]
+func GroupReleaseNotes(from Version, entries []ReleaseNote) *GroupedReleaseNotes {
+	sorted := make([]ReleaseNote, 0, len(entries))
+	for _, e := range entries {
+		if e.Version.Compare(from) > 0 {
+			sorted = append(sorted, e)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Version.Compare(sorted[j].Version) < 0 })
+
+	grouped := &GroupedReleaseNotes{}
+	var pending []ReleaseNote
+	prev := from
+	for i := 0; i < len(sorted); i++ {
+		e := ReleaseNote{Version: sorted[i].Version, Notes: append([]string(nil), sorted[i].Notes...)}
+		for i+1 < len(sorted) && sorted[i+1].Version.Compare(e.Version) == 0 {
+			i++
+			e.Notes = append(e.Notes, sorted[i].Notes...)
+		}
+
+		if len(e.Version.PreRelease) > 0 {
+			pending = append(pending, e)
+			continue
+		}
+
+		group := ReleaseGroup{Version: e.Version, Level: releaseLevel(prev, e.Version)}
+		for _, pre := range pending {
+			group.Notes = append(group.Notes, pre.Notes...)
+			group.Prereleases = append(group.Prereleases, pre.Version)
+		}
+		group.Notes = append(group.Notes, e.Notes...)
+		pending = nil
+		prev = e.Version
+
+		switch group.Level {
+		case BumpMajor:
+			grouped.Breaking = append(grouped.Breaking, group)
+		case BumpMinor:
+			grouped.Features = append(grouped.Features, group)
+		default:
+			grouped.Fixes = append(grouped.Fixes, group)
+		}
+	}
+	grouped.Unreleased = pending
+
+	slices.Reverse(grouped.Breaking)
+	slices.Reverse(grouped.Features)
+	slices.Reverse(grouped.Fixes)
+	slices.Reverse(grouped.Unreleased)
+	return grouped
+}
+
+// releaseLevel returns the significance of the release next following the release prev.
+func releaseLevel(prev, next Version) BumpLevel {
+	d := Distance(prev, next)
+	switch {
+	case prev.Epoch != next.Epoch || d.Majors > 0:
+		return BumpMajor
+	case d.Minors > 0:
+		return BumpMinor
+	default:
+		return BumpPatch
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupReleaseNotes(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	notes := GroupReleaseNotes(MustParse("1.2.0"), []ReleaseNote{
+		{Version: MustParse("2.0.0"), Notes: []string{"Remove Legacy"}},
+		{Version: MustParse("1.2.0"), Notes: []string{"Already released"}},
+		{Version: MustParse("1.2.1"), Notes: []string{"Fix panic"}},
+		{Version: MustParse("1.3.0-rc.1"), Notes: []string{"Add Sort"}},
+		{Version: MustParse("1.3.0"), Notes: []string{"Add Reverse"}},
+		{Version: MustParse("1.3.0-rc.2"), Notes: []string{"Add Filter"}},
+		{Version: MustParse("1.3.1"), Notes: []string{"Fix Sort"}},
+		{Version: MustParse("1.3.1+build.2"), Notes: []string{"Fix Filter"}},
+		{Version: MustParse("2.1.0-beta.1"), Notes: []string{"Add Map"}},
+	})
+
+	is.Len(notes.Breaking, 1)
+	is.Equal("2.0.0", notes.Breaking[0].Version.String())
+	is.Equal(BumpMajor, notes.Breaking[0].Level)
+
+	is.Len(notes.Features, 1)
+	is.Equal([]string{"Add Sort", "Add Filter", "Add Reverse"}, notes.Features[0].Notes)
+	is.Equal([]Version{MustParse("1.3.0-rc.1"), MustParse("1.3.0-rc.2")}, notes.Features[0].Prereleases)
+
+	is.Len(notes.Fixes, 2)
+	is.Equal("1.3.1", notes.Fixes[0].Version.String())
+	is.Equal([]string{"Fix Sort", "Fix Filter"}, notes.Fixes[0].Notes)
+	is.Equal("1.2.1", notes.Fixes[1].Version.String())
+
+	is.Equal([]ReleaseNote{{Version: MustParse("2.1.0-beta.1"), Notes: []string{"Add Map"}}}, notes.Unreleased)
+}
+
+func TestGroupReleaseNotesEmpty(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	notes := GroupReleaseNotes(MustParse("1.0.0"), nil)
+	is.Empty(notes.Breaking)
+	is.Empty(notes.Features)
+	is.Empty(notes.Fixes)
+	is.Empty(notes.Unreleased)
+
+	notes = GroupReleaseNotes(Version{}, []ReleaseNote{{Version: MustParse("0.1.0")}, {Version: MustParse("1.0.0")}})
+	is.Equal(BumpMinor, notes.Features[0].Level)
+	is.Equal(BumpMajor, notes.Breaking[0].Level)
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionPromote(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("1.4.0", MustParse("1.4.0-rc.2+build.9").Promote().String())
+	is.Equal("1.4.0", MustParse("1.4.0+build.9").Promote().String())
+
+	p, err := NewParser(WithEpoch(true))
+	is.NoError(err)
+	v, err := p.Parse("2!1.0.0-rc.1")
+	is.NoError(err)
+	is.Equal("2!1.0.0", v.Promote().String())
+	is.True(v.Promote().GreaterThan(v))
+
+	p, err = NewParser(WithRevision(true))
+	is.NoError(err)
+	v, err = p.Parse("1.2.3.4-rc.1")
+	is.NoError(err)
+	is.Equal("1.2.3.4", v.Promote().String())
+	is.True(v.Promote().GreaterThan(v))
+}
+
+func TestVersionStartPrerelease(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v, err := MustParse("1.2.3").StartPrerelease(BumpMinor, ChannelLabelAlpha)
+	is.NoError(err)
+	is.Equal("1.3.0-alpha.1", v.String())
+
+	v, err = MustParse("1.2.3").StartPrerelease(BumpMajor, ChannelLabelRC)
+	is.NoError(err)
+	is.Equal("2.0.0-rc.1", v.String())
+
+	_, err = MustParse("1.2.3").StartPrerelease(BumpPatch, "")
+	is.Error(err)
+	_, err = MustParse("1.2.3").StartPrerelease(BumpPatch, "rc.1")
+	is.Error(err)
+}
+
+func TestVersionNextPrerelease(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		version  string
+		channel  string
+		expected string
+		illegal  bool
+	}{
+		{"1.4.0-rc.2", ChannelLabelRC, "1.4.0-rc.3", false},
+		{"1.4.0-rc", ChannelLabelRC, "1.4.0-rc.1", false},
+		{"1.4.0-alpha.3", ChannelLabelBeta, "1.4.0-beta.1", false},
+		{"1.4.0-beta.4+build.7", ChannelLabelRC, "1.4.0-rc.1", false},
+		{"1.4.0-rc.1.2", ChannelLabelRC, "1.4.0-rc.1.3", false},
+		{"1.4.0-rc.2", ChannelLabelAlpha, "", true},
+		{"1.4.0-beta.2", ChannelLabelAlpha, "", true},
+		{"1.4.0", ChannelLabelRC, "", true},
+	}
+
+	for _, test := range tests {
+		v, err := MustParse(test.version).NextPrerelease(test.channel)
+		if test.illegal {
+			is.ErrorIs(err, ErrIllegalTransition, "Version %s to %s", test.version, test.channel)
+			continue
+		}
+		is.NoError(err, "Version %s to %s", test.version, test.channel)
+		is.Equal(test.expected, v.String())
+	}
+
+	v, err := MustParse("1.4.0-rc.2").NextRC()
+	is.NoError(err)
+	is.Equal("1.4.0-rc.3", v.String())
+
+	_, err = MustParse("1.4.0-rc.2").NextPrerelease("bad.label")
+	is.Error(err)
+}
+
+func TestValidateTransition(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		from  string
+		to    string
+		legal bool
+	}{
+		{"1.0.0-alpha.1", "1.0.0-alpha.2", true},
+		{"1.0.0-alpha.5", "1.0.0-beta.1", true},
+		{"1.0.0-beta.2", "1.0.0-rc.1", true},
+		{"1.0.0-rc.2", "1.0.0", true},
+		{"1.0.0", "1.0.1-alpha.1", true},
+		{"1.0.0-rc.2", "1.0.0-alpha.3", false},
+		{"1.0.0-rc.2", "1.0.0-snapshot", false},
+		{"1.0.0", "1.0.0-rc.3", false},
+		{"1.0.0", "1.0.0", false},
+	}
+
+	for _, test := range tests {
+		err := ValidateTransition(MustParse(test.from), MustParse(test.to))
+		if test.legal {
+			is.NoError(err, "Transition %s to %s", test.from, test.to)
+		} else {
+			is.ErrorIs(err, ErrIllegalTransition, "Transition %s to %s", test.from, test.to)
+		}
+	}
+}
+
+func TestReleaseTransitionsEpochRevision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithEpoch(true), WithRevision(true))
+	is.NoError(err)
+
+	parse := func(s string) Version {
+		v, err := p.Parse(s)
+		is.NoError(err, s)
+		return v
+	}
+
+	tests := []struct {
+		version  string
+		next     func(Version) (Version, error)
+		expected string
+	}{
+		{"2!1.4.0-rc.2", Version.NextRC, "2!1.4.0-rc.3"},
+		{"1.4.0.7-rc.2", Version.NextRC, "1.4.0.7-rc.3"},
+		{"2!1.4.0.7-beta.4", Version.NextRC, "2!1.4.0.7-rc.1"},
+		{"2!1.4.0.7-alpha.1", func(v Version) (Version, error) { return v.NextPrerelease(ChannelLabelBeta) }, "2!1.4.0.7-beta.1"},
+		{"2!1.2.3", func(v Version) (Version, error) { return v.StartPrerelease(BumpMinor, ChannelLabelAlpha) }, "2!1.3.0-alpha.1"},
+		{"1.2.3.4", func(v Version) (Version, error) { return v.StartPrerelease(BumpPatch, ChannelLabelRC) }, "1.2.4-rc.1"},
+		{"2!1.4.0.7-rc.3", func(v Version) (Version, error) { return v.Promote(), nil }, "2!1.4.0.7"},
+	}
+
+	for _, test := range tests {
+		v := parse(test.version)
+		next, err := test.next(v)
+		is.NoError(err, test.version)
+		is.Equal(test.expected, next.String(), test.version)
+		is.True(next.GreaterThan(v), "%s should follow %s", next, v)
+		is.NoError(ValidateTransition(v, next), "Transition %s to %s", v, next)
+	}
+
+	_, err = parse("2!1.4.0.7").NextRC()
+	is.ErrorIs(err, ErrIllegalTransition)
+	_, err = parse("2!1.4.0.7-rc.2").NextPrerelease(ChannelLabelAlpha)
+	is.ErrorIs(err, ErrIllegalTransition)
+
+	transitions := []struct {
+		from  string
+		to    string
+		legal bool
+	}{
+		{"1.0.0.1-rc.2", "1.0.0.2-alpha.1", true},
+		{"1.0.0-rc.2", "1!1.0.0-alpha.1", true},
+		{"1.0.0.1", "1.0.0.2", true},
+		{"2!1.0.0-rc.2", "2!1.0.0-alpha.3", false},
+		{"1.0.0.2-rc.2", "1.0.0.2-beta.1", false},
+		{"1!1.0.0", "2.0.0", false},
+		{"1.0.0.2", "1.0.0.1", false},
+		{"1.0.0.1", "1.0.0.1", false},
+	}
+
+	for _, test := range transitions {
+		err := ValidateTransition(parse(test.from), parse(test.to))
+		if test.legal {
+			is.NoError(err, "Transition %s to %s", test.from, test.to)
+		} else {
+			is.ErrorIs(err, ErrIllegalTransition, "Transition %s to %s", test.from, test.to)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// Retraction marks a set of versions as retracted, as a go.mod retract directive does. Retracted
+// versions remain valid and resolvable, but should not be selected unless explicitly requested.
+//
+// Range holds the retracted versions and Rationale the reason given for retracting them, which
+// may be empty.
+type Retraction struct {
+	Range     *VersionRange
+	Rationale string
+}
+
+// RetractVersion returns a Retraction of the single version v.
+//
+// Example:
+//
+//	r := semver.RetractVersion(semver.MustParse("1.0.1"), "Published with a broken build.")
+//	fmt.Println(r.Range) // Output: =1.0.1
+func RetractVersion(v Version, rationale string) Retraction {
+	return Retraction{
+		Range:     &VersionRange{Requirements: [][]Requirement{{{Op: OpEq, Ver: v}}}},
+		Rationale: rationale,
+	}
+}
+
+// RetractRange returns a Retraction of the closed interval [low, high], including the
+// pre-releases within it, as with the go.mod directive "retract [v1.0.0, v1.9.9]".
+//
+// Example:
+//
+//	r := semver.RetractRange(semver.MustParse("1.0.0"), semver.MustParse("1.9.9"), "")
+//	fmt.Println(r.Range) // Output: >=1.0.0 <=1.9.9
+func RetractRange(low, high Version, rationale string) Retraction {
+	return Retraction{
+		Range:     &VersionRange{Requirements: [][]Requirement{{{Op: OpGte, Ver: low}, {Op: OpLte, Ver: high}}}},
+		Rationale: rationale,
+	}
+}
+
+// Retractions is a list of retractions, such as those declared in the latest version of a Go
+// module's go.mod file.
+type Retractions []Retraction
+
+// IsRetracted reports whether v is retracted by any of the retractions.
+//
+// Example:
+//
+//	rs := semver.Retractions{semver.RetractVersion(semver.MustParse("1.0.1"), "")}
+//	fmt.Println(rs.IsRetracted(semver.MustParse("1.0.1"))) // Output: true
+func (rs Retractions) IsRetracted(v Version) bool {
+	_, ok := rs.Find(v)
+	return ok
+}
+
+// Find returns the first retraction of v. The boolean result is false if v is not retracted.
+//
+// Example:
+//
+//	rs := semver.Retractions{semver.RetractVersion(semver.MustParse("1.0.1"), "Broken build.")}
+//	r, _ := rs.Find(semver.MustParse("1.0.1"))
+//	fmt.Println(r.Rationale) // Output: Broken build.
+func (rs Retractions) Find(v Version) (Retraction, bool) {
+	for _, r := range rs {
+		if r.Range != nil && r.Range.Contains(v) {
+			return r, true
+		}
+	}
+	return Retraction{}, false
+}
+
+// allows reports whether v may be selected for the range: it must not be retracted, unless a
+// branch of the range requests it explicitly with an OpEq requirement, as "go get m@v1.0.1"
+// still selects a retracted v1.0.1.
+func (rs Retractions) allows(r *VersionRange, v Version) bool {
+	if len(rs) == 0 || !rs.IsRetracted(v) {
+		return true
+	}
+	for _, andReqs := range r.Requirements {
+		for _, req := range andReqs {
+			if req.Op == OpEq && req.Ver.Compare(v) == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// LatestInChannel is like the package-level LatestInChannel, but skips retracted versions.
+//
+// Example:
+//
+//	a, b := semver.MustParse("1.3.0"), semver.MustParse("1.4.0")
+//	rs := semver.Retractions{semver.RetractVersion(b, "")}
+//	v, ok := rs.LatestInChannel(semver.MustParse("1.2.0"), semver.ChannelStable, []*semver.Version{&a, &b})
+//	fmt.Println(v, ok) // Output: 1.3.0 true
+func (rs Retractions) LatestInChannel(current Version, channel Channel, available []*Version) (Version, bool) {
+	candidates := make([]*Version, 0, len(available))
+	for _, v := range available {
+		if v != nil && !rs.IsRetracted(*v) {
+			candidates = append(candidates, v)
+		}
+	}
+	return LatestInChannel(current, channel, candidates)
+}
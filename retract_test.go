@@ -0,0 +1,78 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetractions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	rs := Retractions{
+		RetractVersion(MustParse("1.0.1"), "Broken build."),
+		RetractRange(MustParse("1.2.0"), MustParse("1.2.9"), ""),
+	}
+	is.Equal(">=1.2.0 <=1.2.9", rs[1].Range.String())
+
+	is.True(rs.IsRetracted(MustParse("1.0.1")))
+	is.True(rs.IsRetracted(MustParse("1.2.5-rc.1")))
+	is.True(rs.IsRetracted(MustParse("1.2.9")))
+	is.False(rs.IsRetracted(MustParse("1.3.0")))
+	is.False(Retractions(nil).IsRetracted(MustParse("1.0.1")))
+
+	r, ok := rs.Find(MustParse("1.0.1+build.2"))
+	is.True(ok)
+	is.Equal("Broken build.", r.Rationale)
+	_, ok = rs.Find(MustParse("1.0.0"))
+	is.False(ok)
+}
+
+func TestRetractionsLatestInChannel(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	a, b, c := MustParse("1.3.0"), MustParse("1.4.0"), MustParse("1.5.0-rc.1")
+	rs := Retractions{RetractVersion(b, "")}
+
+	v, ok := rs.LatestInChannel(MustParse("1.2.0"), ChannelStable, []*Version{&a, &b, nil, &c})
+	is.True(ok)
+	is.Equal("1.3.0", v.String())
+
+	_, ok = rs.LatestInChannel(MustParse("1.3.0"), ChannelStable, []*Version{&a, &b})
+	is.False(ok)
+}
+
+func TestVersionIndexRetract(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	idx := NewVersionIndex(nil)
+	for _, s := range []string{"1.4.6", "1.4.7", "1.4.8", "1.5.0", "2.0.0"} {
+		idx.Insert(MustParse(s))
+	}
+	idx.Retract(RetractVersion(MustParse("1.4.8"), ""), RetractRange(MustParse("1.5.0"), MustParse("1.9.9"), ""))
+	is.Len(idx.Retractions(), 2)
+
+	v, ok := idx.MaxSatisfying(MustParseRange("^1.4.0"))
+	is.True(ok)
+	is.Equal("1.4.7", v.String())
+
+	v, ok = idx.MaxSatisfying(MustParseRange("=1.4.8"))
+	is.True(ok)
+	is.Equal("1.4.8", v.String())
+
+	v, ok = idx.MinSatisfying(MustParseRange(">1.4.7 <2.0.0"))
+	is.False(ok)
+	is.Equal(Version{}, v)
+
+	is.Equal([]Version{MustParse("1.4.6"), MustParse("1.4.7"), MustParse("2.0.0")}, idx.Satisfying(MustParseRange(">=1.0.0")))
+	is.True(idx.Contains(MustParse("1.4.8")))
+	is.Equal(5, idx.Len())
+}
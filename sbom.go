@@ -0,0 +1,215 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownSBOMFormat indicates that a document is neither a CycloneDX nor an SPDX JSON document.
+var ErrUnknownSBOMFormat = errors.New("unknown SBOM format")
+
+// SBOMFormat identifies the format of a software bill of materials.
+//
+// Supported Formats:
+//   - SBOMCycloneDX: A CycloneDX JSON document.
+//   - SBOMSPDX: An SPDX 2.x JSON document, or an SPDX 3 JSON-LD document.
+type SBOMFormat string
+
+const (
+	SBOMCycloneDX SBOMFormat = "CycloneDX"
+	SBOMSPDX      SBOMFormat = "SPDX"
+)
+
+// SBOMField is a version or version range field found in an SBOM document.
+//
+// Path locates the field, such as "components[2].version". Normalized is the canonical form
+// of Value, and is empty if the value cannot be represented by this package, in which case
+// Err describes why.
+type SBOMField struct {
+	Path       string
+	Value      string
+	Range      bool
+	Normalized string
+	Err        error
+}
+
+// Changed reports whether the field is representable but not in its canonical form.
+func (f SBOMField) Changed() bool {
+	return f.Err == nil && f.Normalized != f.Value
+}
+
+// InspectSBOM finds every version and version range field in a CycloneDX or SPDX JSON document,
+// in document order, and normalizes each with NormalizeSBOMVersion or NormalizeSBOMRange.
+//
+// The inspected fields are:
+//   - CycloneDX: the version of metadata.component and of every component, including nested
+//     components, and the version and range of every vulnerabilities[].affects[].versions[] entry.
+//   - SPDX 2.x: packages[].versionInfo.
+//   - SPDX 3: software_packageVersion of every element in @graph.
+//
+// Returns ErrUnknownSBOMFormat if the document is not recognized. Fields that cannot be
+// represented are reported with a non-nil Err rather than failing the inspection.
+//
+// Example:
+//
+//	format, fields, err := semver.InspectSBOM(data)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, f := range fields {
+//	    if f.Err != nil {
+//	        fmt.Printf("%s: %s: %v\n", format, f.Path, f.Err)
+//	    }
+//	}
+func InspectSBOM(data []byte) (SBOMFormat, []SBOMField, error) {
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return "", nil, err
+	}
+
+	var w sbomWalker
+	switch {
+	case doc["bomFormat"] == "CycloneDX":
+		if metadata, ok := doc["metadata"].(map[string]any); ok {
+			if component, ok := metadata["component"].(map[string]any); ok {
+				w.cycloneDXComponent("metadata.component", component)
+			}
+		}
+		w.cycloneDXComponents("components", doc["components"])
+		for i, vuln := range objects(doc["vulnerabilities"]) {
+			for j, affect := range objects(vuln["affects"]) {
+				for k, entry := range objects(affect["versions"]) {
+					path := fmt.Sprintf("vulnerabilities[%d].affects[%d].versions[%d]", i, j, k)
+					w.version(path+".version", entry["version"])
+					w.versionRange(path+".range", entry["range"])
+				}
+			}
+		}
+		return SBOMCycloneDX, w.fields, nil
+
+	case doc["spdxVersion"] != nil:
+		for i, pkg := range objects(doc["packages"]) {
+			w.version(fmt.Sprintf("packages[%d].versionInfo", i), pkg["versionInfo"])
+		}
+		return SBOMSPDX, w.fields, nil
+
+	case doc["@graph"] != nil:
+		for i, element := range objects(doc["@graph"]) {
+			w.version(fmt.Sprintf("@graph[%d].software_packageVersion", i), element["software_packageVersion"])
+		}
+		return SBOMSPDX, w.fields, nil
+	}
+
+	return "", nil, ErrUnknownSBOMFormat
+}
+
+// sbomWalker collects the fields found by InspectSBOM.
+type sbomWalker struct {
+	fields []SBOMField
+}
+
+// cycloneDXComponents inspects a CycloneDX component list.
+func (w *sbomWalker) cycloneDXComponents(path string, list any) {
+	for i, component := range objects(list) {
+		w.cycloneDXComponent(fmt.Sprintf("%s[%d]", path, i), component)
+	}
+}
+
+// cycloneDXComponent inspects a CycloneDX component and its nested components.
+func (w *sbomWalker) cycloneDXComponent(path string, component map[string]any) {
+	w.version(path+".version", component["version"])
+	w.cycloneDXComponents(path+".components", component["components"])
+}
+
+// version records a version field if it is present.
+func (w *sbomWalker) version(path string, value any) {
+	if s, ok := value.(string); ok {
+		normalized, err := NormalizeSBOMVersion(s)
+		w.fields = append(w.fields, SBOMField{Path: path, Value: s, Normalized: normalized, Err: err})
+	}
+}
+
+// versionRange records a version range field if it is present.
+func (w *sbomWalker) versionRange(path string, value any) {
+	if s, ok := value.(string); ok {
+		normalized, err := NormalizeSBOMRange(s)
+		w.fields = append(w.fields, SBOMField{Path: path, Value: s, Range: true, Normalized: normalized, Err: err})
+	}
+}
+
+// objects returns the elements of a JSON array that are objects.
+func objects(value any) []map[string]any {
+	list, _ := value.([]any)
+	result := make([]map[string]any, 0, len(list))
+	for _, element := range list {
+		if obj, ok := element.(map[string]any); ok {
+			result = append(result, obj)
+		}
+	}
+	return result
+}
+
+// NormalizeSBOMVersion validates an SBOM version field and returns its canonical form. The
+// version is parsed with ParseLenient, so "v1.2" becomes "1.2.0".
+//
+// Returns an error if the value cannot be represented as a Version, as with calendar-style,
+// distribution-specific, or free-text versions.
+//
+// Example:
+//
+//	v, err := semver.NormalizeSBOMVersion("v2.1")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 2.1.0
+func NormalizeSBOMVersion(s string) (string, error) {
+	v, _, err := ParseLenient(s)
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+// NormalizeSBOMRange validates an SBOM version range field and returns its canonical form.
+//
+// A "vers:" range specifier, as used by CycloneDX, is normalized by lowercasing its scheme,
+// removing whitespace, sorting its constraints by version, and omitting the implied "="
+// comparator. Its constraints must follow the vers rules: unique versions, and lower and upper
+// bounds that alternate. Any other value is parsed with ParseRange and returned in its String
+// form.
+//
+// Returns an error wrapping ErrInvalidVers or the range parse error if the value cannot be
+// represented as a VersionRange.
+//
+// Example:
+//
+//	r, err := semver.NormalizeSBOMRange("vers:NPM/<2.0.0 | >=1.2.3")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r) // Output: vers:npm/>=1.2.3|<2.0.0
+func NormalizeSBOMRange(s string) (string, error) {
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(s)), versPrefix) {
+		scheme, constraints, err := parseVers(s)
+		if err != nil {
+			return "", err
+		}
+		if _, err := versRequirements(constraints); err != nil {
+			return "", err
+		}
+		return formatVers(scheme, constraints), nil
+	}
+
+	r, err := ParseRange(s)
+	if err != nil {
+		return "", err
+	}
+	return r.String(), nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInspectSBOMCycloneDX(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	doc := `{
+		"bomFormat": "CycloneDX",
+		"specVersion": "1.6",
+		"metadata": {"component": {"name": "app", "version": "v3.1"}},
+		"components": [
+			{"name": "lib", "version": "1.2.3", "components": [{"name": "inner", "version": "1:2.3.4-1ubuntu1"}]},
+			{"name": "unversioned"}
+		],
+		"vulnerabilities": [{
+			"affects": [{"versions": [
+				{"version": "1.2.3"},
+				{"range": "vers:NPM/<2.0.0|>=1.2.3"},
+				{"range": "vers:npm/>=1.0.0|>=1.5.0"}
+			]}]
+		}]
+	}`
+
+	format, fields, err := InspectSBOM([]byte(doc))
+	is.NoError(err)
+	is.Equal(SBOMCycloneDX, format)
+	is.Len(fields, 6)
+
+	paths := make([]string, len(fields))
+	for i, f := range fields {
+		paths[i] = f.Path
+	}
+	is.Equal([]string{
+		"metadata.component.version",
+		"components[0].version",
+		"components[0].components[0].version",
+		"vulnerabilities[0].affects[0].versions[0].version",
+		"vulnerabilities[0].affects[0].versions[1].range",
+		"vulnerabilities[0].affects[0].versions[2].range",
+	}, paths)
+
+	is.Equal("3.1.0", fields[0].Normalized)
+	is.True(fields[0].Changed())
+	is.False(fields[1].Changed())
+	is.Error(fields[2].Err, "Debian epochs are not representable")
+	is.False(fields[2].Changed())
+	is.True(fields[4].Range)
+	is.Equal("vers:npm/>=1.2.3|<2.0.0", fields[4].Normalized)
+	is.ErrorIs(fields[5].Err, ErrInvalidVers)
+}
+
+func TestInspectSBOMSPDX(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	format, fields, err := InspectSBOM([]byte(`{
+		"spdxVersion": "SPDX-2.3",
+		"packages": [{"name": "a", "versionInfo": "1.0"}, {"name": "b", "versionInfo": "NOASSERTION"}]
+	}`))
+	is.NoError(err)
+	is.Equal(SBOMSPDX, format)
+	is.Len(fields, 2)
+	is.Equal("packages[0].versionInfo", fields[0].Path)
+	is.Equal("1.0.0", fields[0].Normalized)
+	is.Error(fields[1].Err)
+
+	format, fields, err = InspectSBOM([]byte(`{"@graph": [{"type": "software_Package", "software_packageVersion": "2.0.0"}]}`))
+	is.NoError(err)
+	is.Equal(SBOMSPDX, format)
+	is.Len(fields, 1)
+	is.NoError(fields[0].Err)
+
+	_, _, err = InspectSBOM([]byte(`{"name": "not an sbom"}`))
+	is.ErrorIs(err, ErrUnknownSBOMFormat)
+
+	_, _, err = InspectSBOM([]byte(`not json`))
+	is.Error(err)
+}
+
+func TestNormalizeSBOMRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"vers:npm/1.2.3", "vers:npm/1.2.3"},
+		{"vers:npm/=1.2.3", "vers:npm/1.2.3"},
+		{"vers:golang/ >= v1.2.3 | < v2.0.0 ", "vers:golang/>=1.2.3|<2.0.0"},
+		{"vers:npm/*", "vers:npm/*"},
+		{"vers:npm/!=1.0.0|<2.0.0|>=3.0.0", "vers:npm/!=1.0.0|<2.0.0|>=3.0.0"},
+		{">=1.0.0 <2.0.0", ">=1.0.0 <2.0.0"},
+	}
+	for _, tt := range tests {
+		got, err := NormalizeSBOMRange(tt.input)
+		is.NoError(err, tt.input)
+		is.Equal(tt.expected, got, tt.input)
+	}
+
+	for _, input := range []string{
+		"vers:npm",
+		"vers:/1.0.0",
+		"vers:npm/",
+		"vers:npm/1.0.0|=1.0.0",
+		"vers:npm/<1.0.0|<2.0.0",
+		"vers:pypi/1.0.post1",
+		"not a range",
+	} {
+		_, err := NormalizeSBOMRange(input)
+		is.Error(err, input)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// ScanVersion parses the version that starts at offset start in s and returns it along with
+// the offset just past it, so that versions can be read from within larger inputs such as
+// range expressions, manifests, or query languages without slicing them out first.
+//
+// The version extends over the longest run of characters that may appear in a version:
+// digits, ASCII letters, '-', '.', and '+'. Trailing dots are not part of the version, so a
+// version that ends a sentence is scanned without the full stop. The run is then validated
+// as Parse would validate it. On error, the returned Version is the zero value and next is
+// still the offset just past the rejected run, which lets callers skip it and continue.
+//
+// ScanVersion panics if start is negative or greater than len(s).
+//
+// Example:
+//
+//	s := ">=1.2.3-rc.1 <2.0.0"
+//	v, next, err := semver.ScanVersion(s, 2)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v, next, s[next:]) // Output: 1.2.3-rc.1 12  <2.0.0
+func ScanVersion(s string, start int) (v Version, next int, err error) {
+	next = start + versionTokenLen(s[start:])
+	v, err = DefaultParser.Parse(s[start:next])
+	if err != nil {
+		return Version{}, next, err
+	}
+	return v, next, nil
+}
+
+// versionTokenLen returns the length of the prefix of s made up of version characters,
+// excluding trailing dots.
+func versionTokenLen(s string) int {
+	n := 0
+	for n < len(s) && isVersionChar(s[n]) {
+		n++
+	}
+	for n > 0 && s[n-1] == '.' {
+		n--
+	}
+	return n
+}
+
+// isVersionChar reports whether ch may appear anywhere in a version string.
+func isVersionChar(ch byte) bool {
+	return (ch >= '0' && ch <= '9') ||
+		(ch >= 'A' && ch <= 'Z') ||
+		(ch >= 'a' && ch <= 'z') ||
+		ch == '-' || ch == '.' || ch == '+'
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input    string
+		start    int
+		expected string
+		next     int
+	}{
+		{"1.2.3", 0, "1.2.3", 5},
+		{">=1.2.3-rc.1 <2.0.0", 2, "1.2.3-rc.1", 12},
+		{">=1.2.3-rc.1 <2.0.0", 14, "2.0.0", 19},
+		{"1.2.3||2.0.0", 7, "2.0.0", 12},
+		{"1.2.3+build.5,1.4.0", 0, "1.2.3+build.5", 13},
+		{"Upgrade to 1.4.0.", 11, "1.4.0", 16},
+		{"pkg@1.0.0-beta (latest)", 4, "1.0.0-beta", 14},
+	}
+
+	for _, test := range tests {
+		v, next, err := ScanVersion(test.input, test.start)
+		is.NoError(err, "Input %q at %d", test.input, test.start)
+		is.Equal(test.expected, v.String(), "Input %q at %d", test.input, test.start)
+		is.Equal(test.next, next, "Input %q at %d", test.input, test.start)
+	}
+}
+
+func TestScanVersionErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v, next, err := ScanVersion("a 1.02.3 b", 2)
+	is.ErrorIs(err, ErrLeadingZeroInNumericIdentifier)
+	is.Equal(Version{}, v)
+	is.Equal(8, next)
+
+	_, next, err = ScanVersion(">=1.2.3", 0)
+	is.ErrorIs(err, ErrEmptyVersionString)
+	is.Equal(0, next)
+
+	_, next, err = ScanVersion("1.2.3", 5)
+	is.ErrorIs(err, ErrEmptyVersionString)
+	is.Equal(5, next)
+
+	_, _, err = ScanVersion("1.2.3.4", 0)
+	is.Error(err)
+
+	is.Panics(func() { _, _, _ = ScanVersion("1.2.3", 6) })
+}
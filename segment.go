@@ -0,0 +1,100 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+)
+
+// Ordinals of the numeric segments of a Version, for use with Segment and SetSegment.
+const (
+	SegmentMajor = iota
+	SegmentMinor
+	SegmentPatch
+	SegmentRevision
+)
+
+// NumSegments returns the number of numeric segments of the Version, which Segment and
+// SetSegment address by ordinals from zero up to, but excluding, this number. It is 3 for
+// Semantic Versioning versions and 4 for versions with a non-zero Revision.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3")
+//	for i := 0; i < v.NumSegments(); i++ {
+//	    n, _ := v.Segment(i)
+//	    fmt.Println(n)
+//	}
+//
+// Output:
+// 1
+// 2
+// 3
+func (v Version) NumSegments() int {
+	if v.Revision != 0 {
+		return 4
+	}
+	return 3
+}
+
+// Segment returns the numeric segment at ordinal i: SegmentMajor, SegmentMinor, SegmentPatch,
+// or SegmentRevision, which is addressable even if it is zero. It returns ErrSegmentOutOfRange
+// for any other ordinal.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3-beta")
+//	n, err := v.Segment(semver.SegmentMinor)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(n) // Output: 2
+func (v Version) Segment(i int) (uint64, error) {
+	switch i {
+	case SegmentMajor:
+		return v.Major, nil
+	case SegmentMinor:
+		return v.Minor, nil
+	case SegmentPatch:
+		return v.Patch, nil
+	case SegmentRevision:
+		return v.Revision, nil
+	default:
+		return 0, segmentOutOfRange(i)
+	}
+}
+
+// SetSegment sets the numeric segment at ordinal i to n, leaving every other segment,
+// the pre-release identifiers, and the build metadata unchanged. It returns
+// ErrSegmentOutOfRange for an ordinal that Segment would reject.
+//
+// Example:
+//
+//	v := semver.MustParse("1.2.3")
+//	if err := v.SetSegment(semver.SegmentPatch, 9); err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.9
+func (v *Version) SetSegment(i int, n uint64) error {
+	switch i {
+	case SegmentMajor:
+		v.Major = n
+	case SegmentMinor:
+		v.Minor = n
+	case SegmentPatch:
+		v.Patch = n
+	case SegmentRevision:
+		v.Revision = n
+	default:
+		return segmentOutOfRange(i)
+	}
+	return nil
+}
+
+// segmentOutOfRange returns the error for an ordinal that addresses no segment.
+func segmentOutOfRange(i int) error {
+	return fmt.Errorf("%w: %d not in [%d, %d]", ErrSegmentOutOfRange, i, SegmentMajor, SegmentRevision)
+}
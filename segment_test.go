@@ -0,0 +1,60 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionSegment(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := MustParse("4.5.6-rc.1+build")
+	is.Equal(3, v.NumSegments())
+
+	for i, expected := range []uint64{4, 5, 6} {
+		n, err := v.Segment(i)
+		is.NoError(err)
+		is.Equal(expected, n)
+	}
+
+	n, err := v.Segment(SegmentRevision)
+	is.NoError(err)
+	is.Zero(n)
+
+	for _, i := range []int{-1, 4} {
+		_, err := v.Segment(i)
+		is.ErrorIs(err, ErrSegmentOutOfRange)
+	}
+}
+
+func TestVersionSetSegment(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := MustParse("1.2.3-rc.1+build")
+	is.NoError(v.SetSegment(SegmentMajor, 7))
+	is.NoError(v.SetSegment(SegmentMinor, 8))
+	is.NoError(v.SetSegment(SegmentPatch, 9))
+	is.Equal("7.8.9-rc.1+build", v.String())
+
+	is.ErrorIs(v.SetSegment(4, 1), ErrSegmentOutOfRange)
+	is.ErrorIs(v.SetSegment(-1, 1), ErrSegmentOutOfRange)
+	is.Equal("7.8.9-rc.1+build", v.String())
+
+	var built Version
+	for i := 0; i < built.NumSegments(); i++ {
+		is.NoError(built.SetSegment(i, uint64(i+1)))
+	}
+	is.Equal("1.2.3", built.String())
+
+	is.NoError(built.SetSegment(SegmentRevision, 4))
+	is.Equal(4, built.NumSegments())
+	is.Equal("1.2.3.4", built.String())
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package semvergate provides the version gating policy shared by the semverhttp middleware
+// and the semvergrpc interceptors.
+//
+// A Policy maps routes, such as URL paths or gRPC method names, to the range of client
+// versions each one supports. Evaluating a request against the policy yields the negotiated
+// version, or a *Rejection describing why the request was refused and which versions would
+// have been accepted, so every transport reports version errors the same way.
+package semvergate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+var (
+	// ErrMissingVersion indicates that the request does not name a version and no default is set.
+	ErrMissingVersion = errors.New("missing API version")
+
+	// ErrUnsupportedVersion indicates that the requested version is outside the supported range.
+	ErrUnsupportedVersion = errors.New("unsupported API version")
+)
+
+// Reason classifies why a request was rejected.
+//
+// Supported Reasons:
+//   - ReasonMissing: The request does not name a version.
+//   - ReasonMalformed: The requested version cannot be parsed.
+//   - ReasonUnsupported: The requested version is outside the supported range.
+type Reason int
+
+const (
+	ReasonMissing Reason = iota
+	ReasonMalformed
+	ReasonUnsupported
+)
+
+// String returns the string representation of the Reason.
+//
+// Example:
+//
+//	fmt.Println(semvergate.ReasonUnsupported.String()) // Output: unsupported
+func (r Reason) String() string {
+	switch r {
+	case ReasonMissing:
+		return "missing"
+	case ReasonMalformed:
+		return "malformed"
+	case ReasonUnsupported:
+		return "unsupported"
+	default:
+		return "unknown"
+	}
+}
+
+// Rejection is the error returned when a request fails a Policy.
+//
+// Version is the version as the client sent it, and Supported lists the branches of the
+// range that applies to the route, so clients can choose a version to retry with.
+type Rejection struct {
+	Reason    Reason
+	Route     string
+	Version   string
+	Supported []string
+	Err       error
+}
+
+// Error returns the message of the underlying error.
+func (r *Rejection) Error() string {
+	return r.Err.Error()
+}
+
+// Unwrap returns the underlying error: ErrMissingVersion, ErrUnsupportedVersion, or the
+// parse error of a malformed version.
+func (r *Rejection) Unwrap() error {
+	return r.Err
+}
+
+// Policy describes which client versions each route supports.
+//
+// Routes maps a route to its supported range, and Supported applies to every route without
+// an entry; a nil range accepts every version. Default, if set, is negotiated for requests
+// that do not name a version, and is still checked against the route's range.
+type Policy struct {
+	Supported *semver.VersionRange
+	Routes    map[string]*semver.VersionRange
+	Default   *semver.Version
+}
+
+// Range returns the range of versions the route supports, or nil if it supports every version.
+func (p Policy) Range(route string) *semver.VersionRange {
+	if r, ok := p.Routes[route]; ok {
+		return r
+	}
+	return p.Supported
+}
+
+// Evaluate negotiates the version of a request to the route, where raw is the version the
+// client sent, or "" if it sent none.
+//
+// The version is parsed with semver.ParseLenient, so "2", "v2", and "2.0.0" all name 2.0.0.
+// Returns a *Rejection if the version is missing, malformed, or unsupported.
+//
+// Example:
+//
+//	p := semvergate.Policy{
+//	    Supported: semver.MustParseRange(">=1.0.0 <3.0.0"),
+//	    Routes:    map[string]*semver.VersionRange{"/v2/reports": semver.MustParseRange("^2.1.0")},
+//	}
+//	_, err := p.Evaluate("/v2/reports", "2.0.0")
+//	var rej *semvergate.Rejection
+//	if errors.As(err, &rej) {
+//	    fmt.Println(rej.Reason, rej.Supported) // Output: unsupported [^2.1.0]
+//	}
+func (p Policy) Evaluate(route, raw string) (semver.Version, error) {
+	r := p.Range(route)
+
+	var v semver.Version
+	switch {
+	case raw != "":
+		var err error
+		if v, _, err = semver.ParseLenient(raw); err != nil {
+			return semver.Version{}, p.reject(ReasonMalformed, route, raw, err)
+		}
+	case p.Default != nil:
+		v = *p.Default
+	default:
+		return semver.Version{}, p.reject(ReasonMissing, route, raw, ErrMissingVersion)
+	}
+
+	if r != nil && !r.Contains(v) {
+		return semver.Version{}, p.reject(ReasonUnsupported, route, raw, fmt.Errorf("%w: %s", ErrUnsupportedVersion, v))
+	}
+	return v, nil
+}
+
+// reject returns a Rejection for the route.
+func (p Policy) reject(reason Reason, route, raw string, err error) *Rejection {
+	return &Rejection{Reason: reason, Route: route, Version: raw, Supported: Branches(p.Range(route)), Err: err}
+}
+
+// Branches returns the string form of each branch of the range, or ["*"] for a nil range.
+//
+// Example:
+//
+//	fmt.Println(semvergate.Branches(semver.MustParseRange("^1.0.0 || ^2.0.0")))
+//	// Output: [^1.0.0 ^2.0.0]
+func Branches(r *semver.VersionRange) []string {
+	if r == nil {
+		return []string{"*"}
+	}
+
+	branches := make([]string, 0, len(r.Requirements))
+	for _, andReqs := range r.Requirements {
+		parts := make([]string, len(andReqs))
+		for i, req := range andReqs {
+			parts[i] = req.String()
+		}
+		branches = append(branches, strings.Join(parts, " "))
+	}
+	return branches
+}
+
+// contextKey is the type of the context key the negotiated version is stored under.
+type contextKey struct{}
+
+// NewContext returns a copy of ctx that carries the negotiated version.
+func NewContext(ctx context.Context, v semver.Version) context.Context {
+	return context.WithValue(ctx, contextKey{}, v)
+}
+
+// FromContext returns the version stored in ctx by NewContext.
+func FromContext(ctx context.Context) (semver.Version, bool) {
+	v, ok := ctx.Value(contextKey{}).(semver.Version)
+	return v, ok
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semvergate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyEvaluate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p := Policy{
+		Supported: semver.MustParseRange(">=1.0.0 <3.0.0"),
+		Routes:    map[string]*semver.VersionRange{"/reports": semver.MustParseRange("^2.1.0")},
+	}
+
+	v, err := p.Evaluate("/users", "v2")
+	is.NoError(err)
+	is.Equal("2.0.0", v.String())
+
+	_, err = p.Evaluate("/reports", "2.0.0")
+	var rej *Rejection
+	is.True(errors.As(err, &rej))
+	is.ErrorIs(err, ErrUnsupportedVersion)
+	is.Equal(ReasonUnsupported, rej.Reason)
+	is.Equal("/reports", rej.Route)
+	is.Equal("2.0.0", rej.Version)
+	is.Equal([]string{"^2.1.0"}, rej.Supported)
+
+	_, err = p.Evaluate("/users", "")
+	is.ErrorIs(err, ErrMissingVersion)
+	is.True(errors.As(err, &rej))
+	is.Equal(ReasonMissing, rej.Reason)
+
+	_, err = p.Evaluate("/users", "one")
+	is.True(errors.As(err, &rej))
+	is.Equal(ReasonMalformed, rej.Reason)
+	is.Equal("one", rej.Version)
+}
+
+func TestPolicyDefault(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	def := semver.MustParse("1.5.0")
+	p := Policy{Default: &def, Routes: map[string]*semver.VersionRange{"/v2": semver.MustParseRange(">=2.0.0")}}
+
+	v, err := p.Evaluate("/any", "")
+	is.NoError(err)
+	is.Equal("1.5.0", v.String())
+
+	v, err = p.Evaluate("/any", "99")
+	is.NoError(err, "A nil range should accept every version")
+	is.Equal("99.0.0", v.String())
+
+	_, err = p.Evaluate("/v2", "")
+	is.ErrorIs(err, ErrUnsupportedVersion, "The default should be checked against the route's range")
+	is.Nil(p.Range("/other"))
+}
+
+func TestBranches(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal([]string{"*"}, Branches(nil))
+	is.Equal([]string{"^1.0.0", "^2.0.0"}, Branches(semver.MustParseRange("^1.0.0 || ^2.0.0")))
+}
+
+func TestReasonString(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("missing", ReasonMissing.String())
+	is.Equal("malformed", ReasonMalformed.String())
+	is.Equal("unsupported", ReasonUnsupported.String())
+	is.Equal("unknown", Reason(99).String())
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, ok := FromContext(context.Background())
+	is.False(ok)
+
+	v, ok := FromContext(NewContext(context.Background(), semver.MustParse("1.2.3")))
+	is.True(ok)
+	is.Equal("1.2.3", v.String())
+}
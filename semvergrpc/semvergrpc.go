@@ -0,0 +1,207 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package semvergrpc provides unary and stream server interceptors that gate gRPC calls on the
+// client's API version, using the same semvergate.Policy as the semverhttp middleware.
+//
+// The package does not depend on google.golang.org/grpc. Metadata is read through a
+// MetadataFunc and calls are passed through plain handler functions, so an Interceptor is
+// wired into a server with a few lines of adapter code:
+//
+//	gate := semvergrpc.New(policy,
+//	    func(ctx context.Context) map[string][]string {
+//	        md, _ := metadata.FromIncomingContext(ctx)
+//	        return md
+//	    },
+//	    semvergrpc.WithAnnotator(func(ctx context.Context, md map[string][]string) error {
+//	        return grpc.SetHeader(ctx, md)
+//	    }),
+//	    semvergrpc.WithErrorMapper(func(err error) error {
+//	        return status.Error(codes.Code(semvergrpc.Code(err)), err.Error())
+//	    }),
+//	)
+//	srv := grpc.NewServer(
+//	    grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+//	        return gate.Unary(ctx, req, info.FullMethod, handler)
+//	    }),
+//	    grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+//	        return gate.Stream(ss.Context(), info.FullMethod, func(ctx context.Context) error {
+//	            return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+//	        })
+//	    }),
+//	)
+//
+// where contextStream is a grpc.ServerStream whose Context method returns ctx.
+package semvergrpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/sixafter/semver/semvergate"
+)
+
+// Metadata keys used by the interceptors. gRPC metadata keys are lowercase.
+const (
+	// DefaultMetadataKey is the incoming metadata key the client version is read from by default.
+	DefaultMetadataKey = "api-version"
+
+	// NegotiatedMetadataKey is the header key that carries the negotiated version of an accepted call.
+	NegotiatedMetadataKey = "api-negotiated-version"
+
+	// SupportedMetadataKey is the header key that lists the supported ranges of a rejected call.
+	SupportedMetadataKey = "api-supported-versions"
+)
+
+// Status codes returned by Code. The values are those of google.golang.org/grpc/codes.
+const (
+	CodeOK                 uint32 = 0
+	CodeUnknown            uint32 = 2
+	CodeInvalidArgument    uint32 = 3
+	CodeFailedPrecondition uint32 = 9
+)
+
+// MetadataFunc returns the incoming metadata of a call, such as metadata.FromIncomingContext.
+type MetadataFunc func(ctx context.Context) map[string][]string
+
+// options holds the settings applied by Option functions.
+type options struct {
+	key      string
+	annotate func(context.Context, map[string][]string) error
+	mapError func(error) error
+}
+
+// Option configures an Interceptor.
+type Option func(*options)
+
+// WithMetadataKey reads the client version from the given metadata key instead of
+// DefaultMetadataKey. The key is lowercased, as gRPC does.
+func WithMetadataKey(key string) Option {
+	return func(o *options) {
+		o.key = strings.ToLower(key)
+	}
+}
+
+// WithAnnotator calls fn with the response header metadata of each call, such as
+// grpc.SetHeader: the negotiated version under NegotiatedMetadataKey for accepted calls, and
+// the supported ranges under SupportedMetadataKey for rejected calls. An error from fn fails
+// the call.
+func WithAnnotator(fn func(ctx context.Context, md map[string][]string) error) Option {
+	return func(o *options) {
+		o.annotate = fn
+	}
+}
+
+// WithErrorMapper converts the *semvergate.Rejection of a rejected call into the error the
+// interceptor returns, such as a status error built with Code.
+func WithErrorMapper(fn func(error) error) Option {
+	return func(o *options) {
+		o.mapError = fn
+	}
+}
+
+// Interceptor gates calls on the client version according to a semvergate.Policy whose routes
+// are full method names, such as "/pkg.Service/Method".
+type Interceptor struct {
+	policy   semvergate.Policy
+	metadata MetadataFunc
+	opts     options
+}
+
+// New returns an Interceptor that reads incoming metadata with md and evaluates calls against
+// the policy.
+//
+// Example:
+//
+//	gate := semvergrpc.New(semvergate.Policy{
+//	    Supported: semver.MustParseRange(">=1.0.0 <3.0.0"),
+//	    Routes: map[string]*semver.VersionRange{
+//	        "/reports.Reports/Export": semver.MustParseRange(">=2.1.0 <3.0.0"),
+//	    },
+//	}, incomingMetadata)
+func New(p semvergate.Policy, md MetadataFunc, opts ...Option) *Interceptor {
+	o := options{key: DefaultMetadataKey}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Interceptor{policy: p, metadata: md, opts: o}
+}
+
+// Negotiate evaluates the call to the full method and returns a copy of ctx carrying the
+// negotiated version, readable with semvergate.FromContext.
+//
+// Returns the mapped *semvergate.Rejection if the call is rejected, or the annotator's error.
+func (i *Interceptor) Negotiate(ctx context.Context, fullMethod string) (context.Context, error) {
+	raw := ""
+	if values := i.metadata(ctx)[i.opts.key]; len(values) > 0 {
+		raw = strings.TrimSpace(values[0])
+	}
+
+	v, err := i.policy.Evaluate(fullMethod, raw)
+	if err != nil {
+		var rej *semvergate.Rejection
+		if errors.As(err, &rej) && i.opts.annotate != nil {
+			if aerr := i.opts.annotate(ctx, map[string][]string{SupportedMetadataKey: rej.Supported}); aerr != nil {
+				return ctx, aerr
+			}
+		}
+		if i.opts.mapError != nil {
+			err = i.opts.mapError(err)
+		}
+		return ctx, err
+	}
+
+	if i.opts.annotate != nil {
+		if err := i.opts.annotate(ctx, map[string][]string{NegotiatedMetadataKey: {v.String()}}); err != nil {
+			return ctx, err
+		}
+	}
+	return semvergate.NewContext(ctx, v), nil
+}
+
+// Unary negotiates the version of a unary call and, if it is accepted, invokes the handler
+// with a context carrying the version. Its parameters match those of a
+// grpc.UnaryServerInterceptor, with the method name taken from grpc.UnaryServerInfo.
+func (i *Interceptor) Unary(ctx context.Context, req any, fullMethod string, handler func(context.Context, any) (any, error)) (any, error) {
+	ctx, err := i.Negotiate(ctx, fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// Stream negotiates the version of a streaming call and, if it is accepted, invokes the
+// handler with a context carrying the version, which the handler should expose as the
+// context of the server stream.
+func (i *Interceptor) Stream(ctx context.Context, fullMethod string, handler func(context.Context) error) error {
+	ctx, err := i.Negotiate(ctx, fullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(ctx)
+}
+
+// Code returns the gRPC status code for an error returned by Negotiate: CodeOK for nil,
+// CodeInvalidArgument for a missing or malformed version, CodeFailedPrecondition for an
+// unsupported version, and CodeUnknown otherwise.
+//
+// Example:
+//
+//	err := status.Error(codes.Code(semvergrpc.Code(rejection)), rejection.Error())
+func Code(err error) uint32 {
+	if err == nil {
+		return CodeOK
+	}
+
+	var rej *semvergate.Rejection
+	if !errors.As(err, &rej) {
+		return CodeUnknown
+	}
+	if rej.Reason == semvergate.ReasonUnsupported {
+		return CodeFailedPrecondition
+	}
+	return CodeInvalidArgument
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semvergrpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/sixafter/semver/semvergate"
+	"github.com/stretchr/testify/assert"
+)
+
+// mdKey is the context key the tests store incoming metadata under.
+type mdKey struct{}
+
+func incoming(ctx context.Context) map[string][]string {
+	md, _ := ctx.Value(mdKey{}).(map[string][]string)
+	return md
+}
+
+func withVersion(version string) context.Context {
+	return context.WithValue(context.Background(), mdKey{}, map[string][]string{DefaultMetadataKey: {version}})
+}
+
+var policy = semvergate.Policy{
+	Supported: semver.MustParseRange(">=1.0.0 <3.0.0"),
+	Routes:    map[string]*semver.VersionRange{"/reports.Reports/Export": semver.MustParseRange(">=2.1.0 <3.0.0")},
+}
+
+func TestUnary(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gate := New(policy, incoming)
+	handler := func(ctx context.Context, req any) (any, error) {
+		v, _ := semvergate.FromContext(ctx)
+		return v.String(), nil
+	}
+
+	resp, err := gate.Unary(withVersion("2.0"), nil, "/users.Users/Get", handler)
+	is.NoError(err)
+	is.Equal("2.0.0", resp)
+
+	_, err = gate.Unary(withVersion("2.0.0"), nil, "/reports.Reports/Export", handler)
+	is.ErrorIs(err, semvergate.ErrUnsupportedVersion)
+	is.Equal(CodeFailedPrecondition, Code(err))
+
+	_, err = gate.Unary(context.Background(), nil, "/users.Users/Get", handler)
+	is.ErrorIs(err, semvergate.ErrMissingVersion)
+	is.Equal(CodeInvalidArgument, Code(err))
+}
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	gate := New(policy, incoming, WithMetadataKey("X-Client-Version"))
+	ctx := context.WithValue(context.Background(), mdKey{}, map[string][]string{"x-client-version": {"2.5.0"}})
+
+	called := false
+	err := gate.Stream(ctx, "/reports.Reports/Export", func(ctx context.Context) error {
+		v, ok := semvergate.FromContext(ctx)
+		is.True(ok)
+		is.Equal("2.5.0", v.String())
+		called = true
+		return nil
+	})
+	is.NoError(err)
+	is.True(called)
+
+	err = gate.Stream(withVersion("2.5.0"), "/reports.Reports/Export", func(context.Context) error { return nil })
+	is.ErrorIs(err, semvergate.ErrMissingVersion, "The default key should be ignored")
+}
+
+func TestAnnotateAndMapErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var headers []map[string][]string
+	errMapped := errors.New("mapped")
+	gate := New(policy, incoming,
+		WithAnnotator(func(_ context.Context, md map[string][]string) error {
+			headers = append(headers, md)
+			return nil
+		}),
+		WithErrorMapper(func(err error) error {
+			return errors.Join(errMapped, err)
+		}),
+	)
+
+	_, err := gate.Negotiate(withVersion("1.2.3"), "/users.Users/Get")
+	is.NoError(err)
+	_, err = gate.Negotiate(withVersion("4.0.0"), "/users.Users/Get")
+	is.ErrorIs(err, errMapped)
+	is.Equal(CodeFailedPrecondition, Code(err))
+
+	is.Equal([]map[string][]string{
+		{NegotiatedMetadataKey: {"1.2.3"}},
+		{SupportedMetadataKey: {">=1.0.0 <3.0.0"}},
+	}, headers)
+
+	failing := New(policy, incoming, WithAnnotator(func(context.Context, map[string][]string) error { return errMapped }))
+	_, err = failing.Negotiate(withVersion("1.2.3"), "/users.Users/Get")
+	is.ErrorIs(err, errMapped)
+}
+
+func TestCode(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal(CodeOK, Code(nil))
+	is.Equal(CodeUnknown, Code(errors.New("other")))
+	_, err := policy.Evaluate("/users.Users/Get", "bad")
+	is.Equal(CodeInvalidArgument, Code(err))
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semverhttp
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sixafter/semver"
+	"github.com/sixafter/semver/semvergate"
+)
+
+// ErrConflictingMediaTypeVersion indicates that a media type names different versions in its
+// subtype and in its version parameter.
+var ErrConflictingMediaTypeVersion = errors.New("conflicting media type versions")
+
+// MediaType is a media type that may carry an API version, either in a vendor subtype such as
+// "application/vnd.myapp.v2+json" or in a version parameter such as
+// "application/vnd.myapp+json; version=2.1".
+//
+// Subtype excludes the version and the structured syntax suffix, which is held in Suffix
+// without its "+". Params holds the parameters other than version. Versioned is false if the
+// media type does not name a version, in which case Version is the zero value.
+type MediaType struct {
+	Type      string
+	Subtype   string
+	Suffix    string
+	Params    map[string]string
+	Version   semver.Version
+	Versioned bool
+}
+
+// ParseMediaType parses a media type and the API version it names, if any.
+//
+// A version in the subtype is a dot-separated segment "v" followed by a number, optionally
+// followed by further numeric segments, so "vnd.myapp.v2.1" names 2.1.0. Versions are parsed
+// with semver.ParseLenient. If both the subtype and the version parameter name a version, they
+// must be equal.
+//
+// Example:
+//
+//	mt, err := semverhttp.ParseMediaType("application/vnd.myapp+json; version=2.1")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(mt.Subtype, mt.Suffix, mt.Version) // Output: vnd.myapp json 2.1.0
+func ParseMediaType(s string) (MediaType, error) {
+	full, params, err := mime.ParseMediaType(s)
+	if err != nil {
+		return MediaType{}, err
+	}
+
+	typ, subtype, _ := strings.Cut(full, "/")
+	mt := MediaType{Type: typ, Params: params}
+	if i := strings.LastIndexByte(subtype, '+'); i >= 0 {
+		subtype, mt.Suffix = subtype[:i], subtype[i+1:]
+	}
+
+	segments := strings.Split(subtype, ".")
+	for i, seg := range segments {
+		if !isVersionSegment(seg, segments[i+1:]) {
+			continue
+		}
+		if mt.Version, _, err = semver.ParseLenient(strings.Join(segments[i:], ".")); err != nil {
+			return MediaType{}, fmt.Errorf("media type %q: %w", s, err)
+		}
+		mt.Versioned = true
+		subtype = strings.Join(segments[:i], ".")
+		break
+	}
+	mt.Subtype = subtype
+
+	if raw, ok := params["version"]; ok {
+		delete(params, "version")
+		v, _, err := semver.ParseLenient(raw)
+		if err != nil {
+			return MediaType{}, fmt.Errorf("media type %q: %w", s, err)
+		}
+		if mt.Versioned && !mt.Version.Equal(v) {
+			return MediaType{}, fmt.Errorf("%w: %q", ErrConflictingMediaTypeVersion, s)
+		}
+		mt.Version, mt.Versioned = v, true
+	}
+
+	return mt, nil
+}
+
+// isVersionSegment reports whether seg is "v" followed by a number and every following
+// segment is a number, so that together they spell a version.
+func isVersionSegment(seg string, rest []string) bool {
+	if len(seg) < 2 || (seg[0] != 'v' && seg[0] != 'V') || !isNumber(seg[1:]) {
+		return false
+	}
+	for _, r := range rest {
+		if !isNumber(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumber reports whether s is a non-empty string of ASCII digits.
+func isNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// NegotiateMediaType chooses the media type to respond with from an Accept header, for APIs
+// that are versioned through their media types.
+//
+// The accepted media types are tried in decreasing order of their "q" quality, and in header
+// order among equal qualities. The first one that names a version the supported range contains
+// is returned. Media types that are excluded with q=0, that cannot be parsed, or that do not
+// name a version are skipped.
+//
+// Returns ErrMissingVersion if no accepted media type names a version, or ErrUnsupportedVersion
+// if none names a supported version.
+//
+// Example:
+//
+//	mt, err := semverhttp.NegotiateMediaType(
+//	    "application/vnd.myapp.v3+json, application/vnd.myapp.v2+json;q=0.9",
+//	    semver.MustParseRange(">=1.0.0 <3.0.0"),
+//	)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(mt.Version) // Output: 2.0.0
+func NegotiateMediaType(accept string, supported *semver.VersionRange) (MediaType, error) {
+	type candidate struct {
+		mt MediaType
+		q  float64
+	}
+
+	var candidates []candidate
+	for _, field := range strings.Split(accept, ",") {
+		mt, err := ParseMediaType(field)
+		if err != nil || !mt.Versioned {
+			continue
+		}
+
+		q := 1.0
+		if raw, ok := mt.Params["q"]; ok {
+			delete(mt.Params, "q")
+			if q, err = strconv.ParseFloat(raw, 64); err != nil {
+				continue
+			}
+		}
+		if q > 0 {
+			candidates = append(candidates, candidate{mt: mt, q: q})
+		}
+	}
+	if len(candidates) == 0 {
+		return MediaType{}, ErrMissingVersion
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	for _, c := range candidates {
+		if supported.Contains(c.mt.Version) {
+			return c.mt, nil
+		}
+	}
+	return MediaType{}, fmt.Errorf("%w: accepts none of %v", ErrUnsupportedVersion, semvergate.Branches(supported))
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semverhttp
+
+import (
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMediaType(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input     string
+		subtype   string
+		suffix    string
+		version   string
+		versioned bool
+	}{
+		{"application/vnd.myapp.v2+json", "vnd.myapp", "json", "2.0.0", true},
+		{"application/vnd.myapp.v2.1+json", "vnd.myapp", "json", "2.1.0", true},
+		{"application/vnd.myapp+json; version=2.1", "vnd.myapp", "json", "2.1.0", true},
+		{"application/vnd.myapp.v2+json; version=2.0.0", "vnd.myapp", "json", "2.0.0", true},
+		{"application/vnd.myapp.v2.beta+json", "vnd.myapp.v2.beta", "json", "0.0.0", false},
+		{"application/vnd.vendor.api", "vnd.vendor.api", "", "0.0.0", false},
+		{"application/json", "json", "", "0.0.0", false},
+	}
+
+	for _, tt := range tests {
+		mt, err := ParseMediaType(tt.input)
+		is.NoError(err, tt.input)
+		is.Equal("application", mt.Type, tt.input)
+		is.Equal(tt.subtype, mt.Subtype, tt.input)
+		is.Equal(tt.suffix, mt.Suffix, tt.input)
+		is.Equal(tt.version, mt.Version.String(), tt.input)
+		is.Equal(tt.versioned, mt.Versioned, tt.input)
+		is.NotContains(mt.Params, "version", tt.input)
+	}
+
+	mt, err := ParseMediaType("application/vnd.myapp+json; charset=utf-8; version=1")
+	is.NoError(err)
+	is.Equal(map[string]string{"charset": "utf-8"}, mt.Params)
+
+	_, err = ParseMediaType("application/vnd.myapp.v2+json; version=3")
+	is.ErrorIs(err, ErrConflictingMediaTypeVersion)
+
+	_, err = ParseMediaType("application/vnd.myapp+json; version=two")
+	is.Error(err)
+
+	_, err = ParseMediaType("not a media type")
+	is.Error(err)
+}
+
+func TestNegotiateMediaType(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	supported := semver.MustParseRange(">=1.0.0 <3.0.0")
+
+	mt, err := NegotiateMediaType("application/vnd.myapp.v3+json, application/vnd.myapp.v2+json;q=0.9", supported)
+	is.NoError(err)
+	is.Equal("2.0.0", mt.Version.String())
+	is.NotContains(mt.Params, "q")
+
+	mt, err = NegotiateMediaType("application/vnd.myapp.v1+json;q=0.5, application/vnd.myapp+json;version=2.1;q=0.8, */*", supported)
+	is.NoError(err)
+	is.Equal("2.1.0", mt.Version.String(), "Higher quality should win regardless of order")
+
+	_, err = NegotiateMediaType("application/vnd.myapp.v2+json;q=0", supported)
+	is.ErrorIs(err, ErrMissingVersion)
+
+	_, err = NegotiateMediaType("application/json, */*", supported)
+	is.ErrorIs(err, ErrMissingVersion)
+
+	_, err = NegotiateMediaType("application/vnd.myapp.v4+json", supported)
+	is.ErrorIs(err, ErrUnsupportedVersion)
+	is.Contains(err.Error(), ">=1.0.0 <3.0.0")
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package semverhttp provides net/http middleware for API version negotiation.
+//
+// The middleware reads the version a client asked for from a request header or a path
+// segment, checks it against the range of versions the server supports, and stores the
+// parsed version in the request context for handlers to read with FromContext. Requests
+// that name a malformed version are rejected with 400 Bad Request, and requests that name
+// an unsupported version with 406 Not Acceptable, both with a JSON body listing the
+// supported ranges.
+package semverhttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sixafter/semver"
+	"github.com/sixafter/semver/semvergate"
+)
+
+// DefaultHeader is the request header the middleware reads the version from by default.
+const DefaultHeader = "API-Version"
+
+var (
+	// ErrMissingVersion indicates that the request does not name a version and no default is set.
+	ErrMissingVersion = semvergate.ErrMissingVersion
+
+	// ErrUnsupportedVersion indicates that the requested version is outside the supported range.
+	ErrUnsupportedVersion = semvergate.ErrUnsupportedVersion
+)
+
+// Problem is the JSON body written when a request is rejected.
+//
+// Version is the version as the client sent it, and Supported lists the branches of the
+// supported range, so clients can choose a version to retry with.
+type Problem struct {
+	Status    int      `json:"status"`
+	Error     string   `json:"error"`
+	Version   string   `json:"version,omitempty"`
+	Supported []string `json:"supported"`
+}
+
+// options holds the settings applied by Option functions.
+type options struct {
+	header      string
+	segment     int
+	policy      semvergate.Policy
+	errorWriter func(http.ResponseWriter, *http.Request, Problem)
+}
+
+// Option configures Middleware.
+type Option func(*options)
+
+// WithHeader reads the version from the named request header instead of DefaultHeader.
+func WithHeader(name string) Option {
+	return func(o *options) {
+		o.header = name
+	}
+}
+
+// WithPathSegment reads the version from the n-th segment of the URL path, counting from
+// zero, instead of a header. For example, WithPathSegment(0) reads "v2" from "/v2/users".
+func WithPathSegment(n int) Option {
+	return func(o *options) {
+		o.header = ""
+		o.segment = n
+	}
+}
+
+// WithDefault uses v for requests that do not name a version, instead of rejecting them.
+// The default is still checked against the supported range.
+func WithDefault(v semver.Version) Option {
+	return func(o *options) {
+		o.policy.Default = &v
+	}
+}
+
+// WithRoutes applies the given ranges to requests whose URL path is a key of routes, instead
+// of the supported range passed to Middleware.
+func WithRoutes(routes map[string]*semver.VersionRange) Option {
+	return func(o *options) {
+		o.policy.Routes = routes
+	}
+}
+
+// WithErrorWriter replaces the function that writes the response for a rejected request.
+func WithErrorWriter(fn func(http.ResponseWriter, *http.Request, Problem)) Option {
+	return func(o *options) {
+		o.errorWriter = fn
+	}
+}
+
+// Middleware returns middleware that negotiates the API version of each request against the
+// supported range.
+//
+// The version is evaluated with semvergate.Policy.Evaluate, so "2", "v2", and "2.0.0" all
+// name 2.0.0. A request with a missing or malformed version is rejected with 400 Bad Request,
+// and one whose version the range does not contain with 406 Not Acceptable. Otherwise the
+// version is stored in the request context and the request is passed to the next handler.
+//
+// Example:
+//
+//	supported := semver.MustParseRange(">=1.0.0 <3.0.0")
+//	mux := http.NewServeMux()
+//	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+//	    v, _ := semverhttp.FromContext(r.Context())
+//	    fmt.Fprintln(w, "serving API", v)
+//	})
+//	log.Fatal(http.ListenAndServe(":8080", semverhttp.Middleware(supported)(mux)))
+func Middleware(supported *semver.VersionRange, opts ...Option) func(http.Handler) http.Handler {
+	o := &options{header: DefaultHeader, errorWriter: WriteProblem}
+	for _, opt := range opts {
+		opt(o)
+	}
+	o.policy.Supported = supported
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			v, err := o.policy.Evaluate(r.URL.Path, o.requested(r))
+			if err != nil {
+				o.errorWriter(w, r, newProblem(err))
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), v)))
+		})
+	}
+}
+
+// newProblem returns the problem describing a rejection.
+func newProblem(err error) Problem {
+	var rej *semvergate.Rejection
+	if !errors.As(err, &rej) {
+		return Problem{Status: http.StatusInternalServerError, Error: err.Error()}
+	}
+
+	status := http.StatusBadRequest
+	if rej.Reason == semvergate.ReasonUnsupported {
+		status = http.StatusNotAcceptable
+	}
+	return Problem{Status: status, Error: rej.Error(), Version: rej.Version, Supported: rej.Supported}
+}
+
+// requested returns the version named by the request, or "" if it names none.
+func (o *options) requested(r *http.Request) string {
+	if o.header != "" {
+		return strings.TrimSpace(r.Header.Get(o.header))
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if o.segment < 0 || o.segment >= len(segments) {
+		return ""
+	}
+	return segments[o.segment]
+}
+
+// WriteProblem writes the problem as a JSON response with the problem's status code.
+// It is the default error writer of Middleware.
+func WriteProblem(w http.ResponseWriter, _ *http.Request, p Problem) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p)
+}
+
+// NewContext returns a copy of ctx that carries the version.
+func NewContext(ctx context.Context, v semver.Version) context.Context {
+	return semvergate.NewContext(ctx, v)
+}
+
+// FromContext returns the version stored in ctx by Middleware or NewContext.
+//
+// Example:
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//	    v, ok := semverhttp.FromContext(r.Context())
+//	    if ok && v.Major >= 2 {
+//	        // Serve the v2 representation.
+//	    }
+//	}
+func FromContext(ctx context.Context) (semver.Version, bool) {
+	return semvergate.FromContext(ctx)
+}
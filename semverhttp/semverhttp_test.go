@@ -0,0 +1,127 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semverhttp
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+// echoVersion writes the version stored in the request context.
+var echoVersion = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	v, ok := FromContext(r.Context())
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = w.Write([]byte(v.String()))
+})
+
+func serve(h http.Handler, path, version string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if version != "" {
+		req.Header.Set(DefaultHeader, version)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestMiddlewareHeader(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	h := Middleware(semver.MustParseRange(">=1.0.0 <3.0.0"))(echoVersion)
+
+	rec := serve(h, "/users", "v2")
+	is.Equal(http.StatusOK, rec.Code)
+	is.Equal("2.0.0", rec.Body.String())
+
+	rec = serve(h, "/users", "3.0.0")
+	is.Equal(http.StatusNotAcceptable, rec.Code)
+	is.Equal("application/json", rec.Header().Get("Content-Type"))
+
+	var p Problem
+	is.NoError(json.Unmarshal(rec.Body.Bytes(), &p))
+	is.Equal(Problem{
+		Status:    http.StatusNotAcceptable,
+		Error:     "unsupported API version: 3.0.0",
+		Version:   "3.0.0",
+		Supported: []string{">=1.0.0 <3.0.0"},
+	}, p)
+}
+
+func TestMiddlewareBadRequest(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	h := Middleware(semver.MustParseRange("^1.0.0 || ^2.0.0"))(echoVersion)
+
+	rec := serve(h, "/", "")
+	is.Equal(http.StatusBadRequest, rec.Code)
+	var p Problem
+	is.NoError(json.Unmarshal(rec.Body.Bytes(), &p))
+	is.Equal(ErrMissingVersion.Error(), p.Error)
+	is.Len(p.Supported, 2)
+
+	rec = serve(h, "/", "one.two")
+	is.Equal(http.StatusBadRequest, rec.Code)
+	p = Problem{}
+	is.NoError(json.Unmarshal(rec.Body.Bytes(), &p))
+	is.Equal("one.two", p.Version)
+	is.NotEmpty(p.Error)
+}
+
+func TestMiddlewareOptions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	supported := semver.MustParseRange(">=1.0.0 <3.0.0")
+
+	h := Middleware(supported, WithPathSegment(0))(echoVersion)
+	is.Equal("1.0.0", serve(h, "/v1/users", "").Body.String())
+	is.Equal(http.StatusNotAcceptable, serve(h, "/v3/users", "").Code)
+	is.Equal(http.StatusBadRequest, serve(h, "/", "").Code)
+
+	h = Middleware(supported, WithHeader("X-Version"), WithDefault(semver.MustParse("2.1.0")))(echoVersion)
+	is.Equal("2.1.0", serve(h, "/", "9.9.9").Body.String(), "The default header should be ignored")
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Version", "1.5")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	is.Equal("1.5.0", rec.Body.String())
+
+	h = Middleware(supported, WithRoutes(map[string]*semver.VersionRange{"/reports": semver.MustParseRange("^2.1.0")}))(echoVersion)
+	is.Equal(http.StatusOK, serve(h, "/users", "2.0.0").Code)
+	is.Equal(http.StatusNotAcceptable, serve(h, "/reports", "2.0.0").Code)
+
+	var got Problem
+	h = Middleware(supported, WithErrorWriter(func(w http.ResponseWriter, _ *http.Request, p Problem) {
+		got = p
+		w.WriteHeader(http.StatusTeapot)
+	}))(echoVersion)
+	is.Equal(http.StatusTeapot, serve(h, "/", "4.0.0").Code)
+	is.Equal(http.StatusNotAcceptable, got.Status)
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, ok := FromContext(context.Background())
+	is.False(ok)
+
+	v, ok := FromContext(NewContext(context.Background(), semver.MustParse("1.2.3")))
+	is.True(ok)
+	is.Equal("1.2.3", v.String())
+}
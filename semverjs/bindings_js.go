@@ -0,0 +1,76 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+//go:build js && wasm
+
+package semverjs
+
+import (
+	"syscall/js"
+)
+
+// Register sets a global JavaScript object with the given name whose parse, compare, valid,
+// and satisfies functions call into this module. It returns the object.
+//
+// Failures are reported by returning a JavaScript Error rather than throwing, because a Go
+// panic inside a callback would terminate the WebAssembly instance.
+//
+// Example:
+//
+//	semverjs.Register("semver")
+func Register(name string) js.Value {
+	obj := Object()
+	js.Global().Set(name, obj)
+	return obj
+}
+
+// Object returns a new JavaScript object holding the bindings, for callers that prefer to
+// export it through their own module rather than a global.
+func Object() js.Value {
+	obj := js.Global().Get("Object").New()
+	obj.Set("parse", js.FuncOf(func(_ js.Value, args []js.Value) any {
+		if len(args) != 1 {
+			return jsError("parse expects 1 argument")
+		}
+		v, err := parse(args[0].String())
+		if err != nil {
+			return jsError(err.Error())
+		}
+		return js.ValueOf(v)
+	}))
+	obj.Set("valid", js.FuncOf(func(_ js.Value, args []js.Value) any {
+		if len(args) != 1 {
+			return jsError("valid expects 1 argument")
+		}
+		_, err := parse(args[0].String())
+		return err == nil
+	}))
+	obj.Set("compare", js.FuncOf(func(_ js.Value, args []js.Value) any {
+		if len(args) != 2 {
+			return jsError("compare expects 2 arguments")
+		}
+		c, err := compare(args[0].String(), args[1].String())
+		if err != nil {
+			return jsError(err.Error())
+		}
+		return c
+	}))
+	obj.Set("satisfies", js.FuncOf(func(_ js.Value, args []js.Value) any {
+		if len(args) != 2 {
+			return jsError("satisfies expects 2 arguments")
+		}
+		ok, err := satisfies(args[0].String(), args[1].String())
+		if err != nil {
+			return jsError(err.Error())
+		}
+		return ok
+	}))
+	return obj
+}
+
+// jsError returns a new JavaScript Error with the given message.
+func jsError(msg string) js.Value {
+	return js.Global().Get("Error").New(msg)
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package semverjs exposes Parse, Compare, and Satisfies to JavaScript when built for
+// GOOS=js GOARCH=wasm, so that frontends embedding a Go WebAssembly module share exactly the
+// same version semantics as backend services.
+//
+// The bindings in this package are only available under the js and wasm build constraints.
+// The JavaScript-facing values are built from plain Go maps and slices, so their shape is the
+// same on every platform and can be tested without a JavaScript runtime.
+//
+// Example:
+//
+//	//go:build js && wasm
+//
+//	func main() {
+//	    semverjs.Register("semver")
+//	    select {}
+//	}
+//
+// From JavaScript:
+//
+//	semver.satisfies("1.4.0", ">=1.0.0 <2.0.0") // true
+//	semver.compare("1.0.0", "1.0.0-rc.1")       // 1
+//	semver.parse("1.2.3-rc.1").prerelease        // ["rc", "1"]
+package semverjs
+
+import (
+	"github.com/sixafter/semver"
+)
+
+// parse parses a version and returns it as a JavaScript-compatible object with the fields
+// version, major, minor, patch, prerelease, and build. Numbers are float64, as in JavaScript.
+func parse(s string) (map[string]any, error) {
+	v, err := semver.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	prerelease := make([]any, len(v.PreRelease))
+	for i, pr := range v.PreRelease {
+		prerelease[i] = pr.String()
+	}
+	build := make([]any, len(v.BuildMetadata))
+	for i, bm := range v.BuildMetadata {
+		build[i] = bm
+	}
+
+	return map[string]any{
+		"version":    v.String(),
+		"major":      float64(v.Major),
+		"minor":      float64(v.Minor),
+		"patch":      float64(v.Patch),
+		"prerelease": prerelease,
+		"build":      build,
+	}, nil
+}
+
+// compare parses two versions and compares them with semver.Compare.
+func compare(a, b string) (int, error) {
+	va, err := semver.Parse(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := semver.Parse(b)
+	if err != nil {
+		return 0, err
+	}
+	return semver.Compare(va, vb), nil
+}
+
+// satisfies parses a version and a range and reports whether the version lies within it.
+func satisfies(version, r string) (bool, error) {
+	v, err := semver.Parse(version)
+	if err != nil {
+		return false, err
+	}
+	vr, err := semver.ParseRange(r)
+	if err != nil {
+		return false, err
+	}
+	return vr.Contains(v), nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semverjs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	obj, err := parse("1.2.3-rc.1+build")
+	is.NoError(err)
+	is.Equal(map[string]any{
+		"version":    "1.2.3-rc.1+build",
+		"major":      float64(1),
+		"minor":      float64(2),
+		"patch":      float64(3),
+		"prerelease": []any{"rc", "1"},
+		"build":      []any{"build"},
+	}, obj)
+
+	_, err = parse("v1")
+	is.Error(err)
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	c, err := compare("1.0.0", "1.0.0-rc.1")
+	is.NoError(err)
+	is.Equal(1, c)
+
+	c, err = compare("1.0.0+a", "1.0.0+b")
+	is.NoError(err)
+	is.Equal(0, c)
+
+	_, err = compare("1.0.0", "bogus")
+	is.Error(err)
+}
+
+func TestSatisfies(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	ok, err := satisfies("1.4.0", ">=1.0.0 <2.0.0")
+	is.NoError(err)
+	is.True(ok)
+
+	ok, err = satisfies("2.4.0", ">=1.0.0 <2.0.0")
+	is.NoError(err)
+	is.False(ok)
+
+	_, err = satisfies("1.4.0", ">=bogus")
+	is.Error(err)
+}
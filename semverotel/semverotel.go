@@ -0,0 +1,228 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package semverotel provides helpers for emitting and reading semantic versions in
+// OpenTelemetry resource attributes, such as service.version.
+//
+// The package does not depend on the OpenTelemetry SDK. Attributes are plain key/value
+// string pairs that map directly onto attribute.String(key, value), and ParseResource reads
+// the OTEL_RESOURCE_ATTRIBUTES format, so instrumentation libraries can share one consistent
+// implementation of version handling during resource detection.
+package semverotel
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+// Semantic convention attribute keys that carry versions.
+const (
+	ServiceVersion         = "service.version"
+	TelemetrySDKVersion    = "telemetry.sdk.version"
+	TelemetryDistroVersion = "telemetry.distro.version"
+	ScopeVersion           = "otel.scope.version"
+)
+
+// EnvResourceAttributes is the environment variable holding resource attributes.
+const EnvResourceAttributes = "OTEL_RESOURCE_ATTRIBUTES"
+
+var (
+	// ErrAttributeNotFound indicates that the requested attribute is not present.
+	ErrAttributeNotFound = errors.New("attribute not found")
+
+	// ErrMalformedResource indicates that a resource attribute string could not be parsed.
+	ErrMalformedResource = errors.New("malformed resource attribute string")
+)
+
+// Attribute is a string-valued resource attribute.
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// Validator checks a parsed version before it is accepted. Returning an error rejects it.
+type Validator func(key string, v semver.Version) error
+
+// Option configures how versions are read from attributes.
+type Option func(*options)
+
+type options struct {
+	validators  []Validator
+	allowPrefix bool
+}
+
+// WithValidator adds a validation hook run on every version read from an attribute.
+//
+// Example:
+//
+//	noPrerelease := func(key string, v semver.Version) error {
+//	    if len(v.PreRelease) > 0 {
+//	        return fmt.Errorf("%s must be a release version", key)
+//	    }
+//	    return nil
+//	}
+//	v, err := semverotel.ServiceVersionFrom(attrs, semverotel.WithValidator(noPrerelease))
+func WithValidator(fn Validator) Option {
+	return func(o *options) {
+		o.validators = append(o.validators, fn)
+	}
+}
+
+// WithPrefixTolerance accepts attribute values with a leading "v", such as "v1.2.3".
+func WithPrefixTolerance(value bool) Option {
+	return func(o *options) {
+		o.allowPrefix = value
+	}
+}
+
+// VersionAttribute returns an attribute with the given key and the version as its value.
+//
+// Example:
+//
+//	a := semverotel.VersionAttribute(semverotel.TelemetrySDKVersion, semver.MustParse("1.2.3"))
+//	fmt.Println(a.Key, a.Value) // Output: telemetry.sdk.version 1.2.3
+func VersionAttribute(key string, v semver.Version) Attribute {
+	return Attribute{Key: key, Value: v.String()}
+}
+
+// ServiceVersionAttribute returns the service.version attribute for the version.
+//
+// Example:
+//
+//	a := semverotel.ServiceVersionAttribute(semver.MustParse("1.2.3"))
+//	fmt.Println(a.Key, a.Value) // Output: service.version 1.2.3
+func ServiceVersionAttribute(v semver.Version) Attribute {
+	return VersionAttribute(ServiceVersion, v)
+}
+
+// VersionFrom finds the attribute with the given key and parses its value as a version.
+// If the key occurs more than once, the last occurrence wins, as with resource merging.
+//
+// Returns ErrAttributeNotFound if the key is absent, or the parse or validation error.
+func VersionFrom(attrs []Attribute, key string, opts ...Option) (semver.Version, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	value, found := "", false
+	for _, a := range attrs {
+		if a.Key == key {
+			value, found = a.Value, true
+		}
+	}
+	if !found {
+		return semver.Version{}, fmt.Errorf("%w: %s", ErrAttributeNotFound, key)
+	}
+
+	if o.allowPrefix {
+		value = strings.TrimPrefix(strings.TrimPrefix(value, "v"), "V")
+	}
+
+	v, err := semver.Parse(value)
+	if err != nil {
+		return semver.Version{}, fmt.Errorf("%s: %w", key, err)
+	}
+
+	for _, validate := range o.validators {
+		if err := validate(key, v); err != nil {
+			return semver.Version{}, err
+		}
+	}
+
+	return v, nil
+}
+
+// ServiceVersionFrom parses the service.version attribute.
+//
+// Example:
+//
+//	attrs := []semverotel.Attribute{{Key: "service.version", Value: "2.1.0"}}
+//	v, err := semverotel.ServiceVersionFrom(attrs)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 2.1.0
+func ServiceVersionFrom(attrs []Attribute, opts ...Option) (semver.Version, error) {
+	return VersionFrom(attrs, ServiceVersion, opts...)
+}
+
+// ParseResource parses a resource attribute string in the OTEL_RESOURCE_ATTRIBUTES format,
+// a comma-separated list of percent-encoded key=value pairs.
+//
+// Example:
+//
+//	attrs, err := semverotel.ParseResource("service.name=api,service.version=1.4.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	v, _ := semverotel.ServiceVersionFrom(attrs)
+//	fmt.Println(v) // Output: 1.4.0
+func ParseResource(s string) ([]Attribute, error) {
+	var attrs []Attribute
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%w: %q", ErrMalformedResource, pair)
+		}
+		decoded, err := url.PathUnescape(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrMalformedResource, pair)
+		}
+		attrs = append(attrs, Attribute{Key: key, Value: decoded})
+	}
+	return attrs, nil
+}
+
+// FormatResource formats attributes in the OTEL_RESOURCE_ATTRIBUTES format. Separators, "%",
+// whitespace, and non-ASCII bytes in values are percent-encoded.
+//
+// Example:
+//
+//	s := semverotel.FormatResource(semverotel.ServiceVersionAttribute(semver.MustParse("1.0.0+build.7")))
+//	fmt.Println(s) // Output: service.version=1.0.0+build.7
+func FormatResource(attrs ...Attribute) string {
+	parts := make([]string, 0, len(attrs))
+	for _, a := range attrs {
+		parts = append(parts, a.Key+"="+escapeResourceValue(a.Value))
+	}
+	return strings.Join(parts, ",")
+}
+
+// escapeResourceValue percent-encodes the bytes of value that cannot appear literally.
+func escapeResourceValue(value string) string {
+	const hex = "0123456789ABCDEF"
+
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		if ch <= ' ' || ch >= 0x7f || ch == ',' || ch == '=' || ch == '%' {
+			sb.WriteByte('%')
+			sb.WriteByte(hex[ch>>4])
+			sb.WriteByte(hex[ch&0x0f])
+			continue
+		}
+		sb.WriteByte(ch)
+	}
+	return sb.String()
+}
+
+// ServiceVersionFromEnv parses service.version from the OTEL_RESOURCE_ATTRIBUTES environment variable.
+func ServiceVersionFromEnv(opts ...Option) (semver.Version, error) {
+	attrs, err := ParseResource(os.Getenv(EnvResourceAttributes))
+	if err != nil {
+		return semver.Version{}, err
+	}
+	return ServiceVersionFrom(attrs, opts...)
+}
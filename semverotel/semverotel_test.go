@@ -0,0 +1,92 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semverotel
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionAttribute(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := semver.MustParse("1.2.3-rc.1")
+	is.Equal(Attribute{Key: ServiceVersion, Value: "1.2.3-rc.1"}, ServiceVersionAttribute(v))
+	is.Equal(Attribute{Key: TelemetrySDKVersion, Value: "1.2.3-rc.1"}, VersionAttribute(TelemetrySDKVersion, v))
+}
+
+func TestVersionFrom(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	attrs := []Attribute{
+		{Key: "service.name", Value: "api"},
+		{Key: ServiceVersion, Value: "1.0.0"},
+		{Key: ServiceVersion, Value: "v2.1.0-beta"},
+	}
+
+	_, err := ServiceVersionFrom(attrs)
+	is.Error(err, "Prefixed values should be rejected by default")
+
+	v, err := ServiceVersionFrom(attrs, WithPrefixTolerance(true))
+	is.NoError(err)
+	is.Equal("2.1.0-beta", v.String())
+
+	errPrerelease := errors.New("pre-release not allowed")
+	_, err = ServiceVersionFrom(attrs, WithPrefixTolerance(true), WithValidator(func(key string, v semver.Version) error {
+		if len(v.PreRelease) > 0 {
+			return errPrerelease
+		}
+		return nil
+	}))
+	is.ErrorIs(err, errPrerelease)
+
+	_, err = VersionFrom(attrs, TelemetrySDKVersion)
+	is.ErrorIs(err, ErrAttributeNotFound)
+}
+
+func TestParseFormatResource(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	attrs, err := ParseResource("service.name=my%20api, service.version=1.4.0+build.7,,")
+	is.NoError(err)
+	is.Equal([]Attribute{
+		{Key: "service.name", Value: "my api"},
+		{Key: ServiceVersion, Value: "1.4.0+build.7"},
+	}, attrs)
+
+	s := FormatResource(attrs...)
+	is.Equal("service.name=my%20api,service.version=1.4.0+build.7", s)
+
+	roundTrip, err := ParseResource(s)
+	is.NoError(err)
+	is.Equal(attrs, roundTrip)
+
+	is.Equal("k=a%2Cb%3Dc%25", FormatResource(Attribute{Key: "k", Value: "a,b=c%"}))
+
+	for _, bad := range []string{"novalue", "=1.0.0", "k=%zz"} {
+		_, err := ParseResource(bad)
+		is.ErrorIs(err, ErrMalformedResource, "Expected error for %q", bad)
+	}
+}
+
+func TestServiceVersionFromEnv(t *testing.T) {
+	is := assert.New(t)
+
+	t.Setenv(EnvResourceAttributes, "service.name=api,service.version=3.2.1")
+	v, err := ServiceVersionFromEnv()
+	is.NoError(err)
+	is.Equal("3.2.1", v.String())
+
+	t.Setenv(EnvResourceAttributes, "")
+	_, err = ServiceVersionFromEnv()
+	is.ErrorIs(err, ErrAttributeNotFound)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package semverpb provides a structured representation of semver.Version, with converters and a
+// compact, dependency-free binary encoding, along with the version.proto schema it follows.
+//
+// The package does not depend on google.golang.org/protobuf and does not provide protobuf
+// bindings: Version is a plain Go struct, not a proto.Message, and cannot be used as a field of
+// generated messages or with gRPC codecs. Services that carry versions in protobuf messages
+// should generate their own bindings from version.proto with protoc-gen-go and copy the fields,
+// or carry the string form of the version.
+//
+// Marshal and Unmarshal use the field numbers of version.proto, but the encoding is only
+// guaranteed to round-trip through this package.
+package semverpb
+
+import (
+	"strconv"
+
+	"github.com/sixafter/semver"
+)
+
+// Version holds the fields of a semver.Version, as laid out by the Version message in
+// version.proto.
+type Version struct {
+	Major         uint64
+	Minor         uint64
+	Patch         uint64
+	PreRelease    []PrereleaseIdentifier
+	BuildMetadata []string
+	Revision      uint64
+	Epoch         uint64
+}
+
+// PrereleaseIdentifier holds a single pre-release identifier, as laid out by the
+// PrereleaseIdentifier message in version.proto.
+// Exactly one of Text and Number is set; IsNumber reports which.
+type PrereleaseIdentifier struct {
+	Text     string
+	Number   uint64
+	IsNumber bool
+}
+
+// ToProto converts a semver.Version into its structured representation.
+//
+// Example:
+//
+//	pb := semverpb.ToProto(semver.MustParse("1.2.3-rc.1"))
+//	fmt.Println(pb.Major, pb.PreRelease[1].Number) // Output: 1 1
+func ToProto(v semver.Version) *Version {
+	pb := &Version{
+		Major:    v.Major,
+		Minor:    v.Minor,
+		Patch:    v.Patch,
+		Revision: v.Revision,
+		Epoch:    v.Epoch,
+	}
+
+	if len(v.PreRelease) > 0 {
+		pb.PreRelease = make([]PrereleaseIdentifier, len(v.PreRelease))
+		for i, pr := range v.PreRelease {
+			if n, ok := pr.Numeric(); ok {
+				pb.PreRelease[i] = PrereleaseIdentifier{Number: n, IsNumber: true}
+			} else {
+				pb.PreRelease[i] = PrereleaseIdentifier{Text: pr.String()}
+			}
+		}
+	}
+
+	if len(v.BuildMetadata) > 0 {
+		pb.BuildMetadata = append([]string(nil), v.BuildMetadata...)
+	}
+
+	return pb
+}
+
+// FromProto converts a structured Version into a semver.Version.
+//
+// Returns an error if any identifier is not valid under the Semantic Versioning specification.
+//
+// Example:
+//
+//	v, err := semverpb.FromProto(&semverpb.Version{Major: 1, Minor: 2, Patch: 3})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.3
+func FromProto(pb *Version) (semver.Version, error) {
+	if pb == nil {
+		return semver.Version{}, semver.ErrEmptyVersionString
+	}
+
+	v := semver.Version{
+		Major:    pb.Major,
+		Minor:    pb.Minor,
+		Patch:    pb.Patch,
+		Revision: pb.Revision,
+		Epoch:    pb.Epoch,
+	}
+
+	for _, id := range pb.PreRelease {
+		text := id.Text
+		if id.IsNumber {
+			text = strconv.FormatUint(id.Number, 10)
+		} else if isDigits(text) {
+			// A numeric string would silently become a numeric identifier.
+			return semver.Version{}, semver.ErrInvalidPrereleaseIdentifier
+		}
+		pr, err := semver.NewPrereleaseVersion(text)
+		if err != nil {
+			return semver.Version{}, err
+		}
+		v.PreRelease = append(v.PreRelease, pr)
+	}
+
+	if len(pb.BuildMetadata) > 0 {
+		v.BuildMetadata = append([]string(nil), pb.BuildMetadata...)
+	}
+
+	// Round-trip through the parser to validate every identifier. The epoch and revision are
+	// plain numbers that a Semantic Versioning parser would reject, so they are left out.
+	check := v
+	check.Epoch, check.Revision = 0, 0
+	if _, err := semver.Parse(check.String()); err != nil {
+		return semver.Version{}, err
+	}
+
+	return v, nil
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
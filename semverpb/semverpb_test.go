@@ -0,0 +1,125 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semverpb
+
+import (
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToProtoFromProto(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, s := range []string{"1.2.3", "0.0.0", "1.2.3-rc.1", "1.2.3-18446744073709551615", "1.0.0-alpha.0.beta+build.001", "2.0.0+exp.sha.5114f85"} {
+		v := semver.MustParse(s)
+		pb := ToProto(v)
+		got, err := FromProto(pb)
+		is.NoError(err, "Version %s should convert back", s)
+		is.Equal(v, got, "Version %s should round-trip", s)
+	}
+
+	pb := ToProto(semver.MustParse("1.2.3-rc.1"))
+	is.Equal([]PrereleaseIdentifier{{Text: "rc"}, {Number: 1, IsNumber: true}}, pb.PreRelease)
+}
+
+func TestFromProtoInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []*Version{
+		nil,
+		{Major: 1, PreRelease: []PrereleaseIdentifier{{Text: ""}}},
+		{Major: 1, PreRelease: []PrereleaseIdentifier{{Text: "01"}}},
+		{Major: 1, PreRelease: []PrereleaseIdentifier{{Text: "bad_char"}}},
+		{Major: 1, BuildMetadata: []string{""}},
+	}
+
+	for _, pb := range tests {
+		_, err := FromProto(pb)
+		is.Error(err, "Expected error for %+v", pb)
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	data, err := ToProto(semver.MustParse("1.2.3")).Marshal()
+	is.NoError(err)
+	is.Equal([]byte{0x08, 0x01, 0x10, 0x02, 0x18, 0x03}, data)
+
+	data, err = ToProto(semver.MustParse("1.0.0-rc.0+b")).Marshal()
+	is.NoError(err)
+	is.Equal([]byte{
+		0x08, 0x01,
+		0x22, 0x04, 0x0a, 0x02, 'r', 'c',
+		0x22, 0x02, 0x10, 0x00,
+		0x2a, 0x01, 'b',
+	}, data)
+
+	for _, s := range []string{"0.0.0", "1.2.3", "10.20.30-alpha.1.beta.0+build.1.sha", "18446744073709551615.0.0"} {
+		v := semver.MustParse(s)
+		data, err := ToProto(v).Marshal()
+		is.NoError(err)
+
+		var pb Version
+		is.NoError(pb.Unmarshal(data), "Version %s should decode", s)
+		got, err := FromProto(&pb)
+		is.NoError(err)
+		is.Equal(v, got, "Version %s should round-trip through Marshal and Unmarshal", s)
+	}
+
+	data, err = ToProto(semver.Version{Major: 10, Revision: 1165}).Marshal()
+	is.NoError(err)
+	is.Equal([]byte{0x08, 0x0a, 0x30, 0x8d, 0x09}, data)
+
+	var pb Version
+	is.NoError(pb.Unmarshal(data))
+	is.Equal(uint64(1165), pb.Revision)
+	got, err := FromProto(&pb)
+	is.NoError(err)
+	is.Equal("10.0.0.1165", got.String())
+
+	data, err = ToProto(semver.Version{Epoch: 2, Major: 1}).Marshal()
+	is.NoError(err)
+	is.Equal([]byte{0x08, 0x01, 0x38, 0x02}, data)
+	is.NoError(pb.Unmarshal(data))
+	got, err = FromProto(&pb)
+	is.NoError(err)
+	is.Equal(uint64(2), got.Epoch)
+}
+
+func TestUnmarshalUnknownAndMalformed(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Unknown varint, fixed64, fixed32, and bytes fields are skipped.
+	data := []byte{
+		0x08, 0x01,
+		0x78, 0x05,
+		0x39, 0, 0, 0, 0, 0, 0, 0, 0,
+		0x45, 0, 0, 0, 0,
+		0x52, 0x01, 'x',
+		0x18, 0x07,
+	}
+	var pb Version
+	is.NoError(pb.Unmarshal(data))
+	is.Equal(Version{Major: 1, Patch: 7}, pb)
+
+	for _, bad := range [][]byte{
+		{0x08},
+		{0x22, 0x05, 0x0a},
+		{0x00, 0x01},
+		{0x0b},
+		{0x39, 0x00},
+		{0x80},
+	} {
+		is.ErrorIs(pb.Unmarshal(bad), ErrMalformedMessage, "Expected error for % x", bad)
+	}
+}
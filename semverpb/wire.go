@@ -0,0 +1,187 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semverpb
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Wire types of the encoding, numbered as in protobuf.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var (
+	// ErrMalformedMessage indicates that the encoded data could not be decoded.
+	ErrMalformedMessage = errors.New("malformed semverpb message")
+)
+
+// Marshal encodes the Version in the package's binary encoding.
+//
+// Example:
+//
+//	data, err := semverpb.ToProto(semver.MustParse("1.2.3")).Marshal()
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("% x\n", data) // Output: 08 01 10 02 18 03
+func (pb *Version) Marshal() ([]byte, error) {
+	var b []byte
+	b = appendVarintField(b, 1, pb.Major)
+	b = appendVarintField(b, 2, pb.Minor)
+	b = appendVarintField(b, 3, pb.Patch)
+
+	for _, id := range pb.PreRelease {
+		var msg []byte
+		if id.IsNumber {
+			msg = appendTag(msg, 2, wireVarint)
+			msg = binary.AppendUvarint(msg, id.Number)
+		} else {
+			msg = appendBytesField(msg, 1, []byte(id.Text))
+		}
+		b = appendBytesField(b, 4, msg)
+	}
+
+	for _, bm := range pb.BuildMetadata {
+		b = appendBytesField(b, 5, []byte(bm))
+	}
+
+	b = appendVarintField(b, 6, pb.Revision)
+	b = appendVarintField(b, 7, pb.Epoch)
+
+	return b, nil
+}
+
+// Unmarshal decodes data written by Marshal into the Version, replacing its contents.
+// Unknown fields are skipped.
+//
+// Example:
+//
+//	var pb semverpb.Version
+//	if err := pb.Unmarshal([]byte{0x08, 0x01, 0x10, 0x02, 0x18, 0x03}); err != nil {
+//	    log.Fatal(err)
+//	}
+//	v, _ := semverpb.FromProto(&pb)
+//	fmt.Println(v) // Output: 1.2.3
+func (pb *Version) Unmarshal(data []byte) error {
+	*pb = Version{}
+
+	return walkFields(data, func(field uint64, wireType int, varint uint64, bytes []byte) error {
+		switch {
+		case field == 1 && wireType == wireVarint:
+			pb.Major = varint
+		case field == 2 && wireType == wireVarint:
+			pb.Minor = varint
+		case field == 3 && wireType == wireVarint:
+			pb.Patch = varint
+		case field == 4 && wireType == wireBytes:
+			var id PrereleaseIdentifier
+			if err := id.unmarshal(bytes); err != nil {
+				return err
+			}
+			pb.PreRelease = append(pb.PreRelease, id)
+		case field == 5 && wireType == wireBytes:
+			pb.BuildMetadata = append(pb.BuildMetadata, string(bytes))
+		case field == 6 && wireType == wireVarint:
+			pb.Revision = varint
+		case field == 7 && wireType == wireVarint:
+			pb.Epoch = varint
+		}
+		return nil
+	})
+}
+
+// unmarshal decodes a PrereleaseIdentifier message. As with any oneof, the last field wins.
+func (id *PrereleaseIdentifier) unmarshal(data []byte) error {
+	return walkFields(data, func(field uint64, wireType int, varint uint64, bytes []byte) error {
+		switch {
+		case field == 1 && wireType == wireBytes:
+			*id = PrereleaseIdentifier{Text: string(bytes)}
+		case field == 2 && wireType == wireVarint:
+			*id = PrereleaseIdentifier{Number: varint, IsNumber: true}
+		}
+		return nil
+	})
+}
+
+// walkFields calls fn for every field in data. Varint fields are passed in varint and
+// length-delimited fields in bytes; fixed-width fields are skipped.
+func walkFields(data []byte, fn func(field uint64, wireType int, varint uint64, bytes []byte) error) error {
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return ErrMalformedMessage
+		}
+		data = data[n:]
+
+		field, wireType := tag>>3, int(tag&7)
+		if field == 0 {
+			return ErrMalformedMessage
+		}
+
+		var varint uint64
+		var bytes []byte
+		switch wireType {
+		case wireVarint:
+			varint, n = binary.Uvarint(data)
+			if n <= 0 {
+				return ErrMalformedMessage
+			}
+			data = data[n:]
+		case wireBytes:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || length > uint64(len(data)-n) {
+				return ErrMalformedMessage
+			}
+			bytes = data[n : n+int(length)]
+			data = data[n+int(length):]
+		case wireFixed64:
+			if len(data) < 8 {
+				return ErrMalformedMessage
+			}
+			data = data[8:]
+			continue
+		case wireFixed32:
+			if len(data) < 4 {
+				return ErrMalformedMessage
+			}
+			data = data[4:]
+			continue
+		default:
+			return ErrMalformedMessage
+		}
+
+		if err := fn(field, wireType, varint, bytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendTag appends a field tag.
+func appendTag(b []byte, field uint64, wireType int) []byte {
+	return binary.AppendUvarint(b, field<<3|uint64(wireType))
+}
+
+// appendVarintField appends a varint field, omitting zero values as proto3 does.
+func appendVarintField(b []byte, field uint64, value uint64) []byte {
+	if value == 0 {
+		return b
+	}
+	b = appendTag(b, field, wireVarint)
+	return binary.AppendUvarint(b, value)
+}
+
+// appendBytesField appends a length-delimited field.
+func appendBytesField(b []byte, field uint64, value []byte) []byte {
+	b = appendTag(b, field, wireBytes)
+	b = binary.AppendUvarint(b, uint64(len(value)))
+	return append(b, value...)
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package semverregistry records the versions of the components linked into a binary and the
+// version ranges they require of each other, and serves them over HTTP and expvar.
+//
+// It lives outside the semver package so that programs which only parse and compare versions
+// do not link net/http or expvar, whose initialization registers /debug/vars on
+// http.DefaultServeMux.
+package semverregistry
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/sixafter/semver"
+)
+
+// Registry records the versions of the components linked into a binary, along with the
+// version ranges the components require of each other.
+//
+// A Registry is safe for concurrent use. It implements http.Handler and can be published
+// through expvar to expose the registered versions on a debug endpoint.
+//
+// Example:
+//
+//	reg := semverregistry.NewRegistry()
+//	reg.Register("api", semver.MustParse("2.3.0"))
+//	reg.Register("storage", semver.MustParse("1.4.2"))
+//	_ = reg.Require("api", "storage", ">=1.4.0 <2.0.0")
+//
+//	http.Handle("/debug/versions", reg)
+type Registry struct {
+	mu           sync.RWMutex
+	components   map[string]semver.Version
+	requirements []registryRequirement
+}
+
+// registryRequirement is a version range one component requires of another.
+type registryRequirement struct {
+	component  string
+	dependency string
+	constraint string
+	rng        *semver.VersionRange
+}
+
+// Compatibility is the result of checking one component's requirement on another.
+//
+// Missing is true if the dependency is not registered, in which case Satisfied is false.
+type Compatibility struct {
+	Component  string          `json:"component"`
+	Dependency string          `json:"dependency"`
+	Constraint string          `json:"constraint"`
+	Version    *semver.Version `json:"version,omitempty"`
+	Satisfied  bool            `json:"satisfied"`
+	Missing    bool            `json:"missing,omitempty"`
+}
+
+// RegistrySnapshot is a point-in-time view of a Registry, as served over HTTP and expvar.
+type RegistrySnapshot struct {
+	Components    map[string]semver.Version `json:"components"`
+	Compatibility []Compatibility           `json:"compatibility"`
+}
+
+// DefaultRegistry is the Registry used by the package-level Register and Require functions.
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		components: make(map[string]semver.Version),
+	}
+}
+
+// Register records the version of a component, replacing any previously registered version.
+func (r *Registry) Register(component string, v semver.Version) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.components[component] = v
+}
+
+// Require records that component requires dependency to satisfy the range constraint.
+//
+// Returns an error if the constraint cannot be parsed.
+func (r *Registry) Require(component, dependency, constraint string) error {
+	rng, err := semver.ParseRange(constraint)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requirements = append(r.requirements, registryRequirement{
+		component:  component,
+		dependency: dependency,
+		constraint: constraint,
+		rng:        rng,
+	})
+	return nil
+}
+
+// Lookup returns the registered version of a component.
+func (r *Registry) Lookup(component string) (semver.Version, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	v, ok := r.components[component]
+	return v, ok
+}
+
+// Versions returns a copy of all registered component versions.
+func (r *Registry) Versions() map[string]semver.Version {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.versions()
+}
+
+// versions copies the registered component versions. The caller must hold r.mu.
+func (r *Registry) versions() map[string]semver.Version {
+	versions := make(map[string]semver.Version, len(r.components))
+	for name, v := range r.components {
+		versions[name] = v
+	}
+	return versions
+}
+
+// Check evaluates every recorded requirement against the registered versions, in the order
+// the requirements were recorded.
+func (r *Registry) Check() []Compatibility {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.check()
+}
+
+// check evaluates the recorded requirements. The caller must hold r.mu.
+func (r *Registry) check() []Compatibility {
+	results := make([]Compatibility, 0, len(r.requirements))
+	for _, req := range r.requirements {
+		c := Compatibility{
+			Component:  req.component,
+			Dependency: req.dependency,
+			Constraint: req.constraint,
+		}
+		if v, ok := r.components[req.dependency]; ok {
+			c.Version = &v
+			c.Satisfied = req.rng.Contains(v)
+		} else {
+			c.Missing = true
+		}
+		results = append(results, c)
+	}
+	return results
+}
+
+// Compatible reports whether every recorded requirement is satisfied.
+func (r *Registry) Compatible() bool {
+	for _, c := range r.Check() {
+		if !c.Satisfied {
+			return false
+		}
+	}
+	return true
+}
+
+// Components returns the names of all registered components in sorted order.
+func (r *Registry) Components() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.components))
+	for name := range r.components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Snapshot returns the registered versions together with the results of Check, both taken
+// from the same state of the Registry.
+func (r *Registry) Snapshot() RegistrySnapshot {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return RegistrySnapshot{
+		Components:    r.versions(),
+		Compatibility: r.check(),
+	}
+}
+
+// ServeHTTP implements http.Handler, writing the Snapshot as JSON.
+// The response status is 200 if every requirement is satisfied and 409 otherwise.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	snapshot := r.Snapshot()
+
+	status := http.StatusOK
+	for _, c := range snapshot.Compatibility {
+		if !c.Satisfied {
+			status = http.StatusConflict
+			break
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(snapshot)
+}
+
+// Publish exposes the Snapshot as an expvar variable with the given name.
+//
+// Like expvar.Publish, it panics if the name is already in use.
+//
+// Example:
+//
+//	semverregistry.DefaultRegistry.Publish("versions") // served on /debug/vars
+func (r *Registry) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return r.Snapshot()
+	}))
+}
+
+// Register records the version of a component in DefaultRegistry.
+//
+// Example:
+//
+//	semverregistry.Register("api", semver.MustParse("2.3.0"))
+func Register(component string, v semver.Version) {
+	DefaultRegistry.Register(component, v)
+}
+
+// Require records a requirement between two components in DefaultRegistry.
+//
+// Example:
+//
+//	if err := semverregistry.Require("api", "storage", ">=1.4.0"); err != nil {
+//	    log.Fatal(err)
+//	}
+func Require(component, dependency, constraint string) error {
+	return DefaultRegistry.Require(component, dependency, constraint)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semverregistry
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reg := NewRegistry()
+	reg.Register("api", semver.MustParse("2.3.0"))
+	reg.Register("storage", semver.MustParse("1.4.2"))
+	is.NoError(reg.Require("api", "storage", ">=1.4.0 <2.0.0"))
+	is.NoError(reg.Require("api", "cache", ">=1.0.0"))
+	is.Error(reg.Require("api", "storage", "invalid"))
+
+	v, ok := reg.Lookup("storage")
+	is.True(ok)
+	is.Equal("1.4.2", v.String())
+
+	is.Equal([]string{"api", "storage"}, reg.Components())
+	is.Len(reg.Versions(), 2)
+
+	results := reg.Check()
+	is.Len(results, 2)
+	is.True(results[0].Satisfied)
+	is.Equal("1.4.2", results[0].Version.String())
+	is.True(results[1].Missing)
+	is.False(results[1].Satisfied)
+	is.False(reg.Compatible())
+
+	reg.Register("cache", semver.MustParse("1.0.0"))
+	is.True(reg.Compatible())
+
+	reg.Register("storage", semver.MustParse("2.0.0"))
+	is.False(reg.Compatible())
+}
+
+func TestRegistryServeHTTP(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reg := NewRegistry()
+	reg.Register("api", semver.MustParse("2.3.0"))
+	reg.Register("storage", semver.MustParse("1.4.2"))
+	is.NoError(reg.Require("api", "storage", ">=1.4.0"))
+
+	rec := httptest.NewRecorder()
+	reg.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/versions", nil))
+	is.Equal(http.StatusOK, rec.Code)
+	is.Equal("application/json", rec.Header().Get("Content-Type"))
+
+	var snapshot RegistrySnapshot
+	is.NoError(json.Unmarshal(rec.Body.Bytes(), &snapshot))
+	is.Equal(semver.MustParse("2.3.0"), snapshot.Components["api"])
+	is.True(snapshot.Compatibility[0].Satisfied)
+
+	is.NoError(reg.Require("api", "auth", ">=1.0.0"))
+	rec = httptest.NewRecorder()
+	reg.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/versions", nil))
+	is.Equal(http.StatusConflict, rec.Code)
+}
+
+func TestRegistryPublish(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reg := NewRegistry()
+	reg.Register("api", semver.MustParse("1.0.0"))
+	reg.Publish("semver_test_registry")
+
+	published := expvar.Get("semver_test_registry")
+	is.NotNil(published)
+	is.JSONEq(`{"components":{"api":"1.0.0"},"compatibility":[]}`, published.String())
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	Register("semver-test-component", semver.MustParse("0.1.0"))
+	is.NoError(Require("semver-test-component", "semver-test-component", ">=0.1.0"))
+
+	v, ok := DefaultRegistry.Lookup("semver-test-component")
+	is.True(ok)
+	is.Equal("0.1.0", v.String())
+}
+
+func TestRegistrySnapshotConsistent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	reg := NewRegistry()
+	reg.Register("storage", semver.MustParse("1.0.0"))
+	is.NoError(reg.Require("api", "storage", ">=1.0.0"))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			reg.Register("storage", semver.Version{Major: 1, Patch: uint64(i)})
+		}
+	}()
+
+	for i := 0; i < 1000; i++ {
+		snapshot := reg.Snapshot()
+		is.Equal(snapshot.Components["storage"], *snapshot.Compatibility[0].Version)
+	}
+	<-done
+}
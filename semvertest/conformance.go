@@ -0,0 +1,159 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semvertest
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/sixafter/semver"
+)
+
+//go:embed corpus/*.json
+var corpusFS embed.FS
+
+// RangeFixture is a single golden case: a range expression and the versions it must and must
+// not match, or, if Invalid is true, a range expression that must be rejected.
+type RangeFixture struct {
+	Range   string   `json:"range"`
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+	Invalid bool     `json:"invalid,omitempty"`
+}
+
+// ConformanceFailure describes a fixture case that a Dialect did not satisfy.
+// Version is empty for failures that concern the range itself.
+type ConformanceFailure struct {
+	Range   string `json:"range"`
+	Version string `json:"version,omitempty"`
+	Reason  string `json:"reason"`
+}
+
+// String returns a human-readable description of the failure.
+func (f ConformanceFailure) String() string {
+	if f.Version == "" {
+		return fmt.Sprintf("%q: %s", f.Range, f.Reason)
+	}
+	return fmt.Sprintf("%q with %s: %s", f.Range, f.Version, f.Reason)
+}
+
+// TestingT is the subset of testing.TB used by RunConformance.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// NodeSemverCorpus returns fixtures adapted from the node-semver test suite.
+//
+// Cases that depend on npm's rule that a pre-release only matches a comparator with a
+// pre-release on the same major.minor.patch tuple are omitted, since a VersionRange cannot
+// express it. NpmDialect and HelmDialect pass the corpus.
+func NodeSemverCorpus() []RangeFixture {
+	return mustLoadCorpus("corpus/node-semver.json")
+}
+
+// CargoCorpus returns fixtures adapted from the Rust semver crate's test suite, in Cargo
+// syntax, where a bare version such as "1.2.3" is a caret requirement.
+//
+// As with NodeSemverCorpus, cases that depend on the same-tuple pre-release rule are omitted.
+// CargoDialect passes the corpus.
+func CargoCorpus() []RangeFixture {
+	return mustLoadCorpus("corpus/cargo.json")
+}
+
+// ComposerCorpus returns fixtures adapted from the composer/semver test suite.
+// ComposerDialect passes the corpus.
+func ComposerCorpus() []RangeFixture {
+	return mustLoadCorpus("corpus/composer.json")
+}
+
+// LoadCorpus reads fixtures from a JSON array of RangeFixture objects.
+func LoadCorpus(r io.Reader) ([]RangeFixture, error) {
+	var fixtures []RangeFixture
+	if err := json.NewDecoder(r).Decode(&fixtures); err != nil {
+		return nil, fmt.Errorf("semvertest: invalid corpus: %w", err)
+	}
+	return fixtures, nil
+}
+
+// mustLoadCorpus loads an embedded corpus, which is known to be valid.
+func mustLoadCorpus(name string) []RangeFixture {
+	f, err := corpusFS.Open(name)
+	if err != nil {
+		panic("semvertest: " + err.Error())
+	}
+	defer f.Close()
+
+	fixtures, err := LoadCorpus(f)
+	if err != nil {
+		panic(err)
+	}
+	return fixtures
+}
+
+// CheckConformance evaluates every fixture against the Dialect and returns the cases it fails.
+// An empty result means the Dialect conforms to the fixtures.
+//
+// Example:
+//
+//	for _, f := range semvertest.CheckConformance(semver.ComposerDialect, semvertest.ComposerCorpus()) {
+//	    fmt.Println(f)
+//	}
+func CheckConformance(d semver.Dialect, fixtures []RangeFixture) []ConformanceFailure {
+	var failures []ConformanceFailure
+	for _, fixture := range fixtures {
+		vr, err := d.ParseRange(fixture.Range)
+		if fixture.Invalid {
+			if err == nil {
+				failures = append(failures, ConformanceFailure{Range: fixture.Range, Reason: "expected a parse error"})
+			}
+			continue
+		}
+		if err != nil {
+			failures = append(failures, ConformanceFailure{Range: fixture.Range, Reason: "unexpected parse error: " + err.Error()})
+			continue
+		}
+
+		failures = appendMatchFailures(failures, vr, fixture.Range, fixture.Include, true)
+		failures = appendMatchFailures(failures, vr, fixture.Range, fixture.Exclude, false)
+	}
+	return failures
+}
+
+// appendMatchFailures checks that each version's membership in vr equals want.
+func appendMatchFailures(failures []ConformanceFailure, vr *semver.VersionRange, r string, versions []string, want bool) []ConformanceFailure {
+	for _, s := range versions {
+		v, err := semver.Parse(s)
+		if err != nil {
+			failures = append(failures, ConformanceFailure{Range: r, Version: s, Reason: "invalid fixture version: " + err.Error()})
+			continue
+		}
+		if got := vr.Contains(v); got != want {
+			reason := "expected match"
+			if !want {
+				reason = "expected no match"
+			}
+			failures = append(failures, ConformanceFailure{Range: r, Version: s, Reason: reason})
+		}
+	}
+	return failures
+}
+
+// RunConformance reports every fixture case that the Dialect fails as a test error.
+//
+// Example:
+//
+//	func TestMyDialectConformance(t *testing.T) {
+//	    semvertest.RunConformance(t, MyDialect, semvertest.NodeSemverCorpus())
+//	}
+func RunConformance(t TestingT, d semver.Dialect, fixtures []RangeFixture) {
+	t.Helper()
+	for _, f := range CheckConformance(d, fixtures) {
+		t.Errorf("%s dialect: %s", d.Name(), f)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semvertest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorder is a TestingT that records errors instead of failing.
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	RunConformance(t, semver.NpmDialect, NodeSemverCorpus())
+	RunConformance(t, semver.HelmDialect, NodeSemverCorpus())
+	RunConformance(t, semver.ComposerDialect, ComposerCorpus())
+	RunConformance(t, semver.CargoDialect, CargoCorpus())
+}
+
+func TestCheckConformanceReportsFailures(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	fixtures, err := LoadCorpus(strings.NewReader(`[
+		{"range": "^1.2.3", "include": ["1.9.0", "2.0.0", "bogus"], "exclude": ["1.2.3"]},
+		{"range": ">=1.0.0", "invalid": true},
+		{"range": "blerg", "include": ["1.0.0"]}
+	]`))
+	is.NoError(err)
+
+	failures := CheckConformance(semver.HelmDialect, fixtures)
+	is.Len(failures, 5)
+	is.Equal(ConformanceFailure{Range: "^1.2.3", Version: "2.0.0", Reason: "expected match"}, failures[0])
+	is.Equal("bogus", failures[1].Version)
+	is.Equal(`"^1.2.3" with 1.2.3: expected no match`, failures[2].String())
+	is.Equal(`">=1.0.0": expected a parse error`, failures[3].String())
+	is.Contains(failures[4].Reason, "unexpected parse error")
+
+	r := &recorder{}
+	RunConformance(r, semver.HelmDialect, fixtures)
+	is.Len(r.errors, 5)
+	is.True(strings.HasPrefix(r.errors[0], "helm dialect: "))
+
+	_, err = LoadCorpus(strings.NewReader(`{`))
+	is.Error(err)
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+// Package semvertest provides deterministic generators of valid and invalid versions and
+// ranges for property-based testing of code that handles semantic versions.
+//
+// Generators are seeded, so a failing case can be reproduced from its seed. The Quick* types
+// implement testing/quick.Generator and can be used directly as quick.Check arguments.
+//
+// Example:
+//
+//	g := semvertest.New(42)
+//	for i := 0; i < 100; i++ {
+//	    v := g.Version()
+//	    if _, err := semver.Parse(v.String()); err != nil {
+//	        t.Fatal(err)
+//	    }
+//	}
+package semvertest
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/sixafter/semver"
+)
+
+// Default generation settings.
+const (
+	DefaultMaxComponent          = 20
+	DefaultPrereleaseProbability = 0.3
+	DefaultBuildProbability      = 0.2
+)
+
+const (
+	identifierAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz-"
+	letterAlphabet     = "abcdefghijklmnopqrstuvwxyz"
+)
+
+// rangeOperators are the operators used when generating ranges.
+var rangeOperators = []semver.Operator{
+	semver.OpEq, semver.OpGt, semver.OpGte, semver.OpLt, semver.OpLte, semver.OpNeq, semver.OpCaret, semver.OpTilde,
+}
+
+// invalidVersions are hand-picked strings that violate a specific rule of the specification.
+var invalidVersions = []string{
+	"",
+	"1",
+	"1.2",
+	"1.2.3.4",
+	"01.2.3",
+	"1.02.3",
+	"1.2.03",
+	"-1.2.3",
+	"1.2.3-",
+	"1.2.3+",
+	"1.2.3-01",
+	"1.2.3-alpha..1",
+	"1.2.3+build..1",
+	"1.2.3-al_pha",
+	"1.2.3+bu!ld",
+	"a.b.c",
+	"1.2.3 ",
+	"1.2.-3",
+}
+
+// Option configures a Generator.
+type Option func(*options)
+
+type options struct {
+	maxComponent          uint64
+	prereleaseProbability float64
+	buildProbability      float64
+}
+
+// WithMaxComponent sets the largest major, minor, and patch value that is generated.
+func WithMaxComponent(n uint64) Option {
+	return func(o *options) {
+		o.maxComponent = n
+	}
+}
+
+// WithPrereleaseProbability sets the probability, between 0 and 1, that a generated version
+// has pre-release identifiers.
+func WithPrereleaseProbability(p float64) Option {
+	return func(o *options) {
+		o.prereleaseProbability = p
+	}
+}
+
+// WithBuildProbability sets the probability, between 0 and 1, that a generated version has
+// build metadata.
+func WithBuildProbability(p float64) Option {
+	return func(o *options) {
+		o.buildProbability = p
+	}
+}
+
+// Generator produces deterministic pseudo-random versions and ranges from a seed.
+//
+// A Generator is not safe for concurrent use.
+type Generator struct {
+	rnd  *rand.Rand
+	opts options
+}
+
+// New creates a Generator seeded with seed.
+//
+// Example:
+//
+//	g := semvertest.New(1, semvertest.WithPrereleaseProbability(0))
+//	fmt.Println(len(g.Version().PreRelease)) // Output: 0
+func New(seed int64, opts ...Option) *Generator {
+	return NewFromRand(rand.New(rand.NewSource(seed)), opts...)
+}
+
+// NewFromRand creates a Generator that draws from rnd, such as the source passed to a
+// testing/quick.Generator.
+func NewFromRand(rnd *rand.Rand, opts ...Option) *Generator {
+	o := options{
+		maxComponent:          DefaultMaxComponent,
+		prereleaseProbability: DefaultPrereleaseProbability,
+		buildProbability:      DefaultBuildProbability,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Generator{rnd: rnd, opts: o}
+}
+
+// Version returns a valid version.
+func (g *Generator) Version() semver.Version {
+	return semver.MustParse(g.VersionString())
+}
+
+// Versions returns n valid versions.
+func (g *Generator) Versions(n int) []semver.Version {
+	versions := make([]semver.Version, n)
+	for i := range versions {
+		versions[i] = g.Version()
+	}
+	return versions
+}
+
+// VersionString returns the string form of a valid version.
+func (g *Generator) VersionString() string {
+	var sb strings.Builder
+	sb.WriteString(g.component())
+	sb.WriteByte('.')
+	sb.WriteString(g.component())
+	sb.WriteByte('.')
+	sb.WriteString(g.component())
+
+	if g.rnd.Float64() < g.opts.prereleaseProbability {
+		sb.WriteByte('-')
+		sb.WriteString(g.identifiers(true))
+	}
+	if g.rnd.Float64() < g.opts.buildProbability {
+		sb.WriteByte('+')
+		sb.WriteString(g.identifiers(false))
+	}
+	return sb.String()
+}
+
+// InvalidVersionString returns a string that is not a valid version, either taken from a list
+// of known violations or produced by corrupting a valid version.
+func (g *Generator) InvalidVersionString() string {
+	if g.rnd.Intn(2) == 0 {
+		return invalidVersions[g.rnd.Intn(len(invalidVersions))]
+	}
+
+	v := g.VersionString()
+	switch g.rnd.Intn(4) {
+	case 0:
+		return v + "."
+	case 1:
+		return "0" + v
+	case 2:
+		return strings.Replace(v, ".", "..", 1)
+	default:
+		return v + "+_"
+	}
+}
+
+// Requirement returns a requirement with a random operator and a valid version.
+func (g *Generator) Requirement() semver.Requirement {
+	return semver.Requirement{
+		Op:  rangeOperators[g.rnd.Intn(len(rangeOperators))],
+		Ver: g.Version(),
+	}
+}
+
+// Range returns a valid range with one to three OR branches of one to three requirements each.
+func (g *Generator) Range() *semver.VersionRange {
+	return semver.MustParseRange(g.RangeString())
+}
+
+// RangeString returns the string form of a valid range in ParseRange syntax.
+func (g *Generator) RangeString() string {
+	branches := make([]string, 1+g.rnd.Intn(3))
+	for i := range branches {
+		reqs := make([]string, 1+g.rnd.Intn(3))
+		for j := range reqs {
+			reqs[j] = g.Requirement().String()
+		}
+		branches[i] = strings.Join(reqs, " ")
+	}
+	return strings.Join(branches, " || ")
+}
+
+// InvalidRangeString returns a string that ParseRange rejects.
+func (g *Generator) InvalidRangeString() string {
+	switch g.rnd.Intn(3) {
+	case 0:
+		return ">=" + g.invalidRangeVersion()
+	case 1:
+		return g.RangeString() + " || =>" + g.VersionString()
+	default:
+		return g.RangeString() + " " + g.invalidRangeVersion()
+	}
+}
+
+// invalidRangeVersion returns an invalid version that cannot be mistaken for an empty or
+// multi-token range. Versions with a revision are skipped, since ranges accept the fourth
+// segment that Version.String writes for one.
+func (g *Generator) invalidRangeVersion() string {
+	for {
+		s := g.InvalidVersionString()
+		if s != "" && !strings.ContainsAny(s, " ") && strings.Count(s, ".") != 3 {
+			return s
+		}
+	}
+}
+
+// component returns a numeric version component without leading zeros.
+func (g *Generator) component() string {
+	return strconv.FormatUint(g.uint64n(g.opts.maxComponent), 10)
+}
+
+// uint64n returns a uniformly distributed number from 0 to limit inclusive.
+func (g *Generator) uint64n(limit uint64) uint64 {
+	if limit < math.MaxInt64 {
+		return uint64(g.rnd.Int63n(int64(limit) + 1))
+	}
+
+	// Int63n cannot take the bound, so draw 64 bits and reject values above limit, which
+	// happens at most half the time.
+	for {
+		if n := g.rnd.Uint64(); n <= limit {
+			return n
+		}
+	}
+}
+
+// identifiers returns one to three dot-separated identifiers. Numeric pre-release identifiers
+// never have leading zeros.
+func (g *Generator) identifiers(prerelease bool) string {
+	ids := make([]string, 1+g.rnd.Intn(3))
+	for i := range ids {
+		switch g.rnd.Intn(3) {
+		case 0:
+			ids[i] = g.component()
+		case 1:
+			ids[i] = g.word(letterAlphabet)
+		default:
+			ids[i] = g.word(identifierAlphabet)
+			if prerelease && isNumeric(ids[i]) {
+				ids[i] = "x" + ids[i]
+			}
+		}
+	}
+	return strings.Join(ids, ".")
+}
+
+// word returns a random string of one to eight characters from alphabet.
+func (g *Generator) word(alphabet string) string {
+	b := make([]byte, 1+g.rnd.Intn(8))
+	for i := range b {
+		b[i] = alphabet[g.rnd.Intn(len(alphabet))]
+	}
+	return string(b)
+}
+
+// isNumeric reports whether s consists only of digits.
+func isNumeric(s string) bool {
+	return strings.Trim(s, "0123456789") == ""
+}
+
+// QuickVersion is a valid version that implements testing/quick.Generator.
+//
+// Example:
+//
+//	err := quick.Check(func(q semvertest.QuickVersion) bool {
+//	    return q.Version.Equal(semver.MustParse(q.Version.String()))
+//	}, nil)
+type QuickVersion struct {
+	semver.Version
+}
+
+// Generate implements testing/quick.Generator.
+func (QuickVersion) Generate(rnd *rand.Rand, size int) reflect.Value {
+	g := NewFromRand(rnd, WithMaxComponent(uint64(max(size, 1))))
+	return reflect.ValueOf(QuickVersion{Version: g.Version()})
+}
+
+// QuickVersionString is the string form of a valid version that implements testing/quick.Generator.
+type QuickVersionString string
+
+// Generate implements testing/quick.Generator.
+func (QuickVersionString) Generate(rnd *rand.Rand, size int) reflect.Value {
+	g := NewFromRand(rnd, WithMaxComponent(uint64(max(size, 1))))
+	return reflect.ValueOf(QuickVersionString(g.VersionString()))
+}
+
+// QuickInvalidVersionString is a string that is not a valid version and implements
+// testing/quick.Generator.
+type QuickInvalidVersionString string
+
+// Generate implements testing/quick.Generator.
+func (QuickInvalidVersionString) Generate(rnd *rand.Rand, size int) reflect.Value {
+	g := NewFromRand(rnd, WithMaxComponent(uint64(max(size, 1))))
+	return reflect.ValueOf(QuickInvalidVersionString(g.InvalidVersionString()))
+}
+
+// QuickRange is a valid range that implements testing/quick.Generator.
+type QuickRange struct {
+	*semver.VersionRange
+}
+
+// Generate implements testing/quick.Generator.
+func (QuickRange) Generate(rnd *rand.Rand, size int) reflect.Value {
+	g := NewFromRand(rnd, WithMaxComponent(uint64(max(size, 1))))
+	return reflect.ValueOf(QuickRange{VersionRange: g.Range()})
+}
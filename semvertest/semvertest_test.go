@@ -0,0 +1,101 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semvertest
+
+import (
+	"math"
+	"testing"
+	"testing/quick"
+
+	"github.com/sixafter/semver"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratorDeterministic(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	a, b := New(7), New(7)
+	for i := 0; i < 50; i++ {
+		is.Equal(a.VersionString(), b.VersionString())
+		is.Equal(a.RangeString(), b.RangeString())
+		is.Equal(a.InvalidVersionString(), b.InvalidVersionString())
+	}
+}
+
+func TestGeneratorValidity(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	g := New(42)
+	for i := 0; i < 500; i++ {
+		s := g.VersionString()
+		_, err := semver.Parse(s)
+		is.NoError(err, "Expected valid version: %q", s)
+
+		s = g.InvalidVersionString()
+		_, err = semver.Parse(s)
+		is.Error(err, "Expected invalid version: %q", s)
+
+		s = g.RangeString()
+		_, err = semver.ParseRange(s)
+		is.NoError(err, "Expected valid range: %q", s)
+
+		s = g.InvalidRangeString()
+		_, err = semver.ParseRange(s)
+		is.Error(err, "Expected invalid range: %q", s)
+	}
+}
+
+func TestGeneratorOptions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	g := New(1, WithMaxComponent(2), WithPrereleaseProbability(0), WithBuildProbability(1))
+	for _, v := range g.Versions(100) {
+		is.LessOrEqual(v.Major, uint64(2))
+		is.LessOrEqual(v.Minor, uint64(2))
+		is.LessOrEqual(v.Patch, uint64(2))
+		is.Empty(v.PreRelease)
+		is.NotEmpty(v.BuildMetadata)
+	}
+}
+
+func TestGeneratorMaxComponentBounds(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, limit := range []uint64{math.MaxInt64 - 1, math.MaxInt64, math.MaxInt64 + 1, math.MaxUint64} {
+		g := New(1, WithMaxComponent(limit))
+		var largest uint64
+		is.NotPanics(func() {
+			for _, v := range g.Versions(100) {
+				is.LessOrEqual(v.Major, limit)
+				largest = max(largest, v.Major, v.Minor, v.Patch)
+			}
+		}, "WithMaxComponent(%d)", limit)
+		is.Greater(largest, limit/2, "WithMaxComponent(%d)", limit)
+	}
+}
+
+func TestQuickGenerators(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.NoError(quick.Check(func(q QuickVersion) bool {
+		return q.Version.Compare(semver.MustParse(q.Version.String())) == 0
+	}, nil))
+
+	is.NoError(quick.Check(func(s QuickVersionString, bad QuickInvalidVersionString) bool {
+		_, err := semver.Parse(string(s))
+		_, badErr := semver.Parse(string(bad))
+		return err == nil && badErr != nil
+	}, nil))
+
+	is.NoError(quick.Check(func(q QuickRange) bool {
+		return q.Validate() == nil && q.String() == semver.MustParseRange(q.String()).String()
+	}, nil))
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SetPrerelease returns a copy of the version with its pre-release identifiers replaced by
+// those in pre, such as "rc.2". An empty pre removes the pre-release. The receiver is not
+// modified.
+//
+// Unlike assigning to the PreRelease field, the identifiers are parsed and validated by
+// DefaultParser, so the result's String form always parses back to the same version.
+//
+// Returns an error if pre is not a valid dot-separated list of pre-release identifiers.
+//
+// Example:
+//
+//	v, err := semver.MustParse("1.2.3-rc.1+build.7").SetPrerelease("rc.2")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.3-rc.2+build.7
+func (v Version) SetPrerelease(pre string) (Version, error) {
+	next := v.Clone()
+	next.PreRelease = nil
+	if pre == "" {
+		return next, nil
+	}
+	if strings.IndexByte(pre, '+') >= 0 {
+		return Version{}, fmt.Errorf("pre-release %q: %w", pre, ErrInvalidCharacterInIdentifier)
+	}
+
+	parsed, err := DefaultParser.Parse("0.0.0-" + pre)
+	if err != nil {
+		return Version{}, fmt.Errorf("pre-release %q: %w", pre, err)
+	}
+	next.PreRelease = parsed.PreRelease
+	return next, nil
+}
+
+// SetBuildMetadata returns a copy of the version with its build metadata replaced by the
+// identifiers in build, such as "sha.abcdef". An empty build removes the build metadata. The
+// receiver is not modified.
+//
+// Unlike assigning to the BuildMetadata field, the identifiers are parsed and validated by
+// DefaultParser, so the result's String form always parses back to the same version.
+//
+// Returns an error if build is not a valid dot-separated list of build identifiers.
+//
+// Example:
+//
+//	v, err := semver.MustParse("1.2.3-rc.1").SetBuildMetadata("sha.abcdef")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.3-rc.1+sha.abcdef
+func (v Version) SetBuildMetadata(build string) (Version, error) {
+	next := v.Clone()
+	next.BuildMetadata = nil
+	if build == "" {
+		return next, nil
+	}
+
+	parsed, err := DefaultParser.Parse("0.0.0+" + build)
+	if err != nil {
+		return Version{}, fmt.Errorf("build metadata %q: %w", build, err)
+	}
+	next.BuildMetadata = parsed.BuildMetadata
+	return next, nil
+}
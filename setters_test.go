@@ -0,0 +1,56 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPrerelease(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	orig := MustParse("1.2.3-rc.1+build.7")
+
+	v, err := orig.SetPrerelease("rc.2")
+	is.NoError(err)
+	is.Equal("1.2.3-rc.2+build.7", v.String())
+	is.True(v.StrictEqual(MustParse(v.String())))
+	is.Equal("1.2.3-rc.1+build.7", orig.String())
+
+	v, err = orig.SetPrerelease("")
+	is.NoError(err)
+	is.Equal("1.2.3+build.7", v.String())
+
+	for _, pre := range []string{"rc..1", "rc.01", "rc_1", "rc.1+meta", ".", "ü"} {
+		_, err := orig.SetPrerelease(pre)
+		is.Error(err, pre)
+	}
+}
+
+func TestSetBuildMetadata(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	orig := MustParse("1.2.3-rc.1")
+
+	v, err := orig.SetBuildMetadata("sha.abcdef.001")
+	is.NoError(err)
+	is.Equal("1.2.3-rc.1+sha.abcdef.001", v.String())
+	is.True(v.StrictEqual(MustParse(v.String())))
+	is.Empty(orig.BuildMetadata)
+
+	v, err = v.SetBuildMetadata("")
+	is.NoError(err)
+	is.Equal("1.2.3-rc.1", v.String())
+
+	for _, build := range []string{"sha..1", "sha+1", "sha_1", "."} {
+		_, err := orig.SetBuildMetadata(build)
+		is.Error(err, build)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrVersionSkew indicates that two components' versions are further apart than a SkewPolicy allows.
+var ErrVersionSkew = errors.New("version skew exceeds policy")
+
+// SkewPolicy is a compatibility window between a writer, such as the component that owns a
+// storage schema, and a reader of its data, measured in releases at a given level.
+//
+// Behind is how many releases at Level the reader may trail the writer, and Ahead how many it
+// may lead it. The reader and writer must agree on every component more significant than
+// Level, so a window measured in minor releases never spans two major versions. Pre-release
+// identifiers and build metadata are ignored, and the epoch must always match.
+//
+// Example:
+//
+//	p := semver.SkewPolicy{Level: semver.BumpMinor, Behind: 1}
+//	err := p.Check(semver.MustParse("1.5.0"), semver.MustParse("1.3.2"))
+//	fmt.Println(err)
+//	// Output: reader 1.3.2 is 2 minor versions behind writer 1.5.0; policy allows at most 1 behind
+type SkewPolicy struct {
+	Level  BumpLevel
+	Behind uint64
+	Ahead  uint64
+}
+
+// WithinMinors returns a policy that allows the reader to be up to n minor releases behind or
+// ahead of the writer within the same major version.
+func WithinMinors(n uint64) SkewPolicy {
+	return SkewPolicy{Level: BumpMinor, Behind: n, Ahead: n}
+}
+
+// WithinMajors returns a policy that allows the reader to be up to n major releases behind or
+// ahead of the writer.
+func WithinMajors(n uint64) SkewPolicy {
+	return SkewPolicy{Level: BumpMajor, Behind: n, Ahead: n}
+}
+
+// SkewError describes a reader and writer whose versions fall outside a SkewPolicy.
+//
+// Steps is how many releases at the policy's level separate them, and Ahead is true if the
+// reader leads the writer. If the versions differ in a component more significant than the
+// policy's level, Incompatible names that component and Steps is zero.
+type SkewError struct {
+	Writer       Version
+	Reader       Version
+	Policy       SkewPolicy
+	Steps        uint64
+	Ahead        bool
+	Incompatible string
+}
+
+// Error returns a message that names both versions and the allowed window.
+func (e *SkewError) Error() string {
+	if e.Incompatible != "" {
+		return fmt.Sprintf("reader %s and writer %s have different %s versions; policy allows skew only in %s versions",
+			e.Reader, e.Writer, e.Incompatible, e.Policy.Level)
+	}
+
+	direction, relation, allowed := "behind", "behind", e.Policy.Behind
+	if e.Ahead {
+		direction, relation, allowed = "ahead", "ahead of", e.Policy.Ahead
+	}
+	unit := "versions"
+	if e.Steps == 1 {
+		unit = "version"
+	}
+	return fmt.Sprintf("reader %s is %d %s %s %s writer %s; policy allows at most %d %s",
+		e.Reader, e.Steps, e.Policy.Level, unit, relation, e.Writer, allowed, direction)
+}
+
+// Unwrap returns ErrVersionSkew.
+func (e *SkewError) Unwrap() error {
+	return ErrVersionSkew
+}
+
+// Check returns a *SkewError if the reader's version falls outside the window around the
+// writer's version, or nil if they are compatible.
+func (p SkewPolicy) Check(writer, reader Version) error {
+	w, r := skewComponents(writer), skewComponents(reader)
+
+	level := int(BumpMajor - min(max(p.Level, BumpPatch), BumpMajor))
+	names := [...]string{"epoch", "major", "minor", "patch"}
+	for i := 0; i <= level; i++ {
+		if w[i] != r[i] {
+			return &SkewError{Writer: writer, Reader: reader, Policy: p, Incompatible: names[i]}
+		}
+	}
+
+	wi, ri := w[level+1], r[level+1]
+	switch {
+	case ri < wi && wi-ri > p.Behind:
+		return &SkewError{Writer: writer, Reader: reader, Policy: p, Steps: wi - ri}
+	case ri > wi && ri-wi > p.Ahead:
+		return &SkewError{Writer: writer, Reader: reader, Policy: p, Steps: ri - wi, Ahead: true}
+	}
+	return nil
+}
+
+// skewComponents returns the epoch, major, minor, and patch versions of v.
+func skewComponents(v Version) [4]uint64 {
+	return [4]uint64{v.Epoch, v.Major, v.Minor, v.Patch}
+}
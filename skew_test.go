@@ -0,0 +1,72 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkewPolicyCheck(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	within := WithinMinors(1)
+	tests := []struct {
+		policy         SkewPolicy
+		writer, reader string
+		ok             bool
+	}{
+		{within, "1.5.0", "1.5.9", true},
+		{within, "1.5.0", "1.4.0", true},
+		{within, "1.5.0", "1.6.0-rc.1", true},
+		{within, "1.5.0", "1.3.0", false},
+		{within, "1.5.0", "1.7.0", false},
+		{within, "1.9.0", "2.0.0", false},
+		{SkewPolicy{Level: BumpMinor, Behind: 2}, "1.5.0", "1.3.0", true},
+		{SkewPolicy{Level: BumpMinor, Behind: 2}, "1.5.0", "1.6.0", false},
+		{WithinMajors(1), "2.0.0", "1.9.0", true},
+		{WithinMajors(1), "3.0.0", "1.9.0", false},
+		{SkewPolicy{Level: BumpPatch, Ahead: 3}, "1.2.3", "1.2.6", true},
+		{SkewPolicy{Level: BumpPatch, Ahead: 3}, "1.2.3", "1.2.2", false},
+	}
+
+	for _, tt := range tests {
+		err := tt.policy.Check(MustParse(tt.writer), MustParse(tt.reader))
+		if tt.ok {
+			is.NoError(err, "%s -> %s", tt.writer, tt.reader)
+		} else {
+			is.ErrorIs(err, ErrVersionSkew, "%s -> %s", tt.writer, tt.reader)
+		}
+	}
+
+	err := WithinMajors(1).Check(Version{Epoch: 1, Major: 1}, MustParse("1.0.0"))
+	is.ErrorIs(err, ErrVersionSkew, "Versions in different epochs should be incompatible")
+}
+
+func TestSkewErrorMessage(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p := SkewPolicy{Level: BumpMinor, Behind: 1}
+	err := p.Check(MustParse("1.5.0"), MustParse("1.3.2"))
+	is.EqualError(err, "reader 1.3.2 is 2 minor versions behind writer 1.5.0; policy allows at most 1 behind")
+
+	var skew *SkewError
+	is.True(errors.As(err, &skew))
+	is.Equal(uint64(2), skew.Steps)
+	is.False(skew.Ahead)
+
+	err = p.Check(MustParse("1.5.0"), MustParse("1.6.0"))
+	is.EqualError(err, "reader 1.6.0 is 1 minor version ahead of writer 1.5.0; policy allows at most 0 ahead")
+
+	err = p.Check(MustParse("1.5.0"), MustParse("2.5.0"))
+	is.EqualError(err, "reader 2.5.0 and writer 1.5.0 have different major versions; policy allows skew only in minor versions")
+	is.True(errors.As(err, &skew))
+	is.Equal("major", skew.Incompatible)
+}
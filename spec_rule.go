@@ -0,0 +1,95 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SpecRule is a numbered rule of the Semantic Versioning 2.0.0 specification, with the
+// sentence of the rule that a version violates.
+type SpecRule struct {
+	Number int
+	Text   string
+}
+
+// String returns the citation, such as "rule 9: Numeric identifiers MUST NOT include leading zeroes.".
+func (r SpecRule) String() string {
+	return fmt.Sprintf("rule %d: %s", r.Number, r.Text)
+}
+
+// Rules of the Semantic Versioning 2.0.0 specification cited by GrammarError.
+var (
+	ruleNormalForm        = SpecRule{2, "A normal version number MUST take the form X.Y.Z where X, Y, and Z are non-negative integers."}
+	ruleNormalLeadingZero = SpecRule{2, "X, Y, and Z MUST NOT contain leading zeroes."}
+	rulePrereleaseChars   = SpecRule{9, "Identifiers MUST comprise only ASCII alphanumerics and hyphens [0-9A-Za-z-]."}
+	rulePrereleaseEmpty   = SpecRule{9, "Identifiers MUST NOT be empty."}
+	rulePrereleaseZero    = SpecRule{9, "Numeric identifiers MUST NOT include leading zeroes."}
+	ruleBuildChars        = SpecRule{10, "Identifiers MUST comprise only ASCII alphanumerics and hyphens [0-9A-Za-z-]."}
+	ruleBuildEmpty        = SpecRule{10, "Identifiers MUST NOT be empty."}
+)
+
+// SpecRuleOf returns the rule of the Semantic Versioning 2.0.0 specification that err reports
+// a violation of.
+//
+// A *GrammarError, as returned by ValidateGrammar, always cites a rule. Errors returned by
+// Parse are cited when the sentinel error identifies the rule on its own; a leading zero, for
+// example, violates rule 2 in the version core but rule 9 in a pre-release, so Parse errors
+// for leading zeroes are not cited. Validate the input with ValidateGrammar to cite those.
+//
+// Example:
+//
+//	rule, _ := semver.SpecRuleOf(semver.ValidateGrammar("1.2.3-01"))
+//	fmt.Println(rule) // Output: rule 9: Numeric identifiers MUST NOT include leading zeroes.
+func SpecRuleOf(err error) (SpecRule, bool) {
+	var ge *GrammarError
+	if errors.As(err, &ge) {
+		return ge.Rule, true
+	}
+
+	switch {
+	case errors.Is(err, ErrMissingVersionElements):
+		return ruleNormalForm, true
+	case errors.Is(err, ErrEmptyPrereleaseIdentifier):
+		return rulePrereleaseEmpty, true
+	case errors.Is(err, ErrEmptyBuildMetadata):
+		return ruleBuildEmpty, true
+	case errors.Is(err, ErrInvalidBuildMetadataIdentifier):
+		return ruleBuildChars, true
+	}
+	return SpecRule{}, false
+}
+
+// specRule returns the rule violated by a grammar failure with the given path and error.
+func specRule(path []Production, err error) SpecRule {
+	inPrerelease, inBuild := false, false
+	for _, p := range path {
+		switch p {
+		case ProductionPrerelease:
+			inPrerelease = true
+		case ProductionBuild:
+			inBuild = true
+		}
+	}
+
+	switch {
+	case inPrerelease && errors.Is(err, ErrEmptyPrereleaseIdentifier):
+		return rulePrereleaseEmpty
+	case inPrerelease && errors.Is(err, ErrLeadingZeroInNumericIdentifier):
+		return rulePrereleaseZero
+	case inPrerelease:
+		return rulePrereleaseChars
+	case inBuild && errors.Is(err, ErrInvalidCharacterInIdentifier):
+		return ruleBuildChars
+	case inBuild:
+		return ruleBuildEmpty
+	case errors.Is(err, ErrLeadingZeroInNumericIdentifier):
+		return ruleNormalLeadingZero
+	default:
+		return ruleNormalForm
+	}
+}
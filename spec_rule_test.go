@@ -0,0 +1,74 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpecRuleOfGrammarError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input  string
+		number int
+		text   string
+	}{
+		{"", 2, "A normal version number MUST take the form X.Y.Z where X, Y, and Z are non-negative integers."},
+		{"1.2", 2, "A normal version number MUST take the form X.Y.Z where X, Y, and Z are non-negative integers."},
+		{"01.2.3", 2, "X, Y, and Z MUST NOT contain leading zeroes."},
+		{"1.2.3x", 2, "A normal version number MUST take the form X.Y.Z where X, Y, and Z are non-negative integers."},
+		{"1.2.3-", 9, "Identifiers MUST NOT be empty."},
+		{"1.2.3-01", 9, "Numeric identifiers MUST NOT include leading zeroes."},
+		{"1.2.3-a_b", 9, "Identifiers MUST comprise only ASCII alphanumerics and hyphens [0-9A-Za-z-]."},
+		{"1.2.3+", 10, "Identifiers MUST NOT be empty."},
+		{"1.2.3+a..b", 10, "Identifiers MUST NOT be empty."},
+		{"1.2.3+a~b", 10, "Identifiers MUST comprise only ASCII alphanumerics and hyphens [0-9A-Za-z-]."},
+	}
+
+	for _, tc := range tests {
+		rule, ok := SpecRuleOf(ValidateGrammar(tc.input))
+		is.True(ok, tc.input)
+		is.Equal(tc.number, rule.Number, tc.input)
+		is.Equal(tc.text, rule.Text, tc.input)
+	}
+
+	rule, _ := SpecRuleOf(ValidateGrammar("1.2.3-01"))
+	is.Equal("rule 9: Numeric identifiers MUST NOT include leading zeroes.", rule.String())
+
+	wrapped := fmt.Errorf("manifest line 3: %w", ValidateGrammar("1.2.3-01"))
+	rule, ok := SpecRuleOf(wrapped)
+	is.True(ok)
+	is.Equal(9, rule.Number)
+}
+
+func TestSpecRuleOfParseError(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := Parse("1.2")
+	rule, ok := SpecRuleOf(err)
+	is.True(ok)
+	is.Equal(2, rule.Number)
+
+	_, err = Parse("1.2.3+")
+	rule, ok = SpecRuleOf(err)
+	is.True(ok)
+	is.Equal(10, rule.Number)
+
+	_, ok = SpecRuleOf(ErrLeadingZeroInNumericIdentifier)
+	is.False(ok)
+
+	_, ok = SpecRuleOf(errors.New("unrelated"))
+	is.False(ok)
+	_, ok = SpecRuleOf(nil)
+	is.False(ok)
+}
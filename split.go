@@ -0,0 +1,120 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// defaultVersionSeparators are the field separators SplitVersions uses when none are given.
+var defaultVersionSeparators = []string{";", ",", "/"}
+
+// KeyedVersion is a version extracted from a composite string by SplitVersions, along with
+// the key it was labelled with. Key is empty for an unlabelled field.
+type KeyedVersion struct {
+	Key     string
+	Version Version
+}
+
+// KeyedVersions is the ordered result of SplitVersions.
+type KeyedVersions []KeyedVersion
+
+// Get returns the version of the first field with the given key.
+//
+// Example:
+//
+//	kv, _ := semver.SplitVersions("client=1.2.3;server=1.4.0")
+//	v, _ := kv.Get("server")
+//	fmt.Println(v) // Output: 1.4.0
+func (kv KeyedVersions) Get(key string) (Version, bool) {
+	for _, k := range kv {
+		if k.Key == key {
+			return k.Version, true
+		}
+	}
+	return Version{}, false
+}
+
+// Versions returns the versions of every field, in order.
+func (kv KeyedVersions) Versions() []Version {
+	versions := make([]Version, len(kv))
+	for i, k := range kv {
+		versions[i] = k.Version
+	}
+	return versions
+}
+
+// SplitVersions extracts the versions in a composite string such as "1.2.3/2.0.1" or
+// "client=1.2.3;server=1.4.0", as found in handshakes and compatibility headers.
+//
+// The string is split into fields at any of the given separators, or at ";", ",", and "/" if
+// none are given, and surrounding whitespace is trimmed from each field. A field of the form
+// "key=version" or "key:version" is labelled with its key. Empty fields are skipped, and each
+// version is parsed with ParseLenient, so "v1.2" is accepted as 1.2.0.
+//
+// Returns an error naming the first field whose version cannot be parsed.
+//
+// Example:
+//
+//	kv, err := semver.SplitVersions("client=1.2.3; server=v1.4")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, k := range kv {
+//	    fmt.Println(k.Key, k.Version)
+//	}
+//	// Output:
+//	// client 1.2.3
+//	// server 1.4.0
+func SplitVersions(s string, seps ...string) (KeyedVersions, error) {
+	if len(seps) == 0 {
+		seps = defaultVersionSeparators
+	}
+
+	var result KeyedVersions
+	for _, field := range splitFields(s, seps) {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		key, value := "", field
+		if i := strings.IndexAny(field, "=:"); i >= 0 {
+			key, value = strings.TrimSpace(field[:i]), field[i+1:]
+		}
+
+		v, _, err := ParseLenient(value)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", field, err)
+		}
+		result = append(result, KeyedVersion{Key: key, Version: v})
+	}
+	return result, nil
+}
+
+// splitFields splits s at every occurrence of any of the separators, preferring the longest
+// separator that matches at a position.
+func splitFields(s string, seps []string) []string {
+	var fields []string
+	start := 0
+	for i := 0; i < len(s); {
+		n := 0
+		for _, sep := range seps {
+			if len(sep) > n && strings.HasPrefix(s[i:], sep) {
+				n = len(sep)
+			}
+		}
+		if n == 0 {
+			i++
+			continue
+		}
+		fields = append(fields, s[start:i])
+		i += n
+		start = i
+	}
+	return append(fields, s[start:])
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitVersions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	kv, err := SplitVersions("1.2.3/2.0.1")
+	is.NoError(err)
+	is.Equal([]Version{MustParse("1.2.3"), MustParse("2.0.1")}, kv.Versions())
+	is.Equal("", kv[0].Key)
+
+	kv, err = SplitVersions("client=1.2.3;server=1.4.0")
+	is.NoError(err)
+	is.Len(kv, 2)
+	is.Equal("client", kv[0].Key)
+	v, ok := kv.Get("server")
+	is.True(ok)
+	is.Equal("1.4.0", v.String())
+	_, ok = kv.Get("proxy")
+	is.False(ok)
+
+	kv, err = SplitVersions(" client: v1.2 ;; server = 1.4.0-rc.1 ")
+	is.NoError(err)
+	is.Len(kv, 2)
+	is.Equal("1.2.0", kv[0].Version.String())
+	is.Equal("server", kv[1].Key)
+	is.Equal("1.4.0-rc.1", kv[1].Version.String())
+
+	kv, err = SplitVersions("1.2.3 2.0.1", " ")
+	is.NoError(err)
+	is.Len(kv, 2)
+
+	kv, err = SplitVersions("api=1.0.0 || db=2.1.0", "||")
+	is.NoError(err)
+	is.Len(kv, 2)
+	is.Equal("db", kv[1].Key)
+
+	kv, err = SplitVersions("")
+	is.NoError(err)
+	is.Empty(kv)
+
+	_, err = SplitVersions("client=1.2.3;server=banana")
+	is.ErrorContains(err, `field "server=banana"`)
+}
+
+func TestSplitFields(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal([]string{"a", "b", "c"}, splitFields("a||b|c", []string{"|", "||"}))
+	is.Equal([]string{"abc"}, splitFields("abc", []string{";"}))
+	is.Equal([]string{"", ""}, splitFields(";", []string{";"}))
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"math"
+	"slices"
+)
+
+// BucketCount is the number of versions that fall into a bucket, as returned by Version.Bucket.
+type BucketCount struct {
+	Bucket Version
+	Count  int
+}
+
+// Histogram counts the versions falling into each bucket at the given level, so that BumpMinor
+// counts versions per major version and BumpPatch counts them per minor version. Buckets are
+// returned in increasing order, and only buckets holding at least one version are included.
+//
+// Nil entries are ignored.
+//
+// Example:
+//
+//	a, b, c := semver.MustParse("1.2.0"), semver.MustParse("1.2.5"), semver.MustParse("2.0.0")
+//	for _, bc := range semver.Histogram([]*semver.Version{&a, &b, &c}, semver.BumpPatch) {
+//	    fmt.Println(bc.Bucket.Mask(semver.BumpPatch), bc.Count)
+//	}
+//	// Output:
+//	// 1.2.x 2
+//	// 2.0.x 1
+func Histogram(versions []*Version, level BumpLevel) []BucketCount {
+	// Buckets have only an epoch, major, and minor version, which key the index of their count.
+	index := make(map[[3]uint64]int)
+	var buckets []BucketCount
+	for _, v := range versions {
+		if v == nil {
+			continue
+		}
+		bucket := v.Bucket(level)
+		key := [3]uint64{bucket.Epoch, bucket.Major, bucket.Minor}
+		i, ok := index[key]
+		if !ok {
+			i = len(buckets)
+			index[key] = i
+			buckets = append(buckets, BucketCount{Bucket: bucket})
+		}
+		buckets[i].Count++
+	}
+
+	slices.SortFunc(buckets, func(a, b BucketCount) int { return a.Bucket.Compare(b.Bucket) })
+	return buckets
+}
+
+// VersionStats summarizes a list of versions, such as the versions reported by the installed
+// base of a product, for adoption dashboards.
+//
+// Majors and Minors count the versions per major and minor version, as returned by Histogram
+// for BumpMinor and BumpPatch. Oldest and Newest are the lowest and highest versions, and are
+// zero if the list is empty.
+type VersionStats struct {
+	Total       int
+	Prereleases int
+	Majors      []BucketCount
+	Minors      []BucketCount
+	Oldest      Version
+	Newest      Version
+
+	sorted []*Version
+}
+
+// Stats summarizes versions in one pass over a sorted copy. The versions are not modified.
+//
+// Nil entries are ignored.
+//
+// Example:
+//
+//	var versions []*semver.Version
+//	for _, s := range []string{"1.0.0", "1.1.0", "1.1.0", "2.0.0-rc.1"} {
+//	    v := semver.MustParse(s)
+//	    versions = append(versions, &v)
+//	}
+//	s := semver.Stats(versions)
+//	fmt.Println(s.Total, s.PrereleaseShare(), s.Newest, s.Percentile(50))
+//	// Output: 4 0.25 2.0.0-rc.1 1.1.0
+func Stats(versions []*Version) VersionStats {
+	sorted := make([]*Version, 0, len(versions))
+	for _, v := range versions {
+		if v != nil {
+			sorted = append(sorted, v)
+		}
+	}
+	Sort(sorted)
+
+	s := VersionStats{
+		Total:  len(sorted),
+		Majors: Histogram(sorted, BumpMinor),
+		Minors: Histogram(sorted, BumpPatch),
+		sorted: sorted,
+	}
+	for _, v := range sorted {
+		if len(v.PreRelease) > 0 {
+			s.Prereleases++
+		}
+	}
+	if len(sorted) > 0 {
+		s.Oldest, s.Newest = *sorted[0], *sorted[len(sorted)-1]
+	}
+	return s
+}
+
+// PrereleaseShare returns the fraction of the versions that are pre-releases, between 0 and 1,
+// or 0 if there are no versions.
+func (s VersionStats) PrereleaseShare() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.Prereleases) / float64(s.Total)
+}
+
+// Percentile returns the version at the given percentile, between 0 and 100, using the
+// nearest-rank method: the lowest version that is greater than or equal to p percent of the
+// versions. Percentile(50) is the median, and Percentile(0) is Oldest. p is clamped to the
+// range [0, 100], and the zero Version is returned if there are no versions.
+//
+// Example:
+//
+//	a, b, c := semver.MustParse("1.0.0"), semver.MustParse("1.1.0"), semver.MustParse("1.2.0")
+//	s := semver.Stats([]*semver.Version{&a, &b, &c})
+//	fmt.Println(s.Percentile(50), s.Percentile(90)) // Output: 1.1.0 1.2.0
+func (s VersionStats) Percentile(p float64) Version {
+	if len(s.sorted) == 0 {
+		return Version{}
+	}
+
+	p = math.Max(0, math.Min(100, p))
+	rank := int(math.Ceil(p / 100 * float64(len(s.sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return *s.sorted[rank-1]
+}
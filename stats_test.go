@@ -0,0 +1,79 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func statsVersions(ss ...string) []*Version {
+	versions := make([]*Version, len(ss))
+	for i, s := range ss {
+		v := MustParse(s)
+		versions[i] = &v
+	}
+	return versions
+}
+
+func TestHistogram(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	versions := statsVersions("2.1.0", "1.2.0", "1.2.5-rc.1", "1.0.0", "2.0.0")
+	versions = append(versions, nil)
+
+	majors := Histogram(versions, BumpMinor)
+	is.Len(majors, 2)
+	is.Equal("1.0.0", majors[0].Bucket.String())
+	is.Equal(3, majors[0].Count)
+	is.Equal("2.0.0", majors[1].Bucket.String())
+	is.Equal(2, majors[1].Count)
+
+	minors := Histogram(versions, BumpPatch)
+	var got []string
+	for _, bc := range minors {
+		got = append(got, bc.Bucket.Mask(BumpPatch))
+	}
+	is.Equal([]string{"1.0.x", "1.2.x", "2.0.x", "2.1.x"}, got)
+	is.Equal(2, minors[1].Count)
+
+	is.Empty(Histogram(nil, BumpMinor))
+}
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	versions := statsVersions("1.1.0", "2.0.0-rc.1", "1.0.0", "1.1.0")
+	original := versions[0]
+	s := Stats(append(versions, nil))
+
+	is.Equal(4, s.Total)
+	is.Equal(1, s.Prereleases)
+	is.InDelta(0.25, s.PrereleaseShare(), 1e-9)
+	is.Equal("1.0.0", s.Oldest.String())
+	is.Equal("2.0.0-rc.1", s.Newest.String())
+	is.Len(s.Majors, 2)
+	is.Len(s.Minors, 3)
+	is.Same(original, versions[0], "input must not be reordered")
+
+	is.Equal("1.0.0", s.Percentile(0).String())
+	is.Equal("1.0.0", s.Percentile(25).String())
+	is.Equal("1.1.0", s.Percentile(50).String())
+	is.Equal("1.1.0", s.Percentile(75).String())
+	is.Equal("2.0.0-rc.1", s.Percentile(76).String())
+	is.Equal("2.0.0-rc.1", s.Percentile(100).String())
+	is.Equal("2.0.0-rc.1", s.Percentile(150).String())
+	is.Equal("1.0.0", s.Percentile(-5).String())
+
+	empty := Stats(nil)
+	is.Equal(0, empty.Total)
+	is.Zero(empty.PrereleaseShare())
+	is.Equal(Version{}, empty.Percentile(50))
+	is.Equal(Version{}, empty.Newest)
+}
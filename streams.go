@@ -0,0 +1,79 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"math"
+)
+
+// SameMajor returns the range of versions from v up to, but excluding, the next major release
+// and its pre-releases, such as ">=1.4.2 <2.0.0-0" for 1.4.2.
+//
+// Example:
+//
+//	r := semver.SameMajor(semver.MustParse("1.4.2"))
+//	fmt.Println(r) // Output: >=1.4.2 <2.0.0-0
+func SameMajor(v Version) *VersionRange {
+	if v.Major == math.MaxUint64 {
+		return streamRange(v, nil)
+	}
+	return streamRange(v, &Version{Major: v.Major + 1})
+}
+
+// SameMinor returns the range of versions from v up to, but excluding, the next minor release
+// and its pre-releases, such as ">=1.4.2 <1.5.0-0" for 1.4.2.
+//
+// Example:
+//
+//	r := semver.SameMinor(semver.MustParse("1.4.2"))
+//	fmt.Println(r.Contains(semver.MustParse("1.4.9"))) // Output: true
+func SameMinor(v Version) *VersionRange {
+	if v.Minor == math.MaxUint64 {
+		return streamRange(v, nil)
+	}
+	return streamRange(v, &Version{Major: v.Major, Minor: v.Minor + 1})
+}
+
+// NextMajorRange returns the range covering the major release line after v, such as
+// ">=2.0.0 <3.0.0-0" for 1.4.2. Pre-releases of the next major version are not included.
+//
+// Example:
+//
+//	r := semver.NextMajorRange(semver.MustParse("1.4.2"))
+//	fmt.Println(r) // Output: >=2.0.0 <3.0.0-0
+func NextMajorRange(v Version) *VersionRange {
+	if v.Major == math.MaxUint64 {
+		return &VersionRange{Requirements: matchNone()}
+	}
+	return SameMajor(Version{Major: v.Major + 1, Epoch: v.Epoch})
+}
+
+// NextMinorRange returns the range covering the minor release line after v, such as
+// ">=1.5.0 <1.6.0-0" for 1.4.2. Pre-releases of the next minor version are not included.
+//
+// Example:
+//
+//	r := semver.NextMinorRange(semver.MustParse("1.4.2"))
+//	fmt.Println(r) // Output: >=1.5.0 <1.6.0-0
+func NextMinorRange(v Version) *VersionRange {
+	if v.Minor == math.MaxUint64 {
+		return &VersionRange{Requirements: matchNone()}
+	}
+	return SameMinor(Version{Major: v.Major, Minor: v.Minor + 1, Epoch: v.Epoch})
+}
+
+// streamRange returns the range from floor up to the first pre-release of ceiling, which
+// shares floor's epoch. The range has no upper limit if ceiling is nil.
+func streamRange(floor Version, ceiling *Version) *VersionRange {
+	floor.BuildMetadata = nil
+	reqs := []Requirement{{Op: OpGte, Ver: floor}}
+	if ceiling != nil {
+		upper := minimalPrerelease(ceiling.Major, ceiling.Minor, ceiling.Patch)
+		upper.Epoch = floor.Epoch
+		reqs = append(reqs, Requirement{Op: OpLt, Ver: upper})
+	}
+	return &VersionRange{Requirements: [][]Requirement{reqs}}
+}
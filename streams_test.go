@@ -0,0 +1,71 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameMajor(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := SameMajor(MustParse("1.4.2+build"))
+	is.Equal(">=1.4.2 <2.0.0-0", r.String())
+	is.True(r.Contains(MustParse("1.9.0")))
+	is.False(r.Contains(MustParse("1.4.1")))
+	is.False(r.Contains(MustParse("2.0.0-rc.1")))
+
+	r = SameMajor(MustParse("1.4.2-rc.1"))
+	is.True(r.Contains(MustParse("1.4.2-rc.1")))
+
+	r = SameMajor(Version{Major: math.MaxUint64})
+	is.Len(r.Requirements[0], 1)
+}
+
+func TestSameMinor(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := SameMinor(MustParse("1.4.2"))
+	is.Equal(">=1.4.2 <1.5.0-0", r.String())
+	is.True(r.Contains(MustParse("1.4.9")))
+	is.False(r.Contains(MustParse("1.5.0-beta")))
+	is.False(r.Contains(MustParse("1.5.0")))
+
+	r = SameMinor(Version{Epoch: 2, Major: 1, Minor: 4})
+	is.True(r.Contains(Version{Epoch: 2, Major: 1, Minor: 4, Patch: 7}))
+	is.False(r.Contains(MustParse("1.4.7")))
+}
+
+func TestNextMajorRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NextMajorRange(MustParse("1.4.2"))
+	is.Equal(">=2.0.0 <3.0.0-0", r.String())
+	is.False(r.Contains(MustParse("2.0.0-rc.1")))
+	is.True(r.Contains(MustParse("2.7.1")))
+
+	r = NextMajorRange(Version{Major: math.MaxUint64})
+	is.False(r.Contains(Max))
+}
+
+func TestNextMinorRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	r := NextMinorRange(MustParse("1.4.2"))
+	is.Equal(">=1.5.0 <1.6.0-0", r.String())
+	is.True(r.Contains(MustParse("1.5.3")))
+	is.False(r.Contains(MustParse("1.6.0")))
+
+	r = NextMinorRange(Version{Minor: math.MaxUint64})
+	is.False(r.Contains(Max))
+}
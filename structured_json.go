@@ -0,0 +1,87 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// StructuredVersion wraps a Version so that it marshals to JSON as an object with one field per
+// component, rather than as a string, for analytics pipelines and document stores that query
+// version components without parsing them.
+//
+// The object has the fields "major", "minor", and "patch", and the optional fields "prerelease"
+// and "build" holding the dot-separated identifiers. The "epoch" and "revision" fields are
+// included only when non-zero.
+//
+// Example:
+//
+//	data, _ := json.Marshal(semver.StructuredVersion{Version: semver.MustParse("1.2.3-alpha.1+sha.123")})
+//	fmt.Println(string(data))
+//	// Output: {"major":1,"minor":2,"patch":3,"prerelease":"alpha.1","build":"sha.123"}
+type StructuredVersion struct {
+	Version
+}
+
+// structuredVersionJSON is the JSON object form of a StructuredVersion.
+type structuredVersionJSON struct {
+	Epoch      uint64 `json:"epoch,omitempty"`
+	Major      uint64 `json:"major"`
+	Minor      uint64 `json:"minor"`
+	Patch      uint64 `json:"patch"`
+	Revision   uint64 `json:"revision,omitempty"`
+	Prerelease string `json:"prerelease,omitempty"`
+	Build      string `json:"build,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, encoding the version as a JSON object.
+func (s StructuredVersion) MarshalJSON() ([]byte, error) {
+	pre := make([]string, len(s.PreRelease))
+	for i, id := range s.PreRelease {
+		pre[i] = id.String()
+	}
+
+	return json.Marshal(structuredVersionJSON{
+		Epoch:      s.Epoch,
+		Major:      s.Major,
+		Minor:      s.Minor,
+		Patch:      s.Patch,
+		Revision:   s.Revision,
+		Prerelease: strings.Join(pre, "."),
+		Build:      strings.Join(s.BuildMetadata, "."),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a JSON object as written by MarshalJSON.
+// The pre-release and build metadata are validated as SetPrerelease and SetBuildMetadata do.
+// A JSON string is also accepted and parsed as Version.UnmarshalJSON does, so that documents
+// written in the string form can still be read.
+//
+// Example:
+//
+//	var s semver.StructuredVersion
+//	_ = json.Unmarshal([]byte(`{"major":1,"minor":2,"patch":3,"prerelease":"rc.1"}`), &s)
+//	fmt.Println(s.Version) // Output: 1.2.3-rc.1
+func (s *StructuredVersion) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '"' {
+		return s.Version.UnmarshalJSON(trimmed)
+	}
+
+	var raw structuredVersionJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	v, err := NewValidated(raw.Major, raw.Minor, raw.Patch, raw.Prerelease, raw.Build)
+	if err != nil {
+		return err
+	}
+	v.Epoch, v.Revision = raw.Epoch, raw.Revision
+	s.Version = v
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStructuredVersionJSON(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		version  Version
+		expected string
+	}{
+		{MustParse("1.2.3-alpha.1+sha.123"), `{"major":1,"minor":2,"patch":3,"prerelease":"alpha.1","build":"sha.123"}`},
+		{MustParse("0.1.0"), `{"major":0,"minor":1,"patch":0}`},
+		{Version{Epoch: 2, Major: 1, Revision: 4}, `{"epoch":2,"major":1,"minor":0,"patch":0,"revision":4}`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(StructuredVersion{Version: tt.version})
+		is.NoError(err)
+		is.JSONEq(tt.expected, string(data))
+
+		var s StructuredVersion
+		is.NoError(json.Unmarshal(data, &s))
+		is.True(tt.version.StrictEqual(s.Version), tt.expected)
+		is.Equal(tt.version.Epoch, s.Epoch)
+		is.Equal(tt.version.Revision, s.Revision)
+	}
+}
+
+func TestStructuredVersionUnmarshal(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var s StructuredVersion
+	is.NoError(json.Unmarshal([]byte(` "1.2.3-rc.1" `), &s))
+	is.Equal("1.2.3-rc.1", s.String())
+
+	var record struct {
+		Name    string            `json:"name"`
+		Version StructuredVersion `json:"version"`
+	}
+	is.NoError(json.Unmarshal([]byte(`{"name":"app","version":{"major":2,"minor":0,"patch":1}}`), &record))
+	is.Equal("2.0.1", record.Version.String())
+
+	is.Error(json.Unmarshal([]byte(`{"major":1,"minor":0,"patch":0,"prerelease":"rc..1"}`), &s))
+	is.Error(json.Unmarshal([]byte(`{"major":-1}`), &s))
+	is.Error(json.Unmarshal([]byte(`"1.2"`), &s))
+	is.Error(json.Unmarshal([]byte(`[1,2,3]`), &s))
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+)
+
+// SuggestionError is returned by parsers created with WithSuggestions when a version fails to
+// parse but ParseLenient can correct it. It carries the corrected form so that command-line
+// tools and APIs can tell users what they probably meant.
+//
+// SuggestionError unwraps to the original parse error, so errors.Is keeps working with the
+// sentinel errors of this package.
+type SuggestionError struct {
+	// Input is the version string that failed to parse.
+	Input string
+
+	// Suggestion is the corrected version string, which parses successfully.
+	Suggestion string
+
+	// Err is the original parse error.
+	Err error
+}
+
+// Error returns the parse error followed by the suggestion.
+//
+// Example:
+//
+//	parser, _ := semver.NewParser(semver.WithSuggestions(true))
+//	_, err := parser.Parse("v1.2")
+//	fmt.Println(err) // Output: invalid version "v1.2": invalid numeric identifier; did you mean "1.2.0"?
+func (e *SuggestionError) Error() string {
+	return fmt.Sprintf("invalid version %q: %v; did you mean %q?", e.Input, e.Err, e.Suggestion)
+}
+
+// Unwrap returns the original parse error.
+func (e *SuggestionError) Unwrap() error {
+	return e.Err
+}
+
+// WithSuggestions enables "did you mean" errors. When a version fails to parse, the parser
+// retries it with the corrections of ParseLenient, and if that succeeds, returns a
+// *SuggestionError holding the corrected version instead of the bare error.
+//
+// The input is still rejected; the suggestion only makes the error actionable. Suggestions
+// are computed on the error path only, so valid versions parse as fast as before.
+//
+// Parameters:
+// - value: A boolean indicating whether parse errors should carry suggestions.
+//
+// Returns:
+// - Option: A functional option that can be passed to a configuration function to modify behavior.
+//
+// Example usage:
+//
+//	parser, err := NewParser(WithSuggestions(true))
+//	if err != nil {
+//	    log.Fatalf("Failed to create parser: %v", err)
+//	}
+//
+//	_, err = parser.Parse("1.02.3")
+//	var se *SuggestionError
+//	if errors.As(err, &se) {
+//	    fmt.Println(se.Suggestion) // Output: 1.2.3
+//	}
+func WithSuggestions(value bool) Option {
+	return func(o *ConfigOptions) {
+		o.Suggest = value
+	}
+}
+
+// suggest returns err wrapped in a *SuggestionError if ParseLenient can correct version, and
+// err unchanged otherwise.
+func (p *parser) suggest(version string, err error) error {
+	// Correct the input with a parser that neither suggests nor reports, so that the
+	// corrected string is not itself corrected, traced, or observed.
+	config := *p.config
+	config.suggest = false
+	config.trace = nil
+	config.observer = nil
+	plain := &parser{config: &config}
+
+	v, _, lerr := parseLenient(version, plain.Parse)
+	if lerr != nil {
+		return err
+	}
+
+	suggestion := v.String()
+	if suggestion == version {
+		return err
+	}
+	return &SuggestionError{Input: version, Suggestion: suggestion, Err: err}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithSuggestions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithSuggestions(true))
+	is.NoError(err)
+
+	tests := []struct {
+		input      string
+		suggestion string
+		err        error
+	}{
+		{"1.2", "1.2.0", ErrMissingVersionElements},
+		{"v1.2.3", "1.2.3", ErrInvalidNumericIdentifier},
+		{"1.02.3", "1.2.3", ErrLeadingZeroInNumericIdentifier},
+		{" 1.2.3-rc.01 ", "1.2.3-rc.1", ErrInvalidNumericIdentifier},
+	}
+	for _, tt := range tests {
+		_, err := p.Parse(tt.input)
+		var se *SuggestionError
+		if is.True(errors.As(err, &se), tt.input) {
+			is.Equal(tt.input, se.Input)
+			is.Equal(tt.suggestion, se.Suggestion)
+		}
+		is.ErrorIs(err, tt.err, tt.input)
+	}
+
+	_, err = p.Parse("1.2")
+	is.EqualError(err, `invalid version "1.2": missing major, minor, or patch elements; did you mean "1.2.0"?`)
+
+	// Inputs the lenient parser cannot correct keep their original error.
+	_, err = p.Parse("1.2.3-a_b")
+	is.ErrorIs(err, ErrInvalidCharacterInIdentifier)
+	var se *SuggestionError
+	is.False(errors.As(err, &se))
+
+	v, err := p.Parse("1.2.3-rc.1")
+	is.NoError(err)
+	is.Equal("1.2.3-rc.1", v.String())
+}
+
+func TestWithSuggestionsDisabled(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := Parse("1.2")
+	is.Equal(ErrMissingVersionElements, err)
+}
+
+func TestWithSuggestionsTraceAndObserver(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var sb strings.Builder
+	o := &recordingObserver{}
+	p, err := NewParser(WithSuggestions(true), WithTrace(&sb), WithObserver(o))
+	is.NoError(err)
+
+	_, err = p.Parse("1.2")
+	var se *SuggestionError
+	is.True(errors.As(err, &se))
+
+	// The corrected string is parsed silently.
+	is.Equal(1, strings.Count(sb.String(), "parse "))
+	is.Len(o.parses, 1)
+	is.Equal("missing-elements", o.parses[0].Code)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TerraformDialect parses version constraints the way Terraform does (via
+// hashicorp/go-version), for tooling that pins providers and modules.
+//
+// Supported syntax:
+//   - Bare versions are exact: "1.2.0" is "= 1.2.0"
+//   - Comparisons: "=", "!=", ">", ">=", "<", "<="
+//   - Pessimistic constraints, which allow only the last given component to increase:
+//     "~> 1.2" is ">= 1.2.0, < 2.0.0" and "~> 1.2.3" is ">= 1.2.3, < 1.3.0"
+//   - AND with commas; there is no OR
+//   - An optional "v" prefix on every version
+//
+// Missing minor and patch components are treated as zero, so "> 1.2" matches 1.2.1, and
+// wildcards are not supported. Ranges use PrereleaseOptIn: a pre-release version only matches
+// a constraint whose own version carries a pre-release.
+//
+// Example:
+//
+//	r, err := semver.TerraformDialect.ParseRange("~> 5.0, != 5.1.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("5.31.0"))) // Output: true
+var TerraformDialect Dialect = terraformDialect{}
+
+type terraformDialect struct{}
+
+// terraformOperators lists the Terraform operators, longest first so that prefixes match greedily.
+var terraformOperators = []string{">=", "<=", "!=", "~>", ">", "<", "="}
+
+// Name returns the name of the dialect.
+func (terraformDialect) Name() string {
+	return "terraform"
+}
+
+// ParseVersion parses a Terraform version, tolerating a "v" prefix and coercing missing minor
+// and patch components to zero.
+func (terraformDialect) ParseVersion(v string) (Version, error) {
+	return parseCoercedVersion(strings.TrimSpace(v))
+}
+
+// FormatRange formats a range as a Terraform constraint, which can hold a single interval with
+// excluded versions only. A range that a pessimistic constraint matches exactly is written with
+// "~>".
+func (terraformDialect) FormatRange(r *VersionRange) (string, error) {
+	ivs := r.exactIntervals()
+	if len(ivs) == 0 {
+		return "", fmt.Errorf("%w: terraform cannot express a range that matches nothing", ErrUntranslatable)
+	}
+
+	// Intervals that meet at a single missing version are one interval with an exclusion.
+	iv := ivs[0]
+	var excluded []Version
+	for _, next := range ivs[1:] {
+		if iv.Upper.Inclusive || next.Lower.Inclusive || iv.Upper.Version.Compare(next.Lower.Version) != 0 {
+			return "", fmt.Errorf("%w: terraform cannot express a union of %d intervals", ErrUntranslatable, len(ivs))
+		}
+		excluded = append(excluded, iv.Upper.Version)
+		iv.Upper = next.Upper
+	}
+
+	var parts []string
+	if tilde, ok := shorthandRequirement(iv, OpTilde); ok {
+		parts = append(parts, "~> "+tilde.Ver.String())
+	} else if caret, ok := shorthandRequirement(iv, OpCaret); ok && caret.Ver.Major > 0 &&
+		caret.Ver.Patch == 0 && len(caret.Ver.PreRelease) == 0 {
+		// "~> 1.2" lets the minor version increase, as a caret on a major version does.
+		parts = append(parts, fmt.Sprintf("~> %d.%d", caret.Ver.Major, caret.Ver.Minor))
+	} else {
+		for _, req := range iv.requirements() {
+			req.Ver.BuildMetadata = nil
+			parts = append(parts, string(req.Op)+" "+req.Ver.String())
+		}
+	}
+	for _, v := range excluded {
+		v.BuildMetadata = nil
+		parts = append(parts, "!= "+v.String())
+	}
+	return strings.Join(parts, ", "), nil
+}
+
+// ParseRange parses a Terraform version constraint into a VersionRange.
+func (terraformDialect) ParseRange(r string) (*VersionRange, error) {
+	vr := &VersionRange{
+		Prerelease: PrereleaseOptIn,
+	}
+
+	var group [][]Requirement
+	for _, token := range strings.Split(r, ",") {
+		reqs, err := parseTerraformToken(strings.TrimSpace(token))
+		if err != nil {
+			return nil, err
+		}
+		group = andRequirements(group, reqs)
+	}
+
+	vr.Requirements = group
+	return vr, nil
+}
+
+// parseTerraformToken parses a single Terraform constraint.
+func parseTerraformToken(token string) ([][]Requirement, error) {
+	op, rest := splitOperator(token, terraformOperators)
+
+	core, _, _ := strings.Cut(rest, "-")
+	p, err := parsePartialVersion(rest)
+	if err != nil || p.parts == 0 || strings.ContainsAny(core, "*xX") {
+		return nil, invalidRangeToken(token)
+	}
+
+	if op == "~>" {
+		return expandComposerTilde(p), nil
+	}
+
+	// Like go-version, pad partial versions with zeros, so "1.2" is exactly 1.2.0.
+	p.parts = 3
+	if op == "" {
+		op = "="
+	}
+	return expandComparison(Operator(op), p), nil
+}
+
+// ParseTerraformRange parses a Terraform version constraint into a VersionRange.
+//
+// Example:
+//
+//	r, err := semver.ParseTerraformRange("~> 1.2.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(r.Contains(semver.MustParse("1.3.0"))) // Output: false
+func ParseTerraformRange(r string) (*VersionRange, error) {
+	return TerraformDialect.ParseRange(r)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerraformDialectContains(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rangeStr    string
+		version     string
+		shouldMatch bool
+	}{
+		{"1.2.0", "1.2.0", true},
+		{"1.2", "1.2.1", false},
+		{"= 1.2.3", "1.2.3", true},
+		{"~> 1.2", "1.9.0", true},
+		{"~> 1.2", "2.0.0", false},
+		{"~> 1.2.0", "1.2.9", true},
+		{"~> 1.2.0", "1.3.0", false},
+		{"~> 0.12", "0.15.5", true},
+		{"~> 1", "1.9.0", true},
+		{"> 1.2", "1.2.1", true},
+		{"> 1.2", "1.2.0", false},
+		{"!= 1.2", "1.2.0", false},
+		{"!= 1.2", "1.2.5", true},
+		{">= 1.2, < 2", "1.9.0", true},
+		{">= 1.2, < 2", "2.0.0", false},
+		{">=v1.2.0,<v1.5", "1.4.0", true},
+		{"~> 5.0, != 5.1.0", "5.1.0", false},
+		{"~> 5.0, != 5.1.0", "5.31.0", true},
+
+		// Pre-releases are only matched by constraints that opt in.
+		{">= 1.2.0", "1.3.0-beta.1", false},
+		{">= 1.3.0-beta.1", "1.3.0-beta.2", true},
+	}
+
+	for _, test := range tests {
+		r, err := TerraformDialect.ParseRange(test.rangeStr)
+		is.NoError(err, "Range %s should parse", test.rangeStr)
+		if err != nil {
+			continue
+		}
+		is.Equal(test.shouldMatch, r.Contains(MustParse(test.version)), "Version %s against range %s", test.version, test.rangeStr)
+	}
+}
+
+func TestTerraformDialectInvalid(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, input := range []string{"", "*", "1.x", "1.2.*", "~> 1.2 || 2.0", "^1.2", ">= 1.0 < 2.0", "1.0,"} {
+		_, err := ParseTerraformRange(input)
+		is.ErrorIs(err, ErrInvalidRangeToken, "Expected error for input: %q", input)
+	}
+	is.Equal("terraform", TerraformDialect.Name())
+
+	v, err := TerraformDialect.(VersionDialect).ParseVersion("v1.2")
+	is.NoError(err)
+	is.Equal("1.2.0", v.String())
+}
+
+func TestTerraformDialectFormatRange(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rng      string
+		expected string
+	}{
+		{"~1.2.3", "~> 1.2.3"},
+		{"^0.2.0", "~> 0.2.0"},
+		{"^1.2.0", "~> 1.2"},
+		{"^1.2.3", ">= 1.2.3, < 2.0.0-0"},
+		{"=1.2.3+build.1", "= 1.2.3"},
+		{">=1.2.0 <1.5.0", ">= 1.2.0, < 1.5.0"},
+		{">=1.0.0 <2.0.0 !=1.5.0 !=1.6.0", ">= 1.0.0, < 2.0.0, != 1.5.0, != 1.6.0"},
+		{"^5.0.0 !=5.1.0", "~> 5.0, != 5.1.0"},
+	}
+
+	for _, tt := range tests {
+		r := MustParseRange(tt.rng)
+		r.Prerelease = PrereleaseOptIn
+		s, err := Translate(r, TerraformDialect)
+		is.NoError(err, tt.rng)
+		is.Equal(tt.expected, s, tt.rng)
+	}
+
+	s, err := Translate(MustParseRange("<1.0.0 || >=2.0.0"), TerraformDialect)
+	is.ErrorIs(err, ErrUntranslatable)
+	is.Empty(s)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"slices"
+	"time"
+)
+
+// VersionEntry is a version together with the time it was released, as listed by a package
+// registry. A zero Released time is treated as earlier than any known time.
+type VersionEntry struct {
+	Version  Version
+	Released time.Time
+}
+
+// OrderPriority selects which key a composite comparator considers first.
+//
+// Supported Priorities:
+//   - DateFirst: Order by release time, breaking ties by version precedence.
+//   - VersionFirst: Order by version precedence, breaking ties by release time.
+type OrderPriority int
+
+const (
+	DateFirst OrderPriority = iota
+	VersionFirst
+)
+
+// String returns the string representation of the OrderPriority.
+func (p OrderPriority) String() string {
+	switch p {
+	case DateFirst:
+		return "date"
+	case VersionFirst:
+		return "version"
+	default:
+		return "unknown"
+	}
+}
+
+// CompareEntries returns a comparator, as described by Compare, that orders entries by release
+// time and version precedence in the given priority.
+//
+// Ordering by date first reflects the order in which a registry actually published versions,
+// which differs from precedence when older release lines receive patches or versions are
+// republished. Ordering by version first keeps precedence, and only orders entries of equal
+// precedence, such as versions differing in build metadata, by release time.
+//
+// Example:
+//
+//	slices.SortFunc(entries, semver.CompareEntries(semver.VersionFirst))
+func CompareEntries(priority OrderPriority) func(a, b VersionEntry) int {
+	if priority == VersionFirst {
+		return func(a, b VersionEntry) int {
+			if c := a.Version.Compare(b.Version); c != 0 {
+				return c
+			}
+			return a.Released.Compare(b.Released)
+		}
+	}
+	return CompareByDateThenVersion
+}
+
+// CompareByDateThenVersion orders entries by release time, breaking ties by version precedence.
+// It has the signature of cmp.Compare, so it can be passed directly to slices.SortFunc.
+//
+// Example:
+//
+//	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+//	a := semver.VersionEntry{Version: semver.MustParse("2.0.0"), Released: day}
+//	b := semver.VersionEntry{Version: semver.MustParse("1.9.1"), Released: day.AddDate(0, 0, 1)}
+//	fmt.Println(semver.CompareByDateThenVersion(a, b)) // Output: -1
+func CompareByDateThenVersion(a, b VersionEntry) int {
+	if c := a.Released.Compare(b.Released); c != 0 {
+		return c
+	}
+	return a.Version.Compare(b.Version)
+}
+
+// SortByDateThenVersion sorts entries in place by release time, oldest first, breaking ties by
+// version precedence. The sort is stable, so entries equal in both keys keep their order.
+//
+// Example:
+//
+//	semver.SortByDateThenVersion(entries)
+//	latest := entries[len(entries)-1] // The most recently published version.
+func SortByDateThenVersion(entries []VersionEntry) {
+	slices.SortStableFunc(entries, CompareByDateThenVersion)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testEntries() []VersionEntry {
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []VersionEntry{
+		{Version: MustParse("2.0.0"), Released: day.AddDate(0, 0, 1)},
+		{Version: MustParse("1.9.1"), Released: day.AddDate(0, 0, 2)},
+		{Version: MustParse("1.9.0"), Released: day},
+		{Version: MustParse("1.9.1+republish"), Released: day.AddDate(0, 0, 3)},
+		{Version: MustParse("2.0.0-rc.1"), Released: day},
+		{Version: MustParse("0.1.0")},
+	}
+}
+
+func entryStrings(entries []VersionEntry) []string {
+	s := make([]string, len(entries))
+	for i, e := range entries {
+		s[i] = e.Version.String()
+	}
+	return s
+}
+
+func TestSortByDateThenVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	entries := testEntries()
+	SortByDateThenVersion(entries)
+	is.Equal([]string{"0.1.0", "1.9.0", "2.0.0-rc.1", "2.0.0", "1.9.1", "1.9.1+republish"}, entryStrings(entries))
+}
+
+func TestCompareEntries(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	entries := testEntries()
+	slices.SortStableFunc(entries, CompareEntries(VersionFirst))
+	is.Equal([]string{"0.1.0", "1.9.0", "1.9.1", "1.9.1+republish", "2.0.0-rc.1", "2.0.0"}, entryStrings(entries))
+
+	entries = testEntries()
+	slices.SortStableFunc(entries, CompareEntries(DateFirst))
+	is.Equal([]string{"0.1.0", "1.9.0", "2.0.0-rc.1", "2.0.0", "1.9.1", "1.9.1+republish"}, entryStrings(entries))
+
+	is.Equal("date", DateFirst.String())
+	is.Equal("version", VersionFirst.String())
+	is.Equal("unknown", OrderPriority(9).String())
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"io"
+)
+
+// WithTrace sets a writer that receives a line for each step the parser takes: the input, the
+// bounds of every numeric segment, the classification of every pre-release and build metadata
+// identifier, and the final outcome. It helps to debug why an exotic version string is rejected.
+//
+// Tracing is meant for debugging; it slows parsing down and disables the fast path for plain
+// "major.minor.patch" versions. Errors writing to w are ignored.
+//
+// When the parser is installed as DefaultParser, ParseLenient also traces the corrected string
+// it hands to the parser.
+//
+// Parameters:
+// - w: The writer to trace to, or nil to disable tracing.
+//
+// Returns:
+// - Option: A functional option that can be passed to a configuration function to modify behavior.
+//
+// Example usage:
+//
+//	parser, err := NewParser(WithTrace(os.Stderr))
+//	if err != nil {
+//	    log.Fatalf("Failed to create parser: %v", err)
+//	}
+//
+//	_, err = parser.Parse("1.2.3-rc.01")
+//	// parse "1.2.3-rc.01"
+//	//   major: "1" at [0,1)
+//	//   minor: "2" at [2,3)
+//	//   patch: "3" at [4,5)
+//	//   pre-release: "rc.01" at [6,11)
+//	//   pre-release identifier 0: "rc" alphanumeric
+//	//   pre-release identifier 1: "01" invalid
+//	// reject: invalid pre-release identifier
+func WithTrace(w io.Writer) Option {
+	return func(o *ConfigOptions) {
+		o.Trace = w
+	}
+}
+
+// traceParse parses version as Parse does, tracing every step to the parser's trace writer.
+func (p *parser) traceParse(version string) (Version, error) {
+	p.tracef("parse %q\n", version)
+	v, err := p.parseFull(version, nil, nil)
+	if err != nil {
+		p.tracef("reject: %v\n", err)
+		return Version{}, err
+	}
+	p.tracef("accept: %s\n", v)
+	return v, nil
+}
+
+// tracef writes a formatted line to the parser's trace writer, if it has one.
+func (p *parser) tracef(format string, args ...any) {
+	if p.config.trace != nil {
+		_, _ = fmt.Fprintf(p.config.trace, format, args...)
+	}
+}
+
+// traceSegment traces the bounds of a segment of version. It takes typed arguments so that
+// untraced parsing does not allocate.
+func (p *parser) traceSegment(name, version string, start, end int) {
+	if p.config.trace != nil {
+		p.tracef("  %s: %q at [%d,%d)\n", name, version[start:end], start, end)
+	}
+}
+
+// traceIdentifier traces the classification of the i-th identifier of a pre-release or build
+// metadata section.
+func (p *parser) traceIdentifier(section string, i int, part, class string) {
+	if p.config.trace != nil {
+		p.tracef("  %s identifier %d: %q %s\n", section, i, part, class)
+	}
+}
+
+// identifierClass classifies an identifier that has been accepted for tracing.
+func identifierClass(part string) string {
+	if isNumeric(part) {
+		return "numeric"
+	}
+	return "alphanumeric"
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTrace(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var sb strings.Builder
+	p, err := NewParser(WithTrace(&sb))
+	is.NoError(err)
+
+	_, err = p.Parse("1.2.3-rc.01")
+	is.ErrorIs(err, ErrInvalidPrereleaseIdentifier)
+	is.Equal(`parse "1.2.3-rc.01"
+  major: "1" at [0,1)
+  minor: "2" at [2,3)
+  patch: "3" at [4,5)
+  pre-release: "rc.01" at [6,11)
+  pre-release identifier 0: "rc" alphanumeric
+  pre-release identifier 1: "01" invalid
+reject: invalid pre-release identifier
+`, sb.String())
+
+	sb.Reset()
+	v, err := p.Parse("2.0.0-beta.2+exp.sha")
+	is.NoError(err)
+	is.Equal("2.0.0-beta.2+exp.sha", v.String())
+	is.Equal(`parse "2.0.0-beta.2+exp.sha"
+  major: "2" at [0,1)
+  minor: "0" at [2,3)
+  patch: "0" at [4,5)
+  pre-release: "beta.2" at [6,12)
+  pre-release identifier 0: "beta" alphanumeric
+  pre-release identifier 1: "2" numeric
+  build metadata: "exp.sha" at [13,20)
+  build metadata identifier 0: "exp" alphanumeric
+  build metadata identifier 1: "sha" alphanumeric
+accept: 2.0.0-beta.2+exp.sha
+`, sb.String())
+
+	// Plain versions are traced even though they would normally take the fast path.
+	sb.Reset()
+	_, err = p.Parse("1.2.3")
+	is.NoError(err)
+	is.Contains(sb.String(), `patch: "3" at [4,5)`)
+	is.True(strings.HasSuffix(sb.String(), "accept: 1.2.3\n"))
+}
+
+func TestWithTraceEpochAndRevision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var sb strings.Builder
+	p, err := NewParser(WithTrace(&sb), WithEpoch(true), WithRevision(true))
+	is.NoError(err)
+
+	_, err = p.Parse("2!1.2.3.4")
+	is.NoError(err)
+	is.Contains(sb.String(), `  epoch: "2" at [0,1)`)
+	is.Contains(sb.String(), `  revision: "4" at [8,9)`)
+}
+
+// TestParseLenientTrace is not parallel because it replaces DefaultParser.
+func TestParseLenientTrace(t *testing.T) {
+	is := assert.New(t)
+
+	var sb strings.Builder
+	saved := DefaultParser
+	defer func() { DefaultParser = saved }()
+
+	var err error
+	DefaultParser, err = NewParser(WithTrace(&sb))
+	is.NoError(err)
+
+	_, _, err = ParseLenient(" v1.2-rc.01 ")
+	is.NoError(err)
+	is.True(strings.HasPrefix(sb.String(), `parse "1.2.0-rc.1"`))
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrUntranslatable indicates that a range cannot be expressed in a dialect's syntax, such as
+	// a union of intervals in NuGet's single-interval syntax.
+	ErrUntranslatable = errors.New("range cannot be expressed in dialect")
+
+	// ErrLossyTranslation indicates that a range was expressed in a dialect's syntax, but the
+	// dialect does not match exactly the same versions, such as when it treats pre-releases
+	// differently.
+	ErrLossyTranslation = errors.New("lossy range translation")
+)
+
+// RangeFormatter is a Dialect that can also write ranges in its ecosystem's syntax. The built-in
+// dialects implement it: NpmDialect and HelmDialect write the comparison syntax they share,
+// ComposerDialect the Composer constraint syntax, CargoDialect and TerraformDialect their
+// comma-separated requirements, and GradleDialect and NuGetDialect the bracketed interval
+// syntax shared with Maven.
+type RangeFormatter interface {
+	Dialect
+
+	// FormatRange formats a range in the dialect's syntax, as closely as the syntax allows.
+	FormatRange(r *VersionRange) (string, error)
+}
+
+// Translate formats a range in the syntax of another ecosystem's Dialect, for release tooling
+// that publishes constraints to several package managers.
+//
+// The result is parsed back with the dialect to check that it matches the same versions. If it
+// does not, such as when the dialect matches pre-releases under a different PrereleasePolicy,
+// Translate returns the result together with an error wrapping ErrLossyTranslation that
+// describes the difference, so callers can decide whether the approximation is acceptable.
+// A different PrereleasePolicy alone is not a loss: "=1.0.0" matches no pre-release under any
+// policy.
+//
+// Returns an error wrapping ErrUntranslatable, and an empty string, if the dialect does not
+// implement RangeFormatter or its syntax cannot express the range at all.
+//
+// Example:
+//
+//	s, err := semver.Translate(semver.MustParseRange(">=1.2.0 <2.0.0"), semver.GradleDialect)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(s) // Output: [1.2.0,2.0.0)
+func Translate(r *VersionRange, to Dialect) (string, error) {
+	f, ok := to.(RangeFormatter)
+	if !ok {
+		return "", fmt.Errorf("%w: %s cannot format ranges", ErrUntranslatable, to.Name())
+	}
+
+	s, err := f.FormatRange(r)
+	if err != nil {
+		return "", err
+	}
+
+	back, err := to.ParseRange(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s cannot parse %q: %w", ErrUntranslatable, to.Name(), s, err)
+	}
+	if !sameIntervals(admittedReleases(r), admittedReleases(back)) {
+		return s, fmt.Errorf("%w: %s reads %q as %s", ErrLossyTranslation, to.Name(), s, back)
+	}
+	if !sameIntervals(admittedPrereleases(r), admittedPrereleases(back)) {
+		if back.Prerelease != r.Prerelease {
+			return s, fmt.Errorf("%w: %s matches pre-releases with policy %s, not %s", ErrLossyTranslation, to.Name(), back.Prerelease, r.Prerelease)
+		}
+		return s, fmt.Errorf("%w: %s reads %q as %s", ErrLossyTranslation, to.Name(), s, back)
+	}
+	return s, nil
+}
+
+// admittedReleases returns the intervals of release versions that a range matches. Bounds on
+// pre-releases are moved to the release they precede, so that intervals covering the same
+// releases compare equal.
+func admittedReleases(r *VersionRange) []Interval {
+	var ivs []Interval
+	for _, iv := range r.exactIntervals() {
+		if !iv.Lower.Unbounded && len(iv.Lower.Version.PreRelease) > 0 {
+			iv.Lower = Bound{Version: iv.Lower.Version.Promote(), Inclusive: true}
+		}
+		if !iv.Upper.Unbounded && len(iv.Upper.Version.PreRelease) > 0 {
+			iv.Upper = Bound{Version: iv.Upper.Version.Promote()}
+		}
+		if !iv.IsEmpty() {
+			ivs = append(ivs, iv)
+		}
+	}
+	return mergeIntervals(ivs)
+}
+
+// admittedPrereleases returns the intervals of pre-release versions that a range matches under
+// its PrereleasePolicy. Bounds on releases are made inclusive, which does not change the
+// pre-releases they admit, so that intervals covering the same pre-releases compare equal.
+func admittedPrereleases(r *VersionRange) []Interval {
+	var ivs []Interval
+	switch r.Prerelease {
+	case PrereleaseExcluded:
+		return nil
+	case PrereleaseOptIn:
+		optedIn := &VersionRange{}
+		for _, andReqs := range r.Requirements {
+			if prereleaseOptedIn(andReqs) {
+				optedIn.Requirements = append(optedIn.Requirements, andReqs)
+			}
+		}
+		ivs = optedIn.exactIntervals()
+	default:
+		ivs = r.exactIntervals()
+	}
+
+	admitted := ivs[:0]
+	for _, iv := range ivs {
+		if !iv.Lower.Unbounded && len(iv.Lower.Version.PreRelease) == 0 {
+			iv.Lower.Inclusive = true
+		}
+		if !iv.Upper.Unbounded && len(iv.Upper.Version.PreRelease) == 0 {
+			iv.Upper.Inclusive = true
+		}
+		// A single release admits no pre-release.
+		if !iv.Lower.Unbounded && !iv.Upper.Unbounded && len(iv.Lower.Version.PreRelease) == 0 &&
+			iv.Lower.Version.Compare(iv.Upper.Version) == 0 {
+			continue
+		}
+		admitted = append(admitted, iv)
+	}
+	return mergeIntervals(admitted)
+}
+
+// formatComparisons formats a range as OR-separated groups of space-separated comparisons, the
+// syntax shared by npm, Helm, and Composer, writing each requirement with format. Build metadata
+// is dropped.
+func formatComparisons(r *VersionRange, format func(Requirement) string) string {
+	ivs := r.exactIntervals()
+	if len(ivs) == 0 {
+		return format(Requirement{Op: OpLt, Ver: minimalPrerelease(0, 0, 0)})
+	}
+
+	branches := make([]string, len(ivs))
+	for i, iv := range ivs {
+		reqs := iv.requirements()
+		parts := make([]string, len(reqs))
+		for j, req := range reqs {
+			req.Ver.BuildMetadata = nil
+			parts[j] = format(req)
+		}
+		branches[i] = strings.Join(parts, " ")
+	}
+	return strings.Join(branches, " || ")
+}
+
+// singleInterval returns the only interval of a range, for dialects whose syntax cannot express
+// a union of intervals or a range that matches nothing.
+func singleInterval(r *VersionRange, dialect string) (Interval, error) {
+	ivs := r.exactIntervals()
+	switch {
+	case len(ivs) == 0:
+		return Interval{}, fmt.Errorf("%w: %s cannot express a range that matches nothing", ErrUntranslatable, dialect)
+	case len(ivs) > 1:
+		return Interval{}, fmt.Errorf("%w: %s cannot express a union of %d intervals", ErrUntranslatable, dialect, len(ivs))
+	}
+	return ivs[0], nil
+}
+
+// shorthandRequirement returns the caret or tilde requirement that matches exactly the versions
+// in the interval, if there is one. Build metadata is dropped.
+func shorthandRequirement(iv Interval, op Operator) (Requirement, bool) {
+	if iv.Lower.Unbounded || !iv.Lower.Inclusive || iv.Upper.Unbounded || iv.Upper.Inclusive {
+		return Requirement{}, false
+	}
+
+	req := Requirement{Op: op, Ver: iv.Lower.Version}
+	req.Ver.BuildMetadata = nil
+	if _, upper := req.shorthandBounds(); upper.Compare(iv.Upper.Version) != 0 {
+		return Requirement{}, false
+	}
+	return req, true
+}
+
+// formatBracketInterval formats a range as a single bracketed interval, such as "[1.0.0,2.0.0)",
+// or as all if it matches every version, writing each bound with format. Build metadata is
+// dropped.
+func formatBracketInterval(r *VersionRange, dialect, all string, format func(Version) string) (string, error) {
+	iv, err := singleInterval(r, dialect)
+	if err != nil {
+		return "", err
+	}
+
+	lower, upper := iv.Lower.Version, iv.Upper.Version
+	lower.BuildMetadata, upper.BuildMetadata = nil, nil
+	switch {
+	case iv.Lower.Unbounded && iv.Upper.Unbounded:
+		return all, nil
+	case !iv.Lower.Unbounded && !iv.Upper.Unbounded && iv.Lower.Inclusive && iv.Upper.Inclusive && lower.Compare(upper) == 0:
+		return "[" + format(lower) + "]", nil
+	}
+
+	var sb strings.Builder
+	switch {
+	case iv.Lower.Unbounded:
+		sb.WriteString("(")
+	case iv.Lower.Inclusive:
+		sb.WriteString("[" + format(lower))
+	default:
+		sb.WriteString("(" + format(lower))
+	}
+	sb.WriteByte(',')
+	switch {
+	case iv.Upper.Unbounded:
+		sb.WriteString(")")
+	case iv.Upper.Inclusive:
+		sb.WriteString(format(upper) + "]")
+	default:
+		sb.WriteString(format(upper) + ")")
+	}
+	return sb.String(), nil
+}
+
+// sameIntervals reports whether two lists of sorted, disjoint intervals cover the same versions.
+func sameIntervals(a, b []Interval) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if compareLower(a[i].Lower, b[i].Lower) != 0 || compareUpper(a[i].Upper, b[i].Upper) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranslate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		rng      string
+		policy   PrereleasePolicy
+		to       Dialect
+		expected string
+	}{
+		{">=1.2.0 <2.0.0", PrereleaseInclusive, GradleDialect, "[1.2.0,2.0.0)"},
+		{"=1.0.0+build.1", PrereleaseInclusive, GradleDialect, "[1.0.0]"},
+		{">1.0.0 <=2.0.0", PrereleaseInclusive, GradleDialect, "(1.0.0,2.0.0]"},
+		{"<2.0.0", PrereleaseInclusive, GradleDialect, "(,2.0.0)"},
+		{">=0.0.0-0", PrereleaseInclusive, GradleDialect, "+"},
+		{">=1.0.0-rc.1 <=2.0.0", PrereleaseInclusive, NuGetDialect, "[1.0.0-rc.1,2.0.0]"},
+		{">=1.2.0 <2.0.0", PrereleaseExcluded, NuGetDialect, "[1.2.0,2.0.0)"},
+		{">1.0.0", PrereleaseExcluded, NuGetDialect, "(1.0.0,)"},
+		{"<1.0.0 || >=2.0.0 <3.0.0", PrereleaseOptIn, HelmDialect, "<1.0.0 || >=2.0.0 <3.0.0"},
+		{">=1.0.0 !=1.5.0", PrereleaseOptIn, HelmDialect, ">=1.0.0 <1.5.0 || >1.5.0"},
+		{">=1.0.0-rc.1 <=2.0.0", PrereleaseInclusive, ComposerDialect, ">=1.0.0-rc.1 <=2.0.0"},
+		{"^1.2.3", PrereleaseExcluded, ComposerDialect, ">=1.2.3 <2.0.0"},
+		{">1.0.0-0 <=2.0.0-0", PrereleaseInclusive, ComposerDialect, ">1.0.0-dev <=2.0.0-dev"},
+		{"^1.2.3 || ~3.1.0", PrereleaseOptIn, NpmDialect, ">=1.2.3 <2.0.0-0 || >=3.1.0 <3.2.0-0"},
+		{"=1.2.3", PrereleaseInclusive, HelmDialect, "=1.2.3"},
+		{"=1.2.3", PrereleaseInclusive, NpmDialect, "=1.2.3"},
+		{"=1.2.3", PrereleaseInclusive, ComposerDialect, "=1.2.3"},
+		{"=1.2.3", PrereleaseInclusive, NuGetDialect, "[1.2.3]"},
+		{"=1.2.3", PrereleaseInclusive, TerraformDialect, "= 1.2.3"},
+		{">=1.0.0 <1.5.0 || >1.5.0 <=2.0.0", PrereleaseExcluded, HelmDialect, ">=1.0.0 <1.5.0 || >1.5.0 <=2.0.0"},
+	}
+
+	for _, tt := range tests {
+		r := MustParseRange(tt.rng)
+		r.Prerelease = tt.policy
+		s, err := Translate(r, tt.to)
+		is.NoError(err, tt.rng)
+		is.Equal(tt.expected, s, tt.rng)
+	}
+}
+
+func TestTranslateLossy(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	// Helm only matches pre-releases that a comparator opts into.
+	s, err := Translate(MustParseRange(">=1.2.0 <2.0.0"), HelmDialect)
+	is.ErrorIs(err, ErrLossyTranslation)
+	is.Contains(err.Error(), "opt-in")
+	is.Equal(">=1.2.0 <2.0.0", s)
+
+	s, err = Translate(MustParseRange("^1.0.0"), NuGetDialect)
+	is.ErrorIs(err, ErrLossyTranslation)
+	is.Equal("[1.0.0,2.0.0-0)", s)
+
+	r := MustParseRange(">=1.2.0 <2.0.0")
+	r.Prerelease = PrereleaseExcluded
+	s, err = Translate(r, GradleDialect)
+	is.ErrorIs(err, ErrLossyTranslation)
+	is.Equal("[1.2.0,2.0.0)", s)
+
+	// Gradle orders 2.0.0-rc.1 below 2.0.0, so a plain upper bound admits it.
+	s, err = Translate(MustParseRange("^1.2.3"), GradleDialect)
+	is.ErrorIs(err, ErrLossyTranslation)
+	is.Equal("[1.2.3,2.0.0)", s)
+
+	// Composer only matches pre-releases with a stability flag.
+	s, err = Translate(MustParseRange("^1.2.3"), ComposerDialect)
+	is.ErrorIs(err, ErrLossyTranslation)
+	is.Contains(err.Error(), "excluded")
+	is.Equal(">=1.2.3 <2.0.0", s)
+}
+
+func TestTranslateUntranslatable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	for _, rng := range []string{"<1.0.0 || >=2.0.0", ">=1.0.0 !=1.5.0", ">=2.0.0 <1.0.0"} {
+		for _, d := range []Dialect{GradleDialect, NuGetDialect, CargoDialect} {
+			s, err := Translate(MustParseRange(rng), d)
+			is.ErrorIs(err, ErrUntranslatable, rng)
+			is.Empty(s)
+		}
+	}
+
+	_, err := Translate(MustParseRange(">=1.0.0"), testDialect{})
+	is.ErrorIs(err, ErrUntranslatable)
+}
+
+func TestPrereleasePolicyString(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("inclusive", PrereleaseInclusive.String())
+	is.Equal("excluded", PrereleaseExcluded.String())
+	is.Equal("opt-in", PrereleaseOptIn.String())
+	is.Equal("unknown", PrereleasePolicy(99).String())
+}
+
+// testDialect is a Dialect that does not implement RangeFormatter.
+type testDialect struct{}
+
+func (testDialect) Name() string { return "test" }
+
+func (testDialect) ParseRange(r string) (*VersionRange, error) { return ParseRange(r) }
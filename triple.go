@@ -0,0 +1,55 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// maxTripleDigits is the largest number of digits in a component that parseTriple accepts.
+// Every 19-digit number fits in a uint64, so the fast path never overflows.
+const maxTripleDigits = 19
+
+// parseTriple parses s into v if it is a plain "major.minor.patch" version without pre-release
+// identifiers or build metadata, such as "1.22.333".
+//
+// It makes a single pass over s, with one range check per digit, and never allocates. It returns
+// false, leaving v partially written, if s is not a valid plain triple or if a component has more
+// than maxTripleDigits digits; the general parser must then be used instead.
+func parseTriple(s string, v *Version) bool {
+	n := len(s)
+	i := 0
+
+	var parts [3]uint64
+	for c := range parts {
+		start := i
+		var x uint64
+		for i < n {
+			d := s[i] - '0'
+			if d > 9 {
+				break
+			}
+			x = x*10 + uint64(d)
+			i++
+		}
+
+		// Components must be non-empty and may only start with zero if they are exactly "0".
+		digits := i - start
+		if digits == 0 || digits > maxTripleDigits || (digits > 1 && s[start] == '0') {
+			return false
+		}
+		parts[c] = x
+
+		if c < 2 {
+			if i >= n || s[i] != '.' {
+				return false
+			}
+			i++
+		}
+	}
+
+	if i != n {
+		return false
+	}
+	v.Major, v.Minor, v.Patch = parts[0], parts[1], parts[2]
+	return true
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTriple(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p := DefaultParser.(*parser)
+
+	valid := []string{"0.0.0", "1.2.3", "10.20.30", "1.0.100", "9999999999999999999.0.0"}
+	for _, s := range valid {
+		var v Version
+		is.True(parseTriple(s, &v), "Expected fast path for %q", s)
+		expected, err := p.parseFull(s, nil, nil)
+		is.NoError(err)
+		is.Equal(expected, v, "Fast path must match the general parser for %q", s)
+	}
+
+	fallback := []string{
+		"", "1", "1.2", "1.2.3.4", "01.2.3", "1.02.3", "1.2.03", "00.0.0",
+		"1..3", ".1.2", "1.2.", "1.2.3-alpha", "1.2.3+build", "v1.2.3", "1.2.3 ",
+		"18446744073709551616.0.0", "1.a.3",
+	}
+	for _, s := range fallback {
+		var v Version
+		is.False(parseTriple(s, &v), "Expected fallback for %q", s)
+	}
+}
+
+func TestParseTripleMatchesParser(t *testing.T) {
+	is := assert.New(t)
+
+	p := DefaultParser.(*parser)
+	inputs := []string{"0.0.0", "1.2.3", "01.2.3", "1.2", "1.2.3-rc.1", "1.2.3.4", "00.1.1", "1.2.3+b"}
+	for _, s := range inputs {
+		v1, err1 := p.Parse(s)
+		v2, err2 := p.parseFull(s, nil, nil)
+		is.Equal(v2, v1, "Parse of %q", s)
+		is.Equal(err2, err1, "Error of %q", s)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = p.Parse("10.20.30")
+	})
+	is.Zero(allocs)
+}
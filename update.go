@@ -0,0 +1,112 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// Channel represents an update channel policy used to decide which available versions
+// are eligible upgrade candidates.
+//
+// Supported Channels:
+//   - ChannelStable: Only versions without pre-release identifiers.
+//   - ChannelRC: Stable versions and release candidates (first pre-release identifier starting with "rc").
+//   - ChannelPrerelease: Any version, including all pre-releases.
+type Channel int
+
+const (
+	ChannelStable Channel = iota
+	ChannelRC
+	ChannelPrerelease
+)
+
+// String returns the string representation of the Channel.
+//
+// Example:
+//
+//	fmt.Println(semver.ChannelRC.String()) // Output: rc
+func (c Channel) String() string {
+	switch c {
+	case ChannelStable:
+		return "stable"
+	case ChannelRC:
+		return "rc"
+	case ChannelPrerelease:
+		return "prerelease"
+	default:
+		return "unknown"
+	}
+}
+
+// Allows reports whether the given version is eligible for the channel.
+//
+// Example:
+//
+//	v := semver.MustParse("2.0.0-rc.1")
+//	fmt.Println(semver.ChannelStable.Allows(v)) // Output: false
+//	fmt.Println(semver.ChannelRC.Allows(v))     // Output: true
+func (c Channel) Allows(v Version) bool {
+	if len(v.PreRelease) == 0 {
+		return true
+	}
+
+	switch c {
+	case ChannelRC:
+		first := v.PreRelease[0]
+		return !first.IsNumeric() && strings.HasPrefix(strings.ToLower(first.String()), "rc")
+	case ChannelPrerelease:
+		return true
+	default:
+		return false
+	}
+}
+
+// LatestInChannel returns the highest version from available that is greater than current
+// and allowed by the channel. The boolean result is false if no such version exists.
+//
+// Nil entries in available are ignored.
+//
+// Example:
+//
+//	current := semver.MustParse("1.2.0")
+//	a, b, c := semver.MustParse("1.3.0"), semver.MustParse("1.4.0-rc.1"), semver.MustParse("2.0.0-beta.1")
+//	available := []*semver.Version{&a, &b, &c}
+//
+//	v, ok := semver.LatestInChannel(current, semver.ChannelRC, available)
+//	fmt.Println(v, ok) // Output: 1.4.0-rc.1 true
+func LatestInChannel(current Version, channel Channel, available []*Version) (Version, bool) {
+	var best *Version
+	for _, candidate := range available {
+		if candidate == nil || !channel.Allows(*candidate) {
+			continue
+		}
+		if !candidate.GreaterThan(current) {
+			continue
+		}
+		if best == nil || candidate.GreaterThan(*best) {
+			best = candidate
+		}
+	}
+
+	if best == nil {
+		return Version{}, false
+	}
+	return *best, true
+}
+
+// IsUpdateAvailable reports whether available contains a version greater than current
+// that is allowed by the channel.
+//
+// Example:
+//
+//	current := semver.MustParse("1.2.0")
+//	a := semver.MustParse("1.3.0-beta.1")
+//	fmt.Println(semver.IsUpdateAvailable(current, semver.ChannelStable, []*semver.Version{&a})) // Output: false
+func IsUpdateAvailable(current Version, channel Channel, available []*Version) bool {
+	_, ok := LatestInChannel(current, channel, available)
+	return ok
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelAllows(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		version    string
+		channel    Channel
+		shouldPass bool
+	}{
+		{"1.0.0", ChannelStable, true},
+		{"1.0.0+build.1", ChannelStable, true},
+		{"1.0.0-rc.1", ChannelStable, false},
+		{"1.0.0-rc.1", ChannelRC, true},
+		{"1.0.0-RC1", ChannelRC, true},
+		{"1.0.0-beta.1", ChannelRC, false},
+		{"1.0.0-1", ChannelRC, false},
+		{"1.0.0-beta.1", ChannelPrerelease, true},
+		{"1.0.0", ChannelPrerelease, true},
+	}
+
+	for _, test := range tests {
+		v := MustParse(test.version)
+		is.Equal(test.shouldPass, test.channel.Allows(v), "Channel %s with version %s", test.channel, test.version)
+	}
+}
+
+func TestLatestInChannel(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	versionStrings := []string{
+		"1.1.0",
+		"1.2.0",
+		"1.3.0",
+		"1.4.0-rc.1",
+		"2.0.0-beta.1",
+	}
+
+	var available []*Version
+	for _, vs := range versionStrings {
+		v := MustParse(vs)
+		available = append(available, &v)
+	}
+	available = append(available, nil)
+
+	current := MustParse("1.2.0")
+
+	tests := []struct {
+		channel  Channel
+		expected string
+	}{
+		{ChannelStable, "1.3.0"},
+		{ChannelRC, "1.4.0-rc.1"},
+		{ChannelPrerelease, "2.0.0-beta.1"},
+	}
+
+	for _, test := range tests {
+		v, ok := LatestInChannel(current, test.channel, available)
+		is.True(ok, "Expected a candidate for channel %s", test.channel)
+		is.Equal(test.expected, v.String(), "Candidate for channel %s", test.channel)
+	}
+
+	_, ok := LatestInChannel(MustParse("3.0.0"), ChannelPrerelease, available)
+	is.False(ok, "Expected no candidate newer than 3.0.0")
+
+	_, ok = LatestInChannel(current, ChannelStable, nil)
+	is.False(ok, "Expected no candidate for empty list")
+}
+
+func TestIsUpdateAvailable(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	beta := MustParse("1.3.0-beta.1")
+	stable := MustParse("1.2.1")
+	current := MustParse("1.2.1")
+
+	is.False(IsUpdateAvailable(current, ChannelStable, []*Version{&beta, &stable}))
+	is.True(IsUpdateAvailable(current, ChannelPrerelease, []*Version{&beta, &stable}))
+}
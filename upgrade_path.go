@@ -0,0 +1,144 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrNoUpgradePath indicates that no sequence of available versions leads to the target under an UpgradePathPolicy.
+var ErrNoUpgradePath = errors.New("no upgrade path satisfies policy")
+
+// UpgradePathPolicy holds the rules an upgrade path must follow.
+//
+// Supported Rules:
+//   - NoMajorSkips: Each step may raise the major version by at most one.
+//   - LatestPatchFirst: Before leaving a major.minor line, a member must reach the latest
+//     available patch of that line.
+//   - AllowPrereleases: Pre-releases may be used as intermediate steps. The target may always
+//     be a pre-release.
+type UpgradePathPolicy struct {
+	NoMajorSkips     bool
+	LatestPatchFirst bool
+	AllowPrereleases bool
+}
+
+// PlanUpgrade returns the versions to deploy, in order, to move from current to target under
+// the policy, choosing intermediate steps from the available versions. The target is always
+// the last step, even if it is not among the available versions, and the plan is empty if
+// current already has the target's precedence.
+//
+// Each step goes as far as the policy allows: to the latest patch of the current line if
+// LatestPatchFirst requires it, otherwise to the highest available version of the next major
+// version if NoMajorSkips requires it, otherwise straight to the target.
+//
+// Returns ErrVersionDowngrade if target is below current, or ErrNoUpgradePath if a required
+// intermediate major version has no available release.
+//
+// Example:
+//
+//	available := []semver.Version{
+//	    semver.MustParse("1.2.9"), semver.MustParse("1.9.3"),
+//	    semver.MustParse("2.4.1"), semver.MustParse("3.0.0"),
+//	}
+//	policy := semver.UpgradePathPolicy{NoMajorSkips: true, LatestPatchFirst: true}
+//	steps, err := semver.PlanUpgrade(semver.MustParse("1.2.4"), semver.MustParse("3.0.0"), available, policy)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(steps) // Output: [1.2.9 2.4.1 3.0.0]
+func PlanUpgrade(current, target Version, available []Version, p UpgradePathPolicy) ([]Version, error) {
+	if target.Compare(current) < 0 {
+		return nil, fmt.Errorf("%w: %s to %s", ErrVersionDowngrade, current, target)
+	}
+
+	candidates := make([]Version, 0, len(available))
+	for _, v := range available {
+		if v.Compare(current) > 0 && v.Compare(target) < 0 && (p.AllowPrereleases || len(v.PreRelease) == 0) {
+			candidates = append(candidates, v)
+		}
+	}
+	slices.SortFunc(candidates, Compare)
+
+	var steps []Version
+	for cur := current; cur.Compare(target) < 0; {
+		next, err := p.nextStep(cur, target, candidates)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, next)
+		cur = next
+	}
+	return steps, nil
+}
+
+// nextStep returns the next version to deploy from cur toward target, choosing from the sorted
+// candidates, which all lie strictly between the original current version and target.
+func (p UpgradePathPolicy) nextStep(cur, target Version, candidates []Version) (Version, error) {
+	if p.LatestPatchFirst && !sameLine(cur, target) {
+		var latest *Version
+		for i := range candidates {
+			if sameLine(candidates[i], cur) && candidates[i].Compare(cur) > 0 {
+				latest = &candidates[i]
+			}
+		}
+		if latest != nil {
+			return *latest, nil
+		}
+	}
+
+	if p.NoMajorSkips && target.Epoch == cur.Epoch && target.Major > cur.Major+1 {
+		var highest *Version
+		for i := range candidates {
+			c := &candidates[i]
+			if c.Epoch == cur.Epoch && c.Major == cur.Major+1 {
+				highest = c
+			}
+		}
+		if highest == nil {
+			return Version{}, fmt.Errorf("%w: no available %d.x release between %s and %s", ErrNoUpgradePath, cur.Major+1, cur, target)
+		}
+		return *highest, nil
+	}
+
+	return target, nil
+}
+
+// sameLine reports whether a and b share their epoch, major, and minor versions.
+func sameLine(a, b Version) bool {
+	return a.Epoch == b.Epoch && a.Major == b.Major && a.Minor == b.Minor
+}
+
+// PlanFleetUpgrade plans the rollout of target to a fleet whose members run the given
+// versions. It returns the union of every member's PlanUpgrade path as ordered waves: the fleet
+// deploys each wave in turn, and members already at or above a wave's version skip it.
+//
+// Returns the first error from planning any member's path.
+//
+// Example:
+//
+//	fleet := []semver.Version{semver.MustParse("1.2.4"), semver.MustParse("1.4.0")}
+//	available := []semver.Version{
+//	    semver.MustParse("1.2.9"), semver.MustParse("1.4.2"), semver.MustParse("2.0.0"),
+//	}
+//	policy := semver.UpgradePathPolicy{LatestPatchFirst: true}
+//	waves, _ := semver.PlanFleetUpgrade(fleet, semver.MustParse("2.0.0"), available, policy)
+//	fmt.Println(waves) // Output: [1.2.9 1.4.2 2.0.0]
+func PlanFleetUpgrade(fleet []Version, target Version, available []Version, p UpgradePathPolicy) ([]Version, error) {
+	var waves []Version
+	for _, member := range fleet {
+		steps, err := PlanUpgrade(member, target, available, p)
+		if err != nil {
+			return nil, err
+		}
+		waves = append(waves, steps...)
+	}
+
+	slices.SortFunc(waves, Compare)
+	return slices.CompactFunc(waves, func(a, b Version) bool { return a.Compare(b) == 0 }), nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func versionList(s ...string) []Version {
+	versions := make([]Version, len(s))
+	for i, v := range s {
+		versions[i] = MustParse(v)
+	}
+	return versions
+}
+
+func TestPlanUpgrade(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	available := versionList("3.0.0", "1.2.9", "1.9.3", "2.0.0", "2.4.1", "1.9.0", "2.5.0-rc.1", "1.2.5")
+
+	tests := []struct {
+		name    string
+		policy  UpgradePathPolicy
+		current string
+		target  string
+		steps   []string
+	}{
+		{"no rules", UpgradePathPolicy{}, "1.2.4", "3.0.0", []string{"3.0.0"}},
+		{"no major skips", UpgradePathPolicy{NoMajorSkips: true}, "1.2.4", "3.0.0", []string{"2.4.1", "3.0.0"}},
+		{"latest patch", UpgradePathPolicy{LatestPatchFirst: true}, "1.2.4", "3.0.0", []string{"1.2.9", "3.0.0"}},
+		{"both", UpgradePathPolicy{NoMajorSkips: true, LatestPatchFirst: true}, "1.2.4", "3.0.0", []string{"1.2.9", "2.4.1", "3.0.0"}},
+		{"prereleases", UpgradePathPolicy{NoMajorSkips: true, AllowPrereleases: true}, "1.2.4", "3.0.0", []string{"2.5.0-rc.1", "3.0.0"}},
+		{"same line", UpgradePathPolicy{LatestPatchFirst: true}, "1.2.4", "1.2.7", []string{"1.2.7"}},
+		{"unpublished target", UpgradePathPolicy{NoMajorSkips: true}, "2.0.0", "3.1.0", []string{"3.1.0"}},
+		{"current", UpgradePathPolicy{}, "2.0.0", "2.0.0+build", nil},
+	}
+
+	for _, tt := range tests {
+		steps, err := PlanUpgrade(MustParse(tt.current), MustParse(tt.target), available, tt.policy)
+		is.NoError(err, tt.name)
+		var got []string
+		for _, v := range steps {
+			got = append(got, v.String())
+		}
+		is.Equal(tt.steps, got, tt.name)
+	}
+}
+
+func TestPlanUpgradeErrors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	_, err := PlanUpgrade(MustParse("2.0.0"), MustParse("1.0.0"), nil, UpgradePathPolicy{})
+	is.ErrorIs(err, ErrVersionDowngrade)
+
+	_, err = PlanUpgrade(MustParse("1.0.0"), MustParse("3.0.0"), versionList("1.5.0"), UpgradePathPolicy{NoMajorSkips: true})
+	is.ErrorIs(err, ErrNoUpgradePath)
+	is.ErrorContains(err, "no available 2.x release")
+}
+
+func TestPlanFleetUpgrade(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	fleet := versionList("1.2.4", "1.4.0", "1.2.9")
+	available := versionList("1.2.9", "1.4.2", "2.0.0")
+
+	waves, err := PlanFleetUpgrade(fleet, MustParse("2.0.0"), available, UpgradePathPolicy{LatestPatchFirst: true})
+	is.NoError(err)
+	is.Equal(versionList("1.2.9", "1.4.2", "2.0.0"), waves)
+
+	_, err = PlanFleetUpgrade(append(fleet, MustParse("3.0.0")), MustParse("2.0.0"), available, UpgradePathPolicy{})
+	is.ErrorIs(err, ErrVersionDowngrade)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+// ExclusiveUpper returns the version to use in a "<" requirement so that it excludes v and
+// every pre-release of v. A release such as 2.0.0 becomes 2.0.0-0, the lowest version with
+// that core; a pre-release is returned unchanged. Build metadata is dropped.
+//
+// Under plain precedence rules, "<2.0.0" matches 2.0.0-rc.1, which is rarely what an author
+// capping a range at the next major version intends. "<2.0.0-0" does not, and is the form npm
+// uses when expanding caret and tilde ranges.
+//
+// Example:
+//
+//	upper := semver.ExclusiveUpper(semver.MustParse("2.0.0"))
+//	fmt.Println(upper) // Output: 2.0.0-0
+func ExclusiveUpper(v Version) Version {
+	v.BuildMetadata = nil
+	if len(v.PreRelease) > 0 {
+		return v
+	}
+	v.PreRelease = []PrereleaseVersion{{isNumeric: true}}
+	return v
+}
+
+// WithoutUpperPrereleases returns a copy of the range in which every "<" requirement on a
+// release is rewritten with ExclusiveUpper, so that pre-releases of its upper bounds no longer
+// match. The range's Prerelease policy is preserved, and "<=" requirements are left as they
+// are, since pre-releases of an included version lie within the range.
+//
+// Example:
+//
+//	r := semver.MustParseRange(">=1.0.0 <2.0.0").WithoutUpperPrereleases()
+//	fmt.Println(r)                                          // Output: >=1.0.0 <2.0.0-0
+//	fmt.Println(r.Contains(semver.MustParse("2.0.0-rc.1"))) // Output: false
+func (vr *VersionRange) WithoutUpperPrereleases() *VersionRange {
+	out := &VersionRange{
+		Requirements: make([][]Requirement, len(vr.Requirements)),
+		Prerelease:   vr.Prerelease,
+	}
+	for i, andReqs := range vr.Requirements {
+		reqs := make([]Requirement, len(andReqs))
+		for j, req := range andReqs {
+			if req.Op == OpLt && len(req.Ver.PreRelease) == 0 {
+				req.Ver = ExclusiveUpper(req.Ver)
+			}
+			reqs[j] = req
+		}
+		out.Requirements[i] = reqs
+	}
+	return out
+}
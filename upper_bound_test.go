@@ -0,0 +1,51 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExclusiveUpper(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("2.0.0-0", ExclusiveUpper(MustParse("2.0.0+build")).String())
+	is.Equal("2.0.0-rc.1", ExclusiveUpper(MustParse("2.0.0-rc.1")).String())
+
+	v := MustParse("1.0.0")
+	_ = ExclusiveUpper(v)
+	is.Empty(v.PreRelease)
+}
+
+func TestWithoutUpperPrereleases(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	original := MustParseRange(">=1.0.0 <2.0.0 || >=3.0.0 <=3.5.0 || <4.0.0-beta")
+	r := original.WithoutUpperPrereleases()
+	is.Equal(">=1.0.0 <2.0.0-0 || >=3.0.0 <=3.5.0 || <4.0.0-beta", r.String())
+	is.Equal(">=1.0.0 <2.0.0 || >=3.0.0 <=3.5.0 || <4.0.0-beta", original.String())
+
+	capped := MustParseRange(">=1.0.0 <2.0.0")
+	is.True(capped.Contains(MustParse("2.0.0-rc.1")))
+	is.False(capped.WithoutUpperPrereleases().Contains(MustParse("2.0.0-rc.1")))
+	is.True(capped.WithoutUpperPrereleases().Contains(MustParse("1.9.9")))
+	is.True(capped.WithoutUpperPrereleases().Contains(MustParse("1.5.0-beta")))
+
+	optIn := &VersionRange{Requirements: original.Requirements, Prerelease: PrereleaseOptIn}
+	is.Equal(PrereleaseOptIn, optIn.WithoutUpperPrereleases().Prerelease)
+
+	// The rewritten range matches the npm expansion of the equivalent caret range.
+	caret := MustParseRange("^1.2.3")
+	explicit := MustParseRange(">=1.2.3 <2.0.0").WithoutUpperPrereleases()
+	for _, s := range []string{"1.2.3", "1.9.0", "2.0.0-0", "2.0.0-rc.1", "2.0.0"} {
+		v := MustParse(s)
+		is.Equal(caret.Contains(v), explicit.Contains(v), s)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"strings"
+)
+
+// ProductVersion is a product token and its version, as found in a User-Agent or Server header.
+// Raw is the version exactly as it appeared in the header.
+type ProductVersion struct {
+	Product string
+	Version Version
+	Raw     string
+}
+
+// ParseUserAgent scans a User-Agent-like string for "product/version" tokens, as defined for
+// the User-Agent and Server headers by RFC 9110, and returns those whose version can be parsed
+// with ParseLenient, in order.
+//
+// Comments in parentheses are skipped, as are products without a version and versions that
+// are not valid even leniently, such as "537.36.1.2" or "4.0b3".
+//
+// Example:
+//
+//	ua := "my-cli/v2.4 (linux; amd64) go-http/1.1 plugin/0.9.0-beta.1"
+//	for _, pv := range semver.ParseUserAgent(ua) {
+//	    fmt.Println(pv.Product, pv.Version)
+//	}
+//	// Output:
+//	// my-cli 2.4.0
+//	// go-http 1.1.0
+//	// plugin 0.9.0-beta.1
+func ParseUserAgent(ua string) []ProductVersion {
+	var products []ProductVersion
+	for i := 0; i < len(ua); {
+		switch ua[i] {
+		case ' ', '\t':
+			i++
+		case '(':
+			i = skipComment(ua, i)
+		default:
+			end := i
+			for end < len(ua) && ua[end] != ' ' && ua[end] != '\t' && ua[end] != '(' {
+				end++
+			}
+			if product, raw, ok := strings.Cut(ua[i:end], "/"); ok && product != "" {
+				if v, _, err := ParseLenient(raw); err == nil {
+					products = append(products, ProductVersion{Product: product, Version: v, Raw: raw})
+				}
+			}
+			i = end
+		}
+	}
+	return products
+}
+
+// UserAgentVersion returns the version of the first product in a User-Agent-like string whose
+// name matches product, ignoring case.
+//
+// Example:
+//
+//	v, ok := semver.UserAgentVersion("Mozilla/5.0 my-cli/2.4.1", "My-CLI")
+//	fmt.Println(v, ok) // Output: 2.4.1 true
+func UserAgentVersion(ua, product string) (Version, bool) {
+	for _, pv := range ParseUserAgent(ua) {
+		if strings.EqualFold(pv.Product, product) {
+			return pv.Version, true
+		}
+	}
+	return Version{}, false
+}
+
+// skipComment returns the index just past the comment starting at ua[start], which is '('.
+// Comments may nest, and a backslash quotes the following character. An unterminated comment
+// extends to the end of the string.
+func skipComment(ua string, start int) int {
+	depth := 0
+	for i := start; i < len(ua); i++ {
+		switch ua[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(ua)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUserAgent(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	products := ParseUserAgent("my-cli/v2.4 (linux; amd64) go-http/1.1 plugin/0.9.0-beta.1")
+	is.Equal([]ProductVersion{
+		{Product: "my-cli", Version: MustParse("2.4.0"), Raw: "v2.4"},
+		{Product: "go-http", Version: MustParse("1.1.0"), Raw: "1.1"},
+		{Product: "plugin", Version: MustParse("0.9.0-beta.1"), Raw: "0.9.0-beta.1"},
+	}, products)
+
+	products = ParseUserAgent("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.6099.129 Safari/537.36")
+	is.Len(products, 3)
+	is.Equal("Mozilla", products[0].Product)
+	is.Equal("5.0.0", products[0].Version.String())
+	is.Equal("AppleWebKit", products[1].Product)
+	is.Equal("Safari", products[2].Product)
+
+	products = ParseUserAgent("agent/1.0.0 (nested (comment \\) here) tool/2.0.0) lib/3.0.0 bare /4.0.0 unclosed/1.0.0(oops")
+	is.Equal([]ProductVersion{
+		{Product: "agent", Version: MustParse("1.0.0"), Raw: "1.0.0"},
+		{Product: "lib", Version: MustParse("3.0.0"), Raw: "3.0.0"},
+		{Product: "unclosed", Version: MustParse("1.0.0"), Raw: "1.0.0"},
+	}, products)
+
+	is.Empty(ParseUserAgent(""))
+	is.Empty(ParseUserAgent("(only a comment)"))
+}
+
+func TestUserAgentVersion(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v, ok := UserAgentVersion("Mozilla/5.0 my-cli/2.4.1", "My-CLI")
+	is.True(ok)
+	is.Equal("2.4.1", v.String())
+
+	_, ok = UserAgentVersion("Mozilla/5.0 my-cli/2.4.1", "other")
+	is.False(ok)
+}
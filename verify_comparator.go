@@ -0,0 +1,106 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrComparatorViolation indicates that a Comparator does not define a consistent ordering.
+var ErrComparatorViolation = errors.New("comparator violates ordering axioms")
+
+// Comparator orders two versions, returning a negative number if a has lower precedence than
+// b, a positive number if it has higher precedence, and zero otherwise. Compare is the
+// Comparator for Semantic Versioning precedence; custom comparators let teams plug in their own
+// precedence rules wherever a comparator is accepted, such as slices.SortFunc.
+type Comparator func(a, b Version) int
+
+// VerifyComparator checks that c defines a strict weak ordering over samples, as sorting and
+// searching require, by evaluating it on every pair of samples. It checks that:
+//   - Every sample is equal to itself.
+//   - Swapping the arguments reverses the result.
+//   - The ordering is transitive: if a <= b and b <= c, then a <= c, and a is equal to c
+//     only if it is equal to b and b to c.
+//
+// Returns nil if every axiom holds, or an error wrapping ErrComparatorViolation that names the
+// first violated axiom and the samples violating it. The check calls c once per ordered pair
+// and takes time cubic in the number of samples, so keep samples to a few hundred versions
+// chosen to exercise the comparator's edge cases.
+//
+// Example:
+//
+//	samples := []semver.Version{semver.MustParse("1.0.0-rc.1"), semver.MustParse("1.0.0")}
+//	fmt.Println(semver.VerifyComparator(semver.Compare, samples)) // Output: <nil>
+//
+//	alwaysLess := func(a, b semver.Version) int { return -1 }
+//	fmt.Println(semver.VerifyComparator(alwaysLess, samples)) // Output: comparator violates ordering axioms: reflexivity: compare(1.0.0-rc.1, 1.0.0-rc.1) = -1, want 0
+func VerifyComparator(c Comparator, samples []Version) error {
+	n := len(samples)
+	signs := make([]int, n*n)
+	for i := range samples {
+		for j := range samples {
+			signs[i*n+j] = sign(c(samples[i], samples[j]))
+		}
+	}
+
+	for i := range samples {
+		if signs[i*n+i] != 0 {
+			return comparatorViolation("reflexivity", "compare(%s, %s) = %d, want 0", samples[i], samples[i], signs[i*n+i])
+		}
+	}
+
+	for i := range samples {
+		for j := i + 1; j < n; j++ {
+			if signs[i*n+j] != -signs[j*n+i] {
+				return comparatorViolation("antisymmetry", "compare(%s, %s) = %d but compare(%s, %s) = %d",
+					samples[i], samples[j], signs[i*n+j], samples[j], samples[i], signs[j*n+i])
+			}
+		}
+	}
+
+	for i := range samples {
+		for j := range samples {
+			ij := signs[i*n+j]
+			if ij > 0 {
+				continue
+			}
+			for k := range samples {
+				jk := signs[j*n+k]
+				if jk > 0 {
+					continue
+				}
+				want := 0
+				if ij < 0 || jk < 0 {
+					want = -1
+				}
+				if got := signs[i*n+k]; got != want {
+					return comparatorViolation("transitivity", "compare(%s, %s) = %d and compare(%s, %s) = %d but compare(%s, %s) = %d",
+						samples[i], samples[j], ij, samples[j], samples[k], jk, samples[i], samples[k], got)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// comparatorViolation returns an error wrapping ErrComparatorViolation for the named axiom.
+func comparatorViolation(axiom, format string, args ...any) error {
+	return fmt.Errorf("%w: %s: %s", ErrComparatorViolation, axiom, fmt.Sprintf(format, args...))
+}
+
+// sign returns -1, 0, or +1 according to the sign of n.
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
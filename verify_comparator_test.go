@@ -0,0 +1,59 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyComparator(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	var samples []Version
+	for _, s := range []string{"0.0.0", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-beta", "1.0.0", "1.0.0+build", "1.2.3", "2.0.0"} {
+		samples = append(samples, MustParse(s))
+	}
+
+	is.NoError(VerifyComparator(Compare, samples))
+	is.NoError(VerifyComparator(Compare, nil))
+	is.NoError(VerifyComparator(func(a, b Version) int { return 0 }, samples))
+
+	// Scaled results are fine; only the sign matters.
+	is.NoError(VerifyComparator(func(a, b Version) int { return 10 * a.Compare(b) }, samples))
+
+	err := VerifyComparator(func(a, b Version) int { return -1 }, samples)
+	is.ErrorIs(err, ErrComparatorViolation)
+	is.EqualError(err, "comparator violates ordering axioms: reflexivity: compare(0.0.0, 0.0.0) = -1, want 0")
+
+	// Ignoring the arguments' order breaks antisymmetry.
+	err = VerifyComparator(func(a, b Version) int {
+		if a.Equal(b) {
+			return 0
+		}
+		return 1
+	}, samples)
+	is.ErrorIs(err, ErrComparatorViolation)
+	is.ErrorContains(err, "antisymmetry")
+
+	// Treating versions within one major of each other as equal is not transitive:
+	// 0.x ~ 1.x and 1.x ~ 2.x, but 0.x < 2.x.
+	near := func(a, b Version) int {
+		switch {
+		case a.Major+1 < b.Major:
+			return -1
+		case b.Major+1 < a.Major:
+			return 1
+		default:
+			return 0
+		}
+	}
+	err = VerifyComparator(near, []Version{MustParse("0.1.0"), MustParse("1.0.0"), MustParse("2.0.0")})
+	is.ErrorIs(err, ErrComparatorViolation)
+	is.ErrorContains(err, "transitivity")
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// versPrefix is the URI scheme of a vers range specifier.
+const versPrefix = "vers:"
+
+// ParseVers parses a vers range specifier, as defined by the package-url "vers" specification,
+// into its versioning scheme and a VersionRange.
+//
+// The specifier has the form "vers:<scheme>/<constraints>", where the constraints are separated
+// by "|" and each is a version with an optional "=", "!=", "<", "<=", ">", or ">=" comparator,
+// or the single wildcard "*". Whitespace is ignored, the scheme is lowercased, and versions are
+// percent-decoded and parsed with ParseLenient, so schemes whose versions are semantic versions
+// or close to them, such as npm, golang, and cargo, are supported.
+//
+// As the specification requires, each version may appear only once, and once sorted by version
+// the range constraints must alternate between lower and upper bounds. The returned range
+// matches pre-releases by plain precedence, with PrereleaseInclusive.
+//
+// Returns an error wrapping ErrInvalidVers if the specifier is malformed or a version cannot
+// be parsed.
+//
+// Example:
+//
+//	scheme, r, err := semver.ParseVers("vers:npm/>=1.2.3|<2.0.0")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(scheme, r.Contains(semver.MustParse("1.9.0"))) // Output: npm true
+func ParseVers(s string) (string, *VersionRange, error) {
+	scheme, constraints, err := parseVers(s)
+	if err != nil {
+		return "", nil, err
+	}
+	reqs, err := versRequirements(constraints)
+	if err != nil {
+		return "", nil, err
+	}
+	return scheme, &VersionRange{Requirements: reqs, Prerelease: PrereleaseInclusive}, nil
+}
+
+// FormatVers returns the normalized vers range specifier matching exactly the versions in the
+// range, under the given versioning scheme.
+//
+// The range is reduced to its sorted, disjoint intervals, so equivalent ranges format equally:
+// "^1.2.3" and ">=1.2.3 <2.0.0-0" both become "vers:npm/>=1.2.3|<2.0.0-0". Versions excluded
+// with OpNeq become "!=" constraints.
+//
+// Returns an error wrapping ErrInvalidVers if the scheme is empty, the range matches no
+// version, or its PrereleasePolicy is not PrereleaseInclusive, since vers always compares
+// pre-releases by plain precedence.
+//
+// Example:
+//
+//	s, err := semver.FormatVers("npm", semver.MustParseRange("<1.0.0 || >=2.0.0 !=2.1.0"))
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(s) // Output: vers:npm/<1.0.0|>=2.0.0|!=2.1.0
+func FormatVers(scheme string, r *VersionRange) (string, error) {
+	if scheme == "" || strings.ContainsAny(scheme, "/|: ") {
+		return "", fmt.Errorf("%w: invalid versioning scheme %q", ErrInvalidVers, scheme)
+	}
+	if r.Prerelease != PrereleaseInclusive {
+		return "", fmt.Errorf("%w: pre-release policy cannot be represented", ErrInvalidVers)
+	}
+
+	ivs := r.exactIntervals()
+	if len(ivs) == 0 {
+		return "", fmt.Errorf("%w: range matches no version", ErrInvalidVers)
+	}
+
+	var constraints []versConstraint
+	for i, iv := range ivs {
+		if !iv.Lower.Unbounded && !iv.Upper.Unbounded && iv.Lower.Inclusive && iv.Upper.Inclusive &&
+			iv.Lower.Version.Compare(iv.Upper.Version) == 0 {
+			constraints = append(constraints, versConstraint{op: OpEq, ver: iv.Lower.Version})
+			continue
+		}
+
+		if !iv.Lower.Unbounded {
+			last := len(constraints) - 1
+			if i > 0 && !iv.Lower.Inclusive && last >= 0 && constraints[last].op == OpLt &&
+				constraints[last].ver.Compare(iv.Lower.Version) == 0 {
+				// A single excluded version between two intervals.
+				constraints[last].op = OpNeq
+			} else if iv.Lower.Inclusive {
+				constraints = append(constraints, versConstraint{op: OpGte, ver: iv.Lower.Version})
+			} else {
+				constraints = append(constraints, versConstraint{op: OpGt, ver: iv.Lower.Version})
+			}
+		}
+
+		if !iv.Upper.Unbounded {
+			op := OpLt
+			if iv.Upper.Inclusive {
+				op = OpLte
+			}
+			constraints = append(constraints, versConstraint{op: op, ver: iv.Upper.Version})
+		}
+	}
+
+	return formatVers(strings.ToLower(scheme), constraints), nil
+}
+
+// exactIntervals returns the sorted, disjoint intervals covered by the range. Unlike Intervals,
+// versions excluded with OpNeq are removed, splitting the intervals that contain them.
+func (vr *VersionRange) exactIntervals() []Interval {
+	var ivs []Interval
+	for _, andReqs := range vr.Requirements {
+		iv := unboundedInterval()
+		var excluded []Version
+		for _, req := range andReqs {
+			if reqIv, ok := requirementInterval(req); ok {
+				iv = iv.intersect(reqIv)
+			} else if req.Op == OpNeq {
+				excluded = append(excluded, req.Ver)
+			}
+		}
+		if iv.IsEmpty() {
+			continue
+		}
+
+		sort.Slice(excluded, func(i, j int) bool { return excluded[i].Compare(excluded[j]) < 0 })
+		for _, v := range excluded {
+			point := Interval{Lower: Bound{Version: v, Inclusive: true}, Upper: Bound{Version: v, Inclusive: true}}
+			if !iv.includes(point) {
+				continue
+			}
+			below := Interval{Lower: iv.Lower, Upper: Bound{Version: v}}
+			if !below.IsEmpty() {
+				ivs = append(ivs, below)
+			}
+			iv.Lower = Bound{Version: v}
+		}
+		if !iv.IsEmpty() {
+			ivs = append(ivs, iv)
+		}
+	}
+	return mergeIntervals(ivs)
+}
+
+// versConstraint is one "|"-separated constraint of a vers range specifier, such as ">=1.2.3".
+type versConstraint struct {
+	op  Operator
+	ver Version
+}
+
+// String returns the constraint in vers syntax, omitting the implied "=" comparator.
+func (c versConstraint) String() string {
+	if c.op == OpEq {
+		return c.ver.String()
+	}
+	return string(c.op) + c.ver.String()
+}
+
+// parseVers splits a vers range specifier such as "vers:npm/>=1.2.3|<2.0.0" into its
+// lowercased scheme and its constraints, sorted by version. A nil constraint list is the
+// "*" wildcard, which matches every version. Whitespace is ignored, versions are
+// percent-decoded and parsed with ParseLenient, and each version may appear only once.
+func parseVers(s string) (string, []versConstraint, error) {
+	s = strings.Join(strings.Fields(s), "")
+	if len(s) < len(versPrefix) || !strings.EqualFold(s[:len(versPrefix)], versPrefix) {
+		return "", nil, fmt.Errorf("%w: missing %q prefix", ErrInvalidVers, versPrefix)
+	}
+
+	scheme, body, ok := strings.Cut(s[len(versPrefix):], "/")
+	if !ok || scheme == "" {
+		return "", nil, fmt.Errorf("%w: missing versioning scheme", ErrInvalidVers)
+	}
+	scheme = strings.ToLower(scheme)
+
+	body = strings.Trim(body, "|")
+	if body == "" {
+		return "", nil, fmt.Errorf("%w: no constraints", ErrInvalidVers)
+	}
+	if body == "*" {
+		return scheme, nil, nil
+	}
+
+	var constraints []versConstraint
+	for _, field := range strings.Split(body, "|") {
+		c, err := parseVersConstraint(field)
+		if err != nil {
+			return "", nil, err
+		}
+		constraints = append(constraints, c)
+	}
+
+	slices.SortStableFunc(constraints, func(a, b versConstraint) int { return a.ver.Compare(b.ver) })
+	for i := 1; i < len(constraints); i++ {
+		if constraints[i].ver.Compare(constraints[i-1].ver) == 0 {
+			return "", nil, fmt.Errorf("%w: version %s appears more than once", ErrInvalidVers, constraints[i].ver)
+		}
+	}
+	return scheme, constraints, nil
+}
+
+// parseVersConstraint parses a single constraint, whose comparator defaults to "=".
+func parseVersConstraint(field string) (versConstraint, error) {
+	op := OpEq
+	for _, candidate := range []Operator{OpGte, OpLte, OpNeq, OpGt, OpLt, OpEq} {
+		if strings.HasPrefix(field, string(candidate)) {
+			op, field = candidate, field[len(candidate):]
+			break
+		}
+	}
+
+	raw, err := url.PathUnescape(field)
+	if err != nil || raw == "" || raw == "*" {
+		return versConstraint{}, fmt.Errorf("%w: invalid constraint %q", ErrInvalidVers, field)
+	}
+	v, _, err := ParseLenient(raw)
+	if err != nil {
+		return versConstraint{}, fmt.Errorf("%w: version %q: %w", ErrInvalidVers, raw, err)
+	}
+	return versConstraint{op: op, ver: v}, nil
+}
+
+// versRequirements converts sorted vers constraints into requirements in disjunctive normal
+// form, to be matched by plain precedence as vers does.
+//
+// Each "=" constraint is a branch of its own, and the range constraints must alternate between
+// lower bounds (">", ">=") and upper bounds ("<", "<="), each pair forming a branch; a leading
+// upper bound or a trailing lower bound forms an unbounded branch. Every "!=" constraint is
+// added to each range branch.
+func versRequirements(constraints []versConstraint) ([][]Requirement, error) {
+	everything := Requirement{Op: OpGte, Ver: minimalPrerelease(0, 0, 0)}
+	if constraints == nil {
+		return [][]Requirement{{everything}}, nil
+	}
+
+	var exact, ranges [][]Requirement
+	var excluded []Requirement
+	var lower *Requirement
+	for _, c := range constraints {
+		req := Requirement{Op: c.op, Ver: c.ver}
+		switch c.op {
+		case OpEq:
+			exact = append(exact, []Requirement{req})
+		case OpNeq:
+			excluded = append(excluded, req)
+		case OpGt, OpGte:
+			if lower != nil {
+				return nil, fmt.Errorf("%w: lower bound %s follows lower bound %s", ErrInvalidVers, c, lower)
+			}
+			lower = &req
+		default:
+			if lower == nil && len(ranges) > 0 {
+				return nil, fmt.Errorf("%w: upper bound %s follows an upper bound", ErrInvalidVers, c)
+			}
+			if lower != nil {
+				ranges = append(ranges, []Requirement{*lower, req})
+			} else {
+				ranges = append(ranges, []Requirement{req})
+			}
+			lower = nil
+		}
+	}
+	if lower != nil {
+		ranges = append(ranges, []Requirement{*lower})
+	}
+	if len(ranges) == 0 && len(excluded) > 0 {
+		ranges = [][]Requirement{{everything}}
+	}
+
+	for i := range ranges {
+		ranges[i] = append(ranges[i], excluded...)
+	}
+	return append(ranges, exact...), nil
+}
+
+// formatVers returns the normalized vers range specifier for a scheme and sorted constraints.
+func formatVers(scheme string, constraints []versConstraint) string {
+	if constraints == nil {
+		return versPrefix + scheme + "/*"
+	}
+
+	parts := make([]string, len(constraints))
+	for i, c := range constraints {
+		parts[i] = c.String()
+	}
+	return versPrefix + scheme + "/" + strings.Join(parts, "|")
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVers(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input    string
+		scheme   string
+		included []string
+		excluded []string
+	}{
+		{"vers:npm/>=1.2.3|<2.0.0", "npm", []string{"1.2.3", "1.9.9", "2.0.0-rc.1"}, []string{"1.2.2", "2.0.0"}},
+		{"vers:NPM/1.0.0|2.0.0", "npm", []string{"1.0.0", "2.0.0"}, []string{"1.5.0"}},
+		{"vers:golang/<v1.0.0|>=v2.0.0", "golang", []string{"0.9.0", "2.0.0", "3.0.0"}, []string{"1.0.0", "1.5.0"}},
+		{"vers:npm/!=1.5.0", "npm", []string{"0.0.1", "2.0.0"}, []string{"1.5.0"}},
+		{"vers:npm/>=1.0.0|!=1.5.0|<2.0.0", "npm", []string{"1.0.0", "1.4.0"}, []string{"1.5.0", "2.0.0"}},
+		{"vers:cargo/*", "cargo", []string{"0.0.0", "1.0.0-alpha", "99.0.0"}, nil},
+	}
+	for _, tt := range tests {
+		scheme, r, err := ParseVers(tt.input)
+		is.NoError(err, tt.input)
+		is.Equal(tt.scheme, scheme, tt.input)
+		for _, v := range tt.included {
+			is.True(r.Contains(MustParse(v)), "%s should contain %s", tt.input, v)
+		}
+		for _, v := range tt.excluded {
+			is.False(r.Contains(MustParse(v)), "%s should not contain %s", tt.input, v)
+		}
+	}
+
+	for _, input := range []string{
+		"npm/>=1.0.0",
+		"vers:npm/",
+		"vers:npm/>=1.0.0|>=1.5.0",
+		"vers:npm/<1.0.0|<2.0.0",
+		"vers:npm/1.0.0|!=1.0.0",
+		"vers:npm/>=foo",
+	} {
+		_, _, err := ParseVers(input)
+		is.ErrorIs(err, ErrInvalidVers, input)
+	}
+}
+
+func TestFormatVers(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"^1.2.3", "vers:npm/>=1.2.3|<2.0.0-0"},
+		{">=1.2.3 <2.0.0-0", "vers:npm/>=1.2.3|<2.0.0-0"},
+		{"1.2.3", "vers:npm/1.2.3"},
+		{">=0.0.0-0", "vers:npm/*"},
+		{"<1.0.0 || >=2.0.0 !=2.1.0", "vers:npm/<1.0.0|>=2.0.0|!=2.1.0"},
+		{"!=1.5.0", "vers:npm/!=1.5.0"},
+		{">1.0.0 <=1.5.0 || 1.0.0", "vers:npm/>=1.0.0|<=1.5.0"},
+		{"1.0.0 || 3.0.0 || >=2.0.0 <2.5.0", "vers:npm/1.0.0|>=2.0.0|<2.5.0|3.0.0"},
+		{">=1.0.0 <3.0.0 || >=2.0.0 <4.0.0", "vers:npm/>=1.0.0|<4.0.0"},
+	}
+	for _, tt := range tests {
+		got, err := FormatVers("npm", MustParseRange(tt.input))
+		is.NoError(err, tt.input)
+		is.Equal(tt.expected, got, tt.input)
+
+		_, r, err := ParseVers(got)
+		is.NoError(err, got)
+		round, err := FormatVers("npm", r)
+		is.NoError(err, got)
+		is.Equal(got, round, tt.input)
+	}
+
+	_, err := FormatVers("", MustParseRange(">=1.0.0"))
+	is.ErrorIs(err, ErrInvalidVers)
+
+	_, err = FormatVers("npm", MustParseRange(">2.0.0 <1.0.0"))
+	is.ErrorIs(err, ErrInvalidVers)
+
+	r := MustParseRange("^1.0.0")
+	r.Prerelease = PrereleaseExcluded
+	_, err = FormatVers("npm", r)
+	is.ErrorIs(err, ErrInvalidVers)
+}
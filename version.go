@@ -6,8 +6,10 @@
 package semver
 
 import (
+	"context"
 	"fmt"
-	"strconv"
+	"math"
+	"slices"
 	"strings"
 )
 
@@ -22,6 +24,24 @@ var SupportedVersion = Version{
 	Patch: 0,
 }
 
+// Well-known versions.
+var (
+	// Zero is the lowest release, 0.0.0. Only pre-releases such as 0.0.0-0 precede it.
+	Zero = Version{}
+
+	// InitialDevelopment is 0.1.0, the version the Semantic Versioning specification recommends
+	// for starting initial development, during which anything may change at any time.
+	InitialDevelopment = Version{Minor: 1}
+
+	// FirstStable is 1.0.0, the first version that defines a public API.
+	FirstStable = Version{Major: 1}
+
+	// Max is the highest version with no epoch or revision, with every core component set to
+	// math.MaxUint64. It can serve as the upper end of a range with no upper limit; only
+	// versions with a non-zero Epoch or Revision sort above it.
+	Max = Version{Major: math.MaxUint64, Minor: math.MaxUint64, Patch: math.MaxUint64}
+)
+
 // Version represents a Semantic Versioning 2.0.0 version.
 //
 // A Version includes major, minor, and patch numbers, as well as optional pre-release and build metadata.
@@ -36,12 +56,30 @@ var SupportedVersion = Version{
 //	  BuildMetadata: []string{"build", "123"},
 //	}
 //	fmt.Println(v.String()) // Output: 1.2.3-alpha.1+build.123
+//
+// Concurrency: all methods of Version only read it, so a Version can be shared between goroutines
+// as long as none of them modifies it. Versions returned by the parser have PreRelease and
+// BuildMetadata slices whose capacity equals their length, so appending to them always allocates
+// a new backing array. Assigning to their elements, however, is visible to every copy of the
+// Version; use Freeze to obtain a FrozenVersion that cannot be modified.
+//
+// Revision is an optional fourth numeric segment, as in the Windows-style version "1.2.3.4".
+// It is not part of Semantic Versioning and is only set by parsers created with WithRevision,
+// or explicitly. A zero Revision is treated as absent, so "1.2.3.0" formats as "1.2.3".
+//
+// Epoch is an optional ordinal that takes precedence over every other field, written as a
+// prefix such as "2!1.4.0", as in PEP 440. Raising the epoch lets a project migrate to an
+// incompatible versioning scheme while still ordering every new version after the old ones.
+// It is only set by parsers created with WithEpoch, or explicitly. A zero Epoch is treated
+// as absent, so "0!1.4.0" formats as "1.4.0".
 type Version struct {
 	BuildMetadata []string
 	PreRelease    []PrereleaseVersion
 	Major         uint64
 	Minor         uint64
 	Patch         uint64
+	Revision      uint64
+	Epoch         uint64
 }
 
 var (
@@ -162,6 +200,9 @@ type Parser interface {
 
 type parser struct {
 	config *runtimeConfig
+
+	// ctx, if set by ParseContext, is checked before each pre-release and build identifier.
+	ctx context.Context
 }
 
 // New creates a new Version instance with the specified major, minor, patch components,
@@ -207,6 +248,27 @@ func New(major, minor, patch uint64, preRelease []PrereleaseVersion, buildMetada
 	}
 }
 
+// NewValidated creates a Version from its components, validating the pre-release and build
+// metadata identifiers as SetPrerelease and SetBuildMetadata do. Unlike New, the result is
+// guaranteed to be a valid semantic version whose String form parses back to it.
+//
+// Either string may be empty to omit the pre-release or build metadata.
+//
+// Example:
+//
+//	v, err := semver.NewValidated(1, 2, 3, "rc.1", "build.7")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(v) // Output: 1.2.3-rc.1+build.7
+func NewValidated(major, minor, patch uint64, prerelease, build string) (Version, error) {
+	v, err := Version{Major: major, Minor: minor, Patch: patch}.SetPrerelease(prerelease)
+	if err != nil {
+		return Version{}, err
+	}
+	return v.SetBuildMetadata(build)
+}
+
 // MustParse is a helper function that parses a version string and panics if invalid.
 //
 // Example:
@@ -258,20 +320,66 @@ func Parse(version string) (Version, error) {
 // The version string must follow semantic versioning format, such as "1.0.0-alpha+001".
 // It returns an error if the version string is invalid.
 func (p *parser) Parse(version string) (Version, error) {
+	if p.config.observer != nil {
+		return p.observeParse(version)
+	}
+	return p.parse(version, nil, nil)
+}
+
+// parse parses a version string, appending pre-release identifiers and build metadata to the
+// given buffers, which may be nil. The returned slices have their capacity clipped to their length.
+//
+// Plain "major.minor.patch" versions take a fast path; anything else, including every invalid
+// input, is handled by parseFull.
+func (p *parser) parse(version string, preBuf []PrereleaseVersion, metaBuf []string) (Version, error) {
+	var v Version
+	var err error
+	switch {
+	case p.config.trace != nil:
+		v, err = p.traceParse(version)
+	case parseTriple(version, &v):
+		return v, nil
+	default:
+		v, err = p.parseFull(version, preBuf, metaBuf)
+	}
+
+	if err != nil && p.config.suggest && p.ctxErr() == nil {
+		return Version{}, p.suggest(version, err)
+	}
+	return v, err
+}
+
+// parseFull parses any version string, as described by parse.
+func (p *parser) parseFull(version string, preBuf []PrereleaseVersion, metaBuf []string) (Version, error) {
 	if len(version) == 0 {
 		return Version{}, ErrEmptyVersionString
 	}
 
 	var v Version
-	var index int
+	var index, start int
 	length := len(version)
 	var err error
 
+	// Parse Epoch if the parser allows one and the version has an epoch prefix
+	if p.config.epoch && strings.IndexByte(version, '!') >= 0 {
+		v.Epoch, index, err = p.parseNumericIdentifier(version, index, length)
+		if err != nil {
+			return Version{}, err
+		}
+		p.traceSegment("epoch", version, 0, index)
+		if index >= length || version[index] != '!' {
+			return Version{}, ErrUnexpectedCharacter
+		}
+		index++ // Skip '!'
+	}
+
 	// Parse Major
+	start = index
 	v.Major, index, err = p.parseNumericIdentifier(version, index, length)
 	if err != nil {
 		return Version{}, err
 	}
+	p.traceSegment("major", version, start, index)
 
 	// Expect a '.' after Major
 	if index >= length || version[index] != '.' {
@@ -280,10 +388,12 @@ func (p *parser) Parse(version string) (Version, error) {
 	index++ // Skip '.'
 
 	// Parse Minor
+	start = index
 	v.Minor, index, err = p.parseNumericIdentifier(version, index, length)
 	if err != nil {
 		return Version{}, err
 	}
+	p.traceSegment("minor", version, start, index)
 
 	// Expect a '.' after Minor
 	if index >= length || version[index] != '.' {
@@ -292,14 +402,27 @@ func (p *parser) Parse(version string) (Version, error) {
 	index++ // Skip '.'
 
 	// Parse Patch
+	start = index
 	v.Patch, index, err = p.parseNumericIdentifier(version, index, length)
 	if err != nil {
 		return Version{}, err
 	}
+	p.traceSegment("patch", version, start, index)
+
+	// Parse Revision if the parser allows a fourth segment
+	if p.config.revision && index < length && version[index] == '.' {
+		index++ // Skip '.'
+		start = index
+		v.Revision, index, err = p.parseNumericIdentifier(version, index, length)
+		if err != nil {
+			return Version{}, err
+		}
+		p.traceSegment("revision", version, start, index)
+	}
 
 	// Parse PreRelease and BuildMetadata if any
 	if index < length {
-		index, err = p.parsePreReleaseAndBuildMetadata(version, index, length, &v)
+		index, err = p.parsePreReleaseAndBuildMetadata(version, index, length, &v, preBuf, metaBuf)
 		if err != nil {
 			return Version{}, err
 		}
@@ -343,7 +466,7 @@ func (p *parser) parseNumericIdentifier(version string, index int, length int) (
 
 // parsePreReleaseAndBuildMetadata parses the pre-release and build metadata components from the version string.
 // It updates the Version struct with the parsed values and returns the updated index or an error.
-func (p *parser) parsePreReleaseAndBuildMetadata(version string, index int, length int, v *Version) (int, error) {
+func (p *parser) parsePreReleaseAndBuildMetadata(version string, index int, length int, v *Version, preBuf []PrereleaseVersion, metaBuf []string) (int, error) {
 	var err error
 
 	// Parse PreRelease if present
@@ -357,7 +480,8 @@ func (p *parser) parsePreReleaseAndBuildMetadata(version string, index int, leng
 			index++
 		}
 		prerelease := version[start:index]
-		v.PreRelease, err = p.parsePrerelease(prerelease)
+		p.traceSegment("pre-release", version, start, index)
+		v.PreRelease, err = p.parsePrerelease(prerelease, preBuf)
 		if err != nil {
 			return index, err
 		}
@@ -368,7 +492,8 @@ func (p *parser) parsePreReleaseAndBuildMetadata(version string, index int, leng
 		index++ // Skip '+'
 		start := index
 		build := version[start:]
-		v.BuildMetadata, err = p.parseBuildMetadata(build)
+		p.traceSegment("build metadata", version, start, length)
+		v.BuildMetadata, err = p.parseBuildMetadata(build, metaBuf)
 		if err != nil {
 			return index, err
 		}
@@ -387,33 +512,39 @@ func (p *parser) parsePreReleaseAndBuildMetadata(version string, index int, leng
 //   - Numeric identifiers must not have leading zeros.
 //
 // Returns an error if the input string is empty, contains invalid characters, or contains empty identifiers.
+// Components are appended to dst[:0], which may be nil, so that callers can supply a reusable buffer.
 //
 // Example:
 //
 //	s := "alpha.1.0-beta"
-//	prerelease, err := parsePrerelease(s)
+//	prerelease, err := parsePrerelease(s, nil)
 //	if err != nil {
 //	    // handle error
 //	}
-func (p *parser) parsePrerelease(s string) ([]PrereleaseVersion, error) {
+func (p *parser) parsePrerelease(s string, dst []PrereleaseVersion) ([]PrereleaseVersion, error) {
 	if len(s) == 0 {
 		return nil, ErrEmptyPrereleaseIdentifier
 	}
 
-	var prerelease []PrereleaseVersion
+	prerelease := dst[:0]
 	length := len(s)
 	start := 0
 
 	for i := 0; i <= length; i++ {
 		if i == length || s[i] == '.' {
+			if err := p.ctxErr(); err != nil {
+				return nil, err
+			}
 			if start == i {
 				return nil, ErrEmptyPrereleaseIdentifier
 			}
 			part := s[start:i]
 
 			if !p.isValidPrereleaseIdentifier(part) {
+				p.traceIdentifier("pre-release", len(prerelease), part, "invalid")
 				return nil, ErrInvalidPrereleaseIdentifier
 			}
+			p.traceIdentifier("pre-release", len(prerelease), part, identifierClass(part))
 			component, err := NewPrereleaseVersion(part)
 
 			if err != nil {
@@ -426,7 +557,8 @@ func (p *parser) parsePrerelease(s string) ([]PrereleaseVersion, error) {
 			return nil, ErrInvalidCharacterInIdentifier
 		}
 	}
-	return prerelease, nil
+	// Clip the capacity so that appending to a shared Version never writes to the same backing array.
+	return slices.Clip(prerelease), nil
 }
 
 // parseBuildMetadata parses the given string into a slice of build metadata components.
@@ -437,39 +569,45 @@ func (p *parser) parsePrerelease(s string) ([]PrereleaseVersion, error) {
 //   - Identifiers must only contain alphanumeric characters or hyphens.
 //
 // Returns an error if the input string is empty, contains invalid characters, or contains empty identifiers.
+// Components are appended to dst[:0], which may be nil, so that callers can supply a reusable buffer.
 //
 // Example:
 //
 //	s := "001.alpha"
-//	buildMetadata, err := parseBuildMetadata(s)
+//	buildMetadata, err := parseBuildMetadata(s, nil)
 //	if err != nil {
 //	    // handle error
 //	}
-func (p *parser) parseBuildMetadata(s string) ([]string, error) {
+func (p *parser) parseBuildMetadata(s string, dst []string) ([]string, error) {
 	if len(s) == 0 {
 		return nil, ErrEmptyBuildMetadata
 	}
 
-	var buildMetadata []string
+	buildMetadata := dst[:0]
 	length := len(s)
 	start := 0
 
 	for i := 0; i <= length; i++ {
 		if i == length || s[i] == '.' {
+			if err := p.ctxErr(); err != nil {
+				return nil, err
+			}
 			if start == i {
 				return nil, ErrEmptyBuildMetadata
 			}
 			part := s[start:i]
 			if !p.isValidBuildIdentifier(part) {
+				p.traceIdentifier("build metadata", len(buildMetadata), part, "invalid")
 				return nil, ErrInvalidBuildMetadataIdentifier
 			}
+			p.traceIdentifier("build metadata", len(buildMetadata), part, identifierClass(part))
 			buildMetadata = append(buildMetadata, part)
 			start = i + 1
 		} else if s[i] > 127 || !p.isAllowedInIdentifier(s[i]) {
 			return nil, ErrInvalidCharacterInIdentifier
 		}
 	}
-	return buildMetadata, nil
+	return slices.Clip(buildMetadata), nil
 }
 
 // isAllowedInIdentifier checks if a character is allowed in a semantic version identifier.
@@ -538,36 +676,8 @@ func isNumeric(s string) bool {
 //	v := semver.MustParse("1.2.3-alpha.1+build.123")
 //	fmt.Println(v.String()) // Output: 1.2.3-alpha.1+build.123
 func (v Version) String() string {
-	var sb strings.Builder
-	sb.Grow(16) // Preallocate a reasonable capacity
-
-	sb.WriteString(strconv.FormatUint(v.Major, 10))
-	sb.WriteByte('.')
-	sb.WriteString(strconv.FormatUint(v.Minor, 10))
-	sb.WriteByte('.')
-	sb.WriteString(strconv.FormatUint(v.Patch, 10))
-
-	if len(v.PreRelease) > 0 {
-		sb.WriteByte('-')
-		for i, pr := range v.PreRelease {
-			if i > 0 {
-				sb.WriteByte('.')
-			}
-			sb.WriteString(pr.String())
-		}
-	}
-
-	if len(v.BuildMetadata) > 0 {
-		sb.WriteByte('+')
-		for i, bm := range v.BuildMetadata {
-			if i > 0 {
-				sb.WriteByte('.')
-			}
-			sb.WriteString(bm)
-		}
-	}
-
-	return sb.String()
+	var buf [formatBufferSize]byte
+	return string(v.AppendFormat(buf[:0]))
 }
 
 // Compare compares two Version instances.
@@ -579,6 +689,14 @@ func (v Version) String() string {
 //	v2 := semver.MustParse("1.2.4")
 //	fmt.Println(v1.Compare(v2)) // Output: -1
 func (v Version) Compare(other Version) int {
+	// Compare Epoch, which is zero for Semantic Versioning versions
+	if v.Epoch != other.Epoch {
+		if v.Epoch > other.Epoch {
+			return 1
+		}
+		return -1
+	}
+
 	// Compare Major
 	if v.Major != other.Major {
 		if v.Major > other.Major {
@@ -603,6 +721,14 @@ func (v Version) Compare(other Version) int {
 		return -1
 	}
 
+	// Compare Revision, which is zero for Semantic Versioning versions
+	if v.Revision != other.Revision {
+		if v.Revision > other.Revision {
+			return 1
+		}
+		return -1
+	}
+
 	// Handle pre-release comparison
 	if len(v.PreRelease) == 0 && len(other.PreRelease) == 0 {
 		return 0
@@ -647,6 +773,45 @@ func (v Version) Equal(other Version) bool {
 	return v.Compare(other) == 0
 }
 
+// StrictEqual checks if two versions are identical, not only equal in precedence.
+//
+// Unlike Equal, StrictEqual also compares build metadata and requires each pre-release
+// identifier to have the same type, numeric or alphanumeric, as well as the same value.
+//
+// Example:
+//
+//	v1 := semver.MustParse("1.2.3+build.1")
+//	v2 := semver.MustParse("1.2.3+build.2")
+//	fmt.Println(v1.Equal(v2))       // Output: true
+//	fmt.Println(v1.StrictEqual(v2)) // Output: false
+func (v Version) StrictEqual(other Version) bool {
+	return v.Epoch == other.Epoch &&
+		v.Major == other.Major &&
+		v.Minor == other.Minor &&
+		v.Patch == other.Patch &&
+		v.Revision == other.Revision &&
+		slices.Equal(v.PreRelease, other.PreRelease) &&
+		slices.Equal(v.BuildMetadata, other.BuildMetadata)
+}
+
+// Clone returns a deep copy of the version that shares no backing arrays with v.
+//
+// Copying a Version by assignment shares its PreRelease and BuildMetadata slices, so modifying
+// an identifier through one copy changes the other. Clone avoids this aliasing.
+//
+// Example:
+//
+//	v1 := semver.MustParse("1.2.3+build.1")
+//	v2 := v1.Clone()
+//	v2.BuildMetadata[1] = "2"
+//	fmt.Println(v1) // Output: 1.2.3+build.1
+func (v Version) Clone() Version {
+	c := v
+	c.PreRelease = slices.Clone(v.PreRelease)
+	c.BuildMetadata = slices.Clone(v.BuildMetadata)
+	return c
+}
+
 // LessThan checks if v is less than other.
 //
 // Example:
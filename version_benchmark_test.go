@@ -6,6 +6,7 @@
 package semver
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -94,3 +95,164 @@ func BenchmarkParseVersionAllocations(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkParseVersionArena(b *testing.B) {
+	b.ReportAllocs()
+
+	arena, err := NewArena(WithStrictAdherence(true))
+	if err != nil {
+		b.Fatalf("Error creating arena: %v", err)
+	}
+
+	versions := []string{
+		"2.0.1-beta.2",
+		"4.0.0-alpha.3+exp.sha.5114f85",
+		"5.1.0+build.5678",
+		"3.3.3-rc.2",
+		"6.2.0-beta+ci.789",
+		"1.1.1-alpha.2.3",
+		"7.0.0+build.1234",
+		"8.0.0-alpha.1.5+meta.data.001",
+		"2.4.5+build.meta.sha256",
+		"9.1.2-beta-unstable",
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		version := versions[i%len(versions)]
+		_, err = arena.Parse(version)
+		if err != nil {
+			b.Errorf("Error parsing version %s: %v", version, err)
+		}
+		// Release the buffers in bulk, as an ingestion job would after each batch.
+		if i%1024 == 1023 {
+			arena.Reset()
+		}
+	}
+}
+
+var plainVersions = []string{
+	"1.2.3",
+	"10.20.30",
+	"0.0.1",
+	"2.14.0",
+	"123.456.789",
+}
+
+func BenchmarkParseVersionPlain(b *testing.B) {
+	b.ReportAllocs()
+
+	p, err := NewParser(WithStrictAdherence(true))
+	if err != nil {
+		b.Fatalf("Error creating parser: %v", err)
+	}
+	full := p.(*parser)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		version := plainVersions[i%len(plainVersions)]
+		if _, err := full.parse(version, nil, nil); err != nil {
+			b.Errorf("Error parsing version %s: %v", version, err)
+		}
+	}
+}
+
+func BenchmarkParseVersionPlainGeneralPath(b *testing.B) {
+	b.ReportAllocs()
+
+	p, err := NewParser(WithStrictAdherence(true))
+	if err != nil {
+		b.Fatalf("Error creating parser: %v", err)
+	}
+	full := p.(*parser)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		version := plainVersions[i%len(plainVersions)]
+		if _, err := full.parseFull(version, nil, nil); err != nil {
+			b.Errorf("Error parsing version %s: %v", version, err)
+		}
+	}
+}
+
+func BenchmarkCompareStrings(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = CompareStrings("1.2.3-alpha.10+build.5", "1.2.3-alpha.9")
+	}
+}
+
+func BenchmarkCompareParsed(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v1, _ := Parse("1.2.3-alpha.10+build.5")
+		v2, _ := Parse("1.2.3-alpha.9")
+		_ = v1.Compare(v2)
+	}
+}
+
+func BenchmarkRangeContainsString(b *testing.B) {
+	r := MustParseRange("^1.2.0 || >=3.0.0-rc.1 !=3.1.0")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = r.ContainsString("3.0.0-rc.2+build.5")
+	}
+}
+
+func BenchmarkRangeContainsParsed(b *testing.B) {
+	r := MustParseRange("^1.2.0 || >=3.0.0-rc.1 !=3.1.0")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		v, _ := Parse("3.0.0-rc.2+build.5")
+		_ = r.Contains(v)
+	}
+}
+
+func BenchmarkVersionString(b *testing.B) {
+	v := MustParse("1.2.3-alpha.1+build.123")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = v.String()
+	}
+}
+
+func BenchmarkVersionAppendFormat(b *testing.B) {
+	v := MustParse("1.2.3-alpha.1+build.123")
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = v.AppendFormat(buf[:0])
+	}
+}
+
+func BenchmarkVersionIndexMaxSatisfying(b *testing.B) {
+	idx := NewVersionIndex(nil)
+	for major := uint64(0); major < 100; major++ {
+		for minor := uint64(0); minor < 1000; minor++ {
+			idx.Insert(Version{Major: major, Minor: minor})
+		}
+	}
+	r := MustParseRange("^42.0.0 !=42.999.0")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = idx.MaxSatisfying(r)
+	}
+}
+
+func BenchmarkRangeIndexMatch(b *testing.B) {
+	idx := NewRangeIndex()
+	for major := 0; major < 100; major++ {
+		for minor := 0; minor < 100; minor++ {
+			idx.Add(fmt.Sprintf("advisory-%d-%d", major, minor), MustParseRange(fmt.Sprintf(">=%d.%d.0 <%d.%d.5", major, minor, major, minor)))
+		}
+	}
+	v := MustParse("42.17.3")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = idx.Match(v)
+	}
+}
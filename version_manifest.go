@@ -0,0 +1,224 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+var (
+	// ErrUnknownComponent indicates that a component is not present in a VersionManifest.
+	ErrUnknownComponent = errors.New("unknown component")
+
+	// ErrConstraintNotSatisfied indicates that a component's version does not satisfy a constraint placed on it.
+	ErrConstraintNotSatisfied = errors.New("constraint not satisfied")
+)
+
+// RippleRule controls how a bump in a VersionManifest propagates to the components that depend
+// on the bumped component.
+//
+// Supported Rules:
+//   - RippleNone: Only the named component is bumped.
+//   - RipplePatch: Every dependent, transitively, receives a patch bump.
+//   - RippleSameLevel: Every dependent, transitively, receives a bump of the same level.
+type RippleRule int
+
+const (
+	RippleNone RippleRule = iota
+	RipplePatch
+	RippleSameLevel
+)
+
+// UnmarshalFunc decodes data into a value, such as json.Unmarshal or yaml.Unmarshal.
+type UnmarshalFunc func(data []byte, v interface{}) error
+
+// MarshalFunc encodes a value, such as json.Marshal or yaml.Marshal.
+type MarshalFunc func(v interface{}) ([]byte, error)
+
+// ComponentConstraint records that Component requires the version of Dependency to satisfy Range.
+type ComponentConstraint struct {
+	Component  string `json:"component" yaml:"component"`
+	Dependency string `json:"dependency" yaml:"dependency"`
+	Range      string `json:"range" yaml:"range"`
+}
+
+// VersionManifest maps the components of a monorepo to their versions, along with the
+// constraints between components.
+//
+// The struct carries both json and yaml tags, and Version implements encoding.TextMarshaler,
+// so a manifest can be stored in either format.
+//
+// Example:
+//
+//	m := semver.NewVersionManifest()
+//	m.Components["core"] = semver.MustParse("1.4.0")
+//	m.Components["cli"] = semver.MustParse("0.9.2")
+//	m.Constraints = append(m.Constraints, semver.ComponentConstraint{
+//	    Component: "cli", Dependency: "core", Range: ">=1.4.0 <2.0.0",
+//	})
+//
+//	changed, err := m.Bump("core", semver.BumpMinor, semver.RipplePatch)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Println(changed["core"], changed["cli"]) // Output: 1.5.0 0.9.3
+type VersionManifest struct {
+	Components  map[string]Version    `json:"components" yaml:"components"`
+	Constraints []ComponentConstraint `json:"constraints,omitempty" yaml:"constraints,omitempty"`
+}
+
+// NewVersionManifest creates an empty VersionManifest.
+func NewVersionManifest() *VersionManifest {
+	return &VersionManifest{
+		Components: make(map[string]Version),
+	}
+}
+
+// LoadVersionManifest reads a VersionManifest from r using unmarshal, which defaults to
+// json.Unmarshal when nil. Pass yaml.Unmarshal from a YAML package to load YAML.
+//
+// Example:
+//
+//	f, err := os.Open("versions.yaml")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer f.Close()
+//
+//	m, err := semver.LoadVersionManifest(f, yaml.Unmarshal)
+func LoadVersionManifest(r io.Reader, unmarshal UnmarshalFunc) (*VersionManifest, error) {
+	if unmarshal == nil {
+		unmarshal = json.Unmarshal
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	m := NewVersionManifest()
+	if err := unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	if m.Components == nil {
+		m.Components = make(map[string]Version)
+	}
+	return m, nil
+}
+
+// Save writes the VersionManifest to w using marshal, which defaults to indented JSON when nil.
+// Pass yaml.Marshal from a YAML package to save YAML.
+func (m *VersionManifest) Save(w io.Writer, marshal MarshalFunc) error {
+	if marshal == nil {
+		marshal = func(v interface{}) ([]byte, error) {
+			data, err := json.MarshalIndent(v, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			return append(data, '\n'), nil
+		}
+	}
+
+	data, err := marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// Validate checks every constraint in the manifest, returning all failures as an Errors with
+// one *PositionedError per failing constraint, whose Index is the constraint's index in
+// Constraints and whose Input is its range. A constraint fails if either component is
+// unknown, its range cannot be parsed, or the dependency's version does not satisfy it.
+func (m *VersionManifest) Validate() error {
+	var errs Errors
+	for i, c := range m.Constraints {
+		if err := m.validateConstraint(c); err != nil {
+			errs = append(errs, &PositionedError{Index: i, Input: c.Range, Err: err})
+		}
+	}
+	return errs.Err()
+}
+
+// validateConstraint checks a single constraint, as described by Validate.
+func (m *VersionManifest) validateConstraint(c ComponentConstraint) error {
+	if _, ok := m.Components[c.Component]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownComponent, c.Component)
+	}
+	v, ok := m.Components[c.Dependency]
+	if !ok {
+		return fmt.Errorf("%w: %s (required by %s)", ErrUnknownComponent, c.Dependency, c.Component)
+	}
+	r, err := ParseRange(c.Range)
+	if err != nil {
+		return fmt.Errorf("%s -> %s: %w", c.Component, c.Dependency, err)
+	}
+	if !r.Contains(v) {
+		return fmt.Errorf("%w: %s requires %s %s, found %s", ErrConstraintNotSatisfied, c.Component, c.Dependency, c.Range, v)
+	}
+	return nil
+}
+
+// Dependents returns the sorted names of the components that directly depend on component.
+func (m *VersionManifest) Dependents(component string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, c := range m.Constraints {
+		if c.Dependency == component && !seen[c.Component] {
+			seen[c.Component] = true
+			names = append(names, c.Component)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Bump increments the version of component at the given level and propagates the change to
+// its dependents according to the ripple rule. Each component is bumped at most once.
+//
+// Returns the new versions of every component that changed.
+func (m *VersionManifest) Bump(component string, level BumpLevel, ripple RippleRule) (map[string]Version, error) {
+	v, ok := m.Components[component]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownComponent, component)
+	}
+
+	changed := map[string]Version{component: v.Bump(level)}
+	m.Components[component] = changed[component]
+
+	if ripple == RippleNone {
+		return changed, nil
+	}
+
+	rippleLevel := BumpPatch
+	if ripple == RippleSameLevel {
+		rippleLevel = level
+	}
+
+	queue := m.Dependents(component)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if _, done := changed[name]; done {
+			continue
+		}
+		dependent, ok := m.Components[name]
+		if !ok {
+			continue
+		}
+
+		changed[name] = dependent.Bump(rippleLevel)
+		m.Components[name] = changed[name]
+		queue = append(queue, m.Dependents(name)...)
+	}
+
+	return changed, nil
+}
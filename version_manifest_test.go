@@ -0,0 +1,124 @@
+// Copyright (c) 2024-2025 Six After, Inc
+//
+// This source code is licensed under the Apache 2.0 License found in the
+// LICENSE file in the root directory of this source tree.
+
+package semver
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func newTestVersionManifest() *VersionManifest {
+	m := NewVersionManifest()
+	m.Components["core"] = MustParse("1.4.0")
+	m.Components["cli"] = MustParse("0.9.2")
+	m.Components["web"] = MustParse("2.0.0")
+	m.Constraints = []ComponentConstraint{
+		{Component: "cli", Dependency: "core", Range: ">=1.4.0 <2.0.0"},
+		{Component: "web", Dependency: "cli", Range: ">=0.9.0"},
+	}
+	return m
+}
+
+func TestVersionManifestValidate(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := newTestVersionManifest()
+	is.NoError(m.Validate())
+
+	m.Components["core"] = MustParse("2.0.0")
+	m.Constraints = append(m.Constraints,
+		ComponentConstraint{Component: "docs", Dependency: "core", Range: ">=1.0.0"},
+		ComponentConstraint{Component: "web", Dependency: "api", Range: ">=1.0.0"},
+		ComponentConstraint{Component: "web", Dependency: "core", Range: "invalid"},
+	)
+
+	err := m.Validate()
+	is.ErrorIs(err, ErrConstraintNotSatisfied)
+	is.ErrorIs(err, ErrUnknownComponent)
+	is.Contains(err.Error(), "cli requires core >=1.4.0 <2.0.0, found 2.0.0")
+	is.Contains(err.Error(), "web -> core: invalid version in range")
+
+	var errs Errors
+	if is.True(errors.As(err, &errs)) {
+		is.Len(errs, 4)
+		is.Equal(len(m.Constraints)-1, errs[3].Index)
+		is.Equal("invalid", errs[3].Input)
+	}
+}
+
+func TestVersionManifestBump(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := newTestVersionManifest()
+	changed, err := m.Bump("core", BumpMinor, RippleNone)
+	is.NoError(err)
+	is.Equal(map[string]Version{"core": MustParse("1.5.0")}, changed)
+
+	m = newTestVersionManifest()
+	changed, err = m.Bump("core", BumpMinor, RipplePatch)
+	is.NoError(err)
+	is.Equal(map[string]Version{
+		"core": MustParse("1.5.0"),
+		"cli":  MustParse("0.9.3"),
+		"web":  MustParse("2.0.1"),
+	}, changed)
+	is.Equal(MustParse("0.9.3"), m.Components["cli"])
+
+	m = newTestVersionManifest()
+	changed, err = m.Bump("core", BumpMajor, RippleSameLevel)
+	is.NoError(err)
+	is.Equal(MustParse("1.0.0"), changed["cli"])
+	is.Equal(MustParse("3.0.0"), changed["web"])
+	is.ErrorIs(m.Validate(), ErrConstraintNotSatisfied)
+
+	_, err = m.Bump("missing", BumpPatch, RippleNone)
+	is.ErrorIs(err, ErrUnknownComponent)
+
+	// Cycles are bumped once per component.
+	m = newTestVersionManifest()
+	m.Constraints = append(m.Constraints, ComponentConstraint{Component: "core", Dependency: "web", Range: ">=1.0.0"})
+	changed, err = m.Bump("core", BumpPatch, RipplePatch)
+	is.NoError(err)
+	is.Len(changed, 3)
+	is.Equal(MustParse("1.4.1"), changed["core"])
+}
+
+func TestVersionManifestLoadSave(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	m := newTestVersionManifest()
+
+	var buf bytes.Buffer
+	is.NoError(m.Save(&buf, nil))
+	is.Contains(buf.String(), `"core": "1.4.0"`)
+
+	loaded, err := LoadVersionManifest(&buf, nil)
+	is.NoError(err)
+	is.Equal(m, loaded)
+
+	buf.Reset()
+	is.NoError(m.Save(&buf, yaml.Marshal))
+	is.Contains(buf.String(), "core: 1.4.0")
+
+	loaded, err = LoadVersionManifest(&buf, yaml.Unmarshal)
+	is.NoError(err)
+	is.Equal(m, loaded)
+
+	loaded, err = LoadVersionManifest(strings.NewReader(`{}`), nil)
+	is.NoError(err)
+	is.NotNil(loaded.Components)
+
+	_, err = LoadVersionManifest(strings.NewReader(`{"components": {"core": "1.2"}}`), nil)
+	is.Error(err)
+}
@@ -219,6 +219,43 @@ func TestVersionEqual(t *testing.T) {
 	is.False(v1.Equal(v3), "Versions should not be equal")
 }
 
+func TestVersionStrictEqual(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.True(MustParse("1.2.3-alpha.1+build.1").StrictEqual(MustParse("1.2.3-alpha.1+build.1")))
+	is.False(MustParse("1.2.3+build.1").StrictEqual(MustParse("1.2.3+build.2")))
+	is.False(MustParse("1.2.3+build.1").StrictEqual(MustParse("1.2.3")))
+	is.False(MustParse("1.2.3-alpha").StrictEqual(MustParse("1.2.3-beta")))
+	is.False(MustParse("1.2.3").StrictEqual(MustParse("1.2.4")))
+
+	numeric := Version{Major: 1, PreRelease: []PrereleaseVersion{{partNumeric: 1, isNumeric: true}}}
+	alphanumeric := Version{Major: 1, PreRelease: []PrereleaseVersion{{partString: "1"}}}
+	is.Equal(numeric.String(), alphanumeric.String())
+	is.False(numeric.StrictEqual(alphanumeric), "Identifier types must match")
+}
+
+func TestVersionClone(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := MustParse("1.2.3-alpha.1+build.1")
+	c := v.Clone()
+	is.True(v.StrictEqual(c))
+
+	c.BuildMetadata[0] = "changed"
+	c.PreRelease[0] = PrereleaseVersion{partString: "beta"}
+	is.Equal("1.2.3-alpha.1+build.1", v.String())
+	is.Equal("1.2.3-beta.1+changed.1", c.String())
+
+	shallow := v
+	shallow.BuildMetadata[0] = "aliased"
+	is.Equal("1.2.3-alpha.1+aliased.1", v.String(), "Assignment shares backing arrays")
+
+	is.Nil(Version{}.Clone().PreRelease)
+	is.Nil(Version{}.Clone().BuildMetadata)
+}
+
 func TestVersionLessThan(t *testing.T) {
 	t.Parallel()
 	is := assert.New(t)
@@ -361,3 +398,207 @@ func TestInitPanicsOnParserFailure(t *testing.T) {
 
 	initDefaultParser() // Should panic
 }
+
+func TestParseRevision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithRevision(true))
+	is.NoError(err)
+
+	tests := []struct {
+		input    string
+		expected Version
+	}{
+		{"1.2.3", Version{Major: 1, Minor: 2, Patch: 3}},
+		{"10.0.19041.1165", Version{Major: 10, Patch: 19041, Revision: 1165}},
+		{"1.2.3.4-beta+build", Version{Major: 1, Minor: 2, Patch: 3, Revision: 4, PreRelease: []PrereleaseVersion{{partString: "beta"}}, BuildMetadata: []string{"build"}}},
+		{"1.2.3.0", Version{Major: 1, Minor: 2, Patch: 3}},
+	}
+
+	for _, test := range tests {
+		v, err := p.Parse(test.input)
+		is.NoError(err, "Input %s", test.input)
+		is.True(test.expected.StrictEqual(v), "Input %s", test.input)
+	}
+
+	v, _ := p.Parse("1.2.3.4-beta+build")
+	is.Equal("1.2.3.4-beta+build", v.String())
+
+	for _, input := range []string{"1.2.3.", "1.2.3.04", "1.2.3.4.5", "1.2.3.x"} {
+		_, err := p.Parse(input)
+		is.Error(err, "Input %s", input)
+	}
+
+	_, err = Parse("1.2.3.4")
+	is.ErrorIs(err, ErrUnexpectedCharacter)
+}
+
+func TestCompareRevision(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithRevision(true))
+	is.NoError(err)
+
+	ordered := []string{"1.2.3-rc.1", "1.2.3", "1.2.3.1-rc.1", "1.2.3.1", "1.2.3.2", "1.2.3.10", "1.2.4"}
+	for i := 1; i < len(ordered); i++ {
+		a, err := p.Parse(ordered[i-1])
+		is.NoError(err)
+		b, err := p.Parse(ordered[i])
+		is.NoError(err)
+		is.Equal(-1, a.Compare(b), "%s < %s", ordered[i-1], ordered[i])
+		is.Equal(1, b.Compare(a), "%s > %s", ordered[i], ordered[i-1])
+	}
+
+	a, _ := p.Parse("1.2.3.4")
+	b, _ := p.Parse("1.2.3.4+build")
+	is.True(a.Equal(b))
+	is.False(a.StrictEqual(Version{Major: 1, Minor: 2, Patch: 3}))
+
+	r := MustParseRange(">=1.2.3 <1.2.4")
+	is.True(r.Contains(a))
+	ok, err := (&VersionRange{Requirements: [][]Requirement{{{Op: OpLt, Ver: a}}}}).ContainsString("1.2.3")
+	is.NoError(err)
+	is.True(ok)
+}
+
+func TestParseEpoch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithEpoch(true))
+	is.NoError(err)
+
+	tests := []struct {
+		input    string
+		expected Version
+		output   string
+	}{
+		{"1.2.3", Version{Major: 1, Minor: 2, Patch: 3}, "1.2.3"},
+		{"2!1.4.0", Version{Epoch: 2, Major: 1, Minor: 4}, "2!1.4.0"},
+		{"1!0.1.0-rc.1+build", Version{Epoch: 1, Minor: 1, PreRelease: []PrereleaseVersion{{partString: "rc"}, {isNumeric: true, partNumeric: 1}}, BuildMetadata: []string{"build"}}, "1!0.1.0-rc.1+build"},
+		{"0!1.4.0", Version{Major: 1, Minor: 4}, "1.4.0"},
+	}
+
+	for _, test := range tests {
+		v, err := p.Parse(test.input)
+		is.NoError(err, "Input %s", test.input)
+		is.True(test.expected.StrictEqual(v), "Input %s", test.input)
+		is.Equal(test.output, v.String(), "Input %s", test.input)
+	}
+
+	for _, input := range []string{"!1.2.3", "01!1.2.3", "1!!1.2.3", "a!1.2.3", "1!", "1.2.3+build!1"} {
+		_, err := p.Parse(input)
+		is.Error(err, "Input %s", input)
+	}
+
+	_, err = Parse("2!1.4.0")
+	is.Error(err)
+
+	both, err := NewParser(WithEpoch(true), WithRevision(true))
+	is.NoError(err)
+	v, err := both.Parse("3!10.0.19041.1165")
+	is.NoError(err)
+	is.Equal("3!10.0.19041.1165", v.String())
+}
+
+func TestCompareEpoch(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	p, err := NewParser(WithEpoch(true))
+	is.NoError(err)
+
+	ordered := []string{"1.0.0", "2024.12.0", "1!0.0.1-rc.1", "1!0.0.1", "1!1.0.0", "2!0.0.0"}
+	for i := 1; i < len(ordered); i++ {
+		a, err := p.Parse(ordered[i-1])
+		is.NoError(err)
+		b, err := p.Parse(ordered[i])
+		is.NoError(err)
+		is.Equal(-1, a.Compare(b), "%s < %s", ordered[i-1], ordered[i])
+		is.Equal(1, b.Compare(a), "%s > %s", ordered[i], ordered[i-1])
+	}
+
+	v, _ := p.Parse("1!1.5.0")
+	caret := &VersionRange{Requirements: [][]Requirement{{{Op: OpCaret, Ver: Version{Epoch: 1, Major: 1, Minor: 2}}}}}
+	is.True(caret.Contains(v))
+	is.False(caret.Contains(MustParse("1.5.0")))
+
+	ok, err := caret.ContainsString("1.5.0")
+	is.NoError(err)
+	is.False(ok)
+
+	ok, err = MustParseRange(">=2024.0.0").ContainsString("2024.1.0")
+	is.NoError(err)
+	is.True(ok)
+	is.True(MustParseRange(">=2024.0.0").Contains(v), "Any epoch sorts after the scheme it replaced")
+}
+
+func TestWellKnownVersions(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	is.Equal("0.0.0", Zero.String())
+	is.Equal("0.1.0", InitialDevelopment.String())
+	is.Equal("1.0.0", FirstStable.String())
+	is.Equal("18446744073709551615.18446744073709551615.18446744073709551615", Max.String())
+
+	is.True(Zero.LessThan(InitialDevelopment))
+	is.True(InitialDevelopment.LessThan(FirstStable))
+	is.True(MustParse("0.0.0-0").LessThan(Zero))
+	is.True(MustParse("99999.0.0").LessThan(Max))
+	is.True(Max.LessThan(Version{Epoch: 1}))
+
+	parsed, err := Parse(Max.String())
+	is.NoError(err)
+	is.True(parsed.StrictEqual(Max))
+}
+
+func TestPrereleaseAccessors(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v := MustParse("1.2.3-rc.2.x-1+build.7")
+	is.Equal(3, v.PrereleaseLen())
+	is.Equal(0, MustParse("1.2.3+build.7").PrereleaseLen())
+
+	id, ok := v.PrereleaseAt(0)
+	is.True(ok)
+	is.Equal("rc", id.String())
+	id, ok = v.PrereleaseAt(2)
+	is.True(ok)
+	is.Equal("x-1", id.String())
+	_, ok = v.PrereleaseAt(3)
+	is.False(ok)
+	_, ok = v.PrereleaseAt(-1)
+	is.False(ok)
+
+	n, ok := v.PrereleaseNumericAt(1)
+	is.True(ok)
+	is.Equal(uint64(2), n)
+	_, ok = v.PrereleaseNumericAt(0)
+	is.False(ok)
+	_, ok = v.PrereleaseNumericAt(5)
+	is.False(ok)
+}
+
+func TestNewValidated(t *testing.T) {
+	t.Parallel()
+	is := assert.New(t)
+
+	v, err := NewValidated(1, 2, 3, "rc.1", "build.7")
+	is.NoError(err)
+	is.Equal("1.2.3-rc.1+build.7", v.String())
+	is.True(v.StrictEqual(MustParse(v.String())))
+
+	v, err = NewValidated(0, 1, 0, "", "")
+	is.NoError(err)
+	is.Equal(Version{Minor: 1}, v)
+
+	_, err = NewValidated(1, 0, 0, "rc.01", "")
+	is.ErrorIs(err, ErrInvalidPrereleaseIdentifier)
+
+	_, err = NewValidated(1, 0, 0, "", "build..7")
+	is.Error(err)
+}